@@ -24,9 +24,17 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/brands/all": {
+        "/api-keys/all": {
             "get": {
-                "description": "Возвращает все бренды с возможностью фильтрации и сортировки",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все выпущенные API-ключи, включая отозванные; секреты не возвращаются",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,21 +42,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "brand"
+                    "api-key"
                 ],
-                "summary": "Получение всех брендов",
+                "summary": "Получение всех API-ключей",
                 "responses": {
                     "200": {
-                        "description": "Список брендов",
+                        "description": "Список API-ключей",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.Brand"
+                                "$ref": "#/definitions/dto.APIKey"
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to fetch brand",
+                        "description": "Failed to fetch api keys",
                         "schema": {
                             "type": "string"
                         }
@@ -56,9 +76,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/create": {
+        "/api-keys/create": {
             "post": {
-                "description": "Эндпоинт для создания нового бренда",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Выпускает API-ключ для сервисного клиента. Секрет возвращается только в этом ответе и далее не может быть получен",
                 "consumes": [
                     "application/json"
                 ],
@@ -66,25 +94,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "brand"
+                    "api-key"
                 ],
-                "summary": "Создание нового бренда",
+                "summary": "Выпуск API-ключа",
                 "parameters": [
                     {
-                        "description": "Данные нового бренда",
-                        "name": "brand",
+                        "description": "Название, scope и срок действия ключа",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Brand"
+                            "$ref": "#/definitions/dto.APIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Brand created successfully",
+                    "201": {
+                        "description": "Выпущенный ключ",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.IssuedAPIKey"
                         }
                     },
                     "400": {
@@ -93,8 +121,20 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to create brand",
+                        "description": "Failed to issue api key",
                         "schema": {
                             "type": "string"
                         }
@@ -102,9 +142,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/delete/{id}": {
+        "/api-keys/revoke/{id}": {
             "delete": {
-                "description": "Выполняет мягкое удаление бренда по его ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Отзывает API-ключ; запросы с ним сразу отклоняются",
                 "consumes": [
                     "application/json"
                 ],
@@ -112,13 +160,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "brand"
+                    "api-key"
                 ],
-                "summary": "Мягкое удаление бренда",
+                "summary": "Отзыв API-ключа",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID бренда",
+                        "description": "ID API-ключа",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -126,7 +174,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Brand soft-deleted successfully",
+                        "description": "API key revoked successfully",
                         "schema": {
                             "type": "string"
                         }
@@ -137,8 +185,26 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to delete brand",
+                        "description": "Failed to revoke api key",
                         "schema": {
                             "type": "string"
                         }
@@ -146,9 +212,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/filter": {
-            "get": {
-                "description": "Возвращает все бренды с возможностью фильтрации и сортировки",
+        "/api-keys/rotate/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Выпускает новый секрет для активного ключа, прежний секрет сразу перестает действовать. Секрет возвращается только в этом ответе",
                 "consumes": [
                     "application/json"
                 ],
@@ -156,65 +230,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "brand"
+                    "api-key"
                 ],
-                "summary": "Фильтрация брендов",
+                "summary": "Ротация API-ключа",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Фильтр по имени бренда",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по стране происхождения",
-                        "name": "origin_country",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Фильтр по популярности (целое число)",
-                        "name": "popularity",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Фильтр по признаку премиум-бренда",
-                        "name": "is_premium",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Фильтр по признаку предстоящего бренда",
-                        "name": "is_upcoming",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Фильтр по году основания",
-                        "name": "founded_year",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Поле сортировки (например, 'name', '-popularity')",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "ID API-ключа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список брендов",
+                        "description": "Ключ с новым секретом",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Brand"
-                            }
+                            "$ref": "#/definitions/dto.IssuedAPIKey"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to fetch brands",
+                        "description": "Failed to rotate api key",
                         "schema": {
                             "type": "string"
                         }
@@ -222,9 +282,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/restore/{id}": {
-            "post": {
-                "description": "Восстановление бренда, который был удалён ранее (мягкое удаление)",
+        "/audit": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает события создания, изменения, удаления и восстановления брендов и моделей, от новых к старым",
                 "consumes": [
                     "application/json"
                 ],
@@ -232,33 +300,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "brand"
+                    "audit"
                 ],
-                "summary": "Восстановление мягко удалённого бренда",
+                "summary": "Журнал изменений каталога",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID бренда",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "ID бренда или модели",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Автор изменения (actor_guid)",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода (не включительно), RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Brand restored successfully",
+                        "description": "Страница журнала",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.AuditPage"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to restore brand",
+                        "description": "Failed to fetch audit events",
                         "schema": {
                             "type": "string"
                         }
@@ -266,9 +375,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/update/{id}": {
-            "put": {
-                "description": "Обновление данных бренда по его ID",
+        "/brands/all": {
+            "get": {
+                "description": "Возвращает все бренды с возможностью фильтрации и сортировки",
                 "consumes": [
                     "application/json"
                 ],
@@ -278,46 +387,33 @@ const docTemplate = `{
                 "tags": [
                     "brand"
                 ],
-                "summary": "Обновление бренда по ID",
+                "summary": "Получение всех брендов",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID бренда (UUIDv7)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
                     },
                     {
-                        "description": "Обновлённые данные бренда",
-                        "name": "brand",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.Brand"
-                        }
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Brand updated successfully",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Brand not found",
+                        "description": "Список брендов",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Brand"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Failed to update brand",
+                        "description": "Failed to fetch brand",
                         "schema": {
                             "type": "string"
                         }
@@ -325,9 +421,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/{id}": {
+        "/brands/by-slug/{slug}": {
             "get": {
-                "description": "Получение информации о бренде по его уникальному ID",
+                "description": "Получение бренда по слагу. Запрос по прежнему слагу бренда, переименованного после публикации ссылки, или по слагу его альтернативного названия перенаправляется на актуальный адрес",
                 "consumes": [
                     "application/json"
                 ],
@@ -337,14 +433,26 @@ const docTemplate = `{
                 "tags": [
                     "brand"
                 ],
-                "summary": "Получение бренда по ID",
+                "summary": "Получение бренда по слагу",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID бренда",
-                        "name": "id",
+                        "description": "Слаг бренда",
+                        "name": "slug",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -354,8 +462,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.Brand"
                         }
                     },
+                    "301": {
+                        "description": "Слаг устарел, актуальный адрес в заголовке Location",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid slug format",
                         "schema": {
                             "type": "string"
                         }
@@ -369,41 +483,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/models/all": {
-            "get": {
-                "description": "Возвращает все модели с возможностью фильтрации и сортировки",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "models"
-                ],
-                "summary": "Получение всех моделей",
-                "responses": {
-                    "200": {
-                        "description": "Список моделей",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Model"
-                            }
-                        }
+        "/brands/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     },
-                    "500": {
-                        "description": "Failed to fetch models",
-                        "schema": {
-                            "type": "string"
-                        }
+                    {
+                        "APIKeyAuth": []
                     }
-                }
-            }
-        },
-        "/models/create": {
-            "post": {
-                "description": "Эндпоинт для создания новой модели",
+                ],
+                "description": "Эндпоинт для создания нового бренда",
                 "consumes": [
                     "application/json"
                 ],
@@ -411,35 +501,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "models"
+                    "brand"
                 ],
-                "summary": "Создание новой модели",
+                "summary": "Создание нового бренда",
                 "parameters": [
                     {
-                        "description": "Данные новой модели",
-                        "name": "model",
+                        "description": "Данные нового бренда",
+                        "name": "brand",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Model"
+                            "$ref": "#/definitions/dto.Brand"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом и телом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Model created successfully",
+                        "description": "Brand created successfully",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid request body, slug or parent brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken (JSON), parent brand would form a cycle or Idempotency-Key reused with a different request or still in progress (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to create model",
+                        "description": "Failed to create brand",
                         "schema": {
                             "type": "string"
                         }
@@ -447,9 +561,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/models/delete/{id}": {
+        "/brands/delete/{id}": {
             "delete": {
-                "description": "Выполняет мягкое удаление модели по её ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Выполняет мягкое удаление бренда по его ID вместе с его моделями",
                 "consumes": [
                     "application/json"
                 ],
@@ -457,13 +579,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "models"
+                    "brand"
                 ],
-                "summary": "Мягкое удаление модели",
+                "summary": "Мягкое удаление бренда",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID модели (UUIDv7)",
+                        "description": "ID бренда",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -471,7 +593,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Model soft-deleted successfully",
+                        "description": "Brand soft-deleted successfully",
                         "schema": {
                             "type": "string"
                         }
@@ -482,8 +604,26 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Brand has been soft-deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to delete model",
+                        "description": "Failed to delete brand",
                         "schema": {
                             "type": "string"
                         }
@@ -491,9 +631,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/models/filter": {
+        "/brands/duplicates": {
             "get": {
-                "description": "Возвращает все модели с возможностью фильтрации и сортировки",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает группы брендов, похожих на дубликаты, по убыванию оценки. Пары находит периодическая задача по нормализованным названиям, триграммному сходству, совпадающим link и logo_url, году основания и стране. Группу можно слить через POST /brands/{id}/merge или отклонить",
                 "consumes": [
                     "application/json"
                 ],
@@ -501,97 +649,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "models"
+                    "duplicates"
                 ],
-                "summary": "Фильтрация моделей",
+                "summary": "Возможные дубликаты брендов",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Фильтр по имени модели",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по идентификатору бренда",
-                        "name": "brand_id",
+                        "type": "number",
+                        "description": "Минимальная оценка пары (0..1)",
+                        "name": "min_score",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Фильтр по популярности (целое число)",
-                        "name": "popularity",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Фильтр по признаку премиум-модели",
-                        "name": "is_limited",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Поле сортировки (например, 'name', '-popularity')",
-                        "name": "sort",
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список моделей",
+                        "description": "Страница групп",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Model"
-                            }
+                            "$ref": "#/definitions/dto.BrandDuplicatePage"
                         }
                     },
-                    "500": {
-                        "description": "Failed to fetch models",
+                    "400": {
+                        "description": "Invalid query parameter",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                }
-            }
-        },
-        "/models/restore/{id}": {
-            "post": {
-                "description": "Восстановление модели, которая была удалена ранее",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "models"
-                ],
-                "summary": "Восстановление мягко удалённой модели",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID модели (UUIDv7)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Model restored successfully",
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID format",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to restore model",
+                        "description": "Failed to fetch brand duplicates",
                         "schema": {
                             "type": "string"
                         }
@@ -599,9 +706,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/models/update/{id}": {
-            "put": {
-                "description": "Обновление данных модели по её ID",
+        "/brands/duplicates/dismiss": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Отмечает открытые пары, обе записи которых перечислены в ids, как не являющиеся дубликатами. Отклоненные пары не предлагаются повторно",
                 "consumes": [
                     "application/json"
                 ],
@@ -609,48 +724,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "models"
+                    "duplicates"
                 ],
-                "summary": "Обновление модели по ID",
+                "summary": "Отклонение возможных дубликатов брендов",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID модели (UUIDv7)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Обновлённые данные модели",
-                        "name": "model",
+                        "description": "ID брендов группы или ее части",
+                        "name": "dismiss",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Model"
+                            "$ref": "#/definitions/dto.DuplicateDismissRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Model updated successfully",
+                        "description": "Число отклоненных пар",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.DuplicateDismissResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid request body or fewer than two ids",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Model not found",
+                        "description": "No open duplicate candidates among given ids",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update model",
+                        "description": "Failed to dismiss duplicates",
                         "schema": {
                             "type": "string"
                         }
@@ -658,9 +778,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/models/{id}": {
+        "/brands/filter": {
             "get": {
-                "description": "Получение информации о модели по её уникальному ID",
+                "description": "Возвращает все бренды с возможностью фильтрации и сортировки",
                 "consumes": [
                     "application/json"
                 ],
@@ -668,23 +788,371 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "models"
+                    "brand"
                 ],
-                "summary": "Получение модели по ID",
+                "summary": "Фильтрация брендов",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID модели (UUIDv7)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Фильтр по имени бренда или его альтернативному названию",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по стране происхождения",
+                        "name": "origin_country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по популярности (целое число)",
+                        "name": "popularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по признаку премиум-бренда",
+                        "name": "is_premium",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по признаку предстоящего бренда",
+                        "name": "is_upcoming",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по году основания",
+                        "name": "founded_year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Бренды всех уровней ниже родительского бренда",
+                        "name": "parent_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поле сортировки (например, 'name', '-popularity')",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Модель найдена",
+                        "description": "Список брендов",
                         "schema": {
-                            "$ref": "#/definitions/dto.Model"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Brand"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch brands",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/restore/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстановление бренда, который был удалён ранее (мягкое удаление), и моделей, удалённых вместе с ним",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Восстановление мягко удалённого бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand restored successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug taken by another record while this one was deleted (JSON) or brand is not deleted (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает мягко удалённые бренды с временем и автором удаления, от недавно удалённых к давним",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Корзина брендов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница корзины",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandTrashPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch deleted brands",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/update/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Обновление данных бренда по его ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Обновление бренда по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда (UUIDv7)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Обновлённые данные бренда",
+                        "name": "brand",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Brand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand updated successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, slug or parent brand, or a localized brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken (JSON) or parent brand would form a cycle (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}": {
+            "get": {
+                "description": "Получение информации о бренде по его уникальному ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Получение бренда по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Вернуть бренд в состоянии на момент времени, RFC 3339",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бренд найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Brand"
                         }
                     },
                     "400": {
@@ -694,34 +1162,5198 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Model not found",
+                        "description": "Brand not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.Brand": {
+        },
+        "/brands/{id}/aliases": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает сокращения, написания на других языках и прежние названия бренда, по которым он находится в фильтре по name и по слагу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Альтернативные названия бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Альтернативные названия",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BrandAlias"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch brand aliases",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет бренду альтернативное название. Слаг названия строится автоматически и должен быть свободен: он не может совпадать со слагом неудаленного бренда или названием другого бренда",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Добавление альтернативного названия бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Альтернативное название",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Добавленное название",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandAlias"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, request body or alias",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Alias slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create brand alias",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/aliases/{alias_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Изменяет альтернативное название бренда; слаг названия строится заново",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Изменение альтернативного названия бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID альтернативного названия",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Альтернативное название",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Измененное название",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandAlias"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, request body or alias",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand or alias not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Alias slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update brand alias",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет альтернативное название бренда; бренд перестает находиться по нему",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Удаление альтернативного названия бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID альтернативного названия",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand alias deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand or alias not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete brand alias",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/ancestors": {
+            "get": {
+                "description": "Возвращает цепочку родительских брендов от корня до непосредственного родителя. Цепочка обрывается на удаленном бренде",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Родительские бренды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бренды с уровнем относительно запрошенного, от корня",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BrandNode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch brand ancestors",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/children": {
+            "get": {
+                "description": "Возвращает неудаленные бренды ниже бренда до depth уровней в порядке обхода дерева: за каждым брендом следуют его потомки. Ветка ниже удаленного бренда не возвращается",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Дочерние бренды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Число уровней: 1 — только дочерние бренды (по умолчанию), максимум 32",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бренды с уровнем относительно запрошенного",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BrandNode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or depth",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch brand children",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Сливает бренд source_id с брендом из пути в одной транзакции: пустые (или, по стратегии prefer_source, все) поля цели получают значения источника, модели, альтернативные названия и дочерние бренды источника переносятся в цель (цель, бывшая дочерним брендом источника, получает его родителя), название источника становится альтернативным названием цели, источник мягко удаляется с отметкой merged_into, а его слаги перенаправляют на цель. Модель, слаг которой в цели уже занят, получает слаг с суффиксом. С dry_run возвращает предпросмотр без изменений",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Слияние бренда-дубликата",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID целевого бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры слияния",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandMergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат слияния или предпросмотр",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandMergeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, strategy or field, source brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Target brand is a deeper descendant of the source",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to merge brands",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/purge": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Безвозвратно удаляет бренд из корзины вместе со всеми его моделями, их версиями и историей слагов. Журнал аудита сохраняется. Бренд должен быть предварительно мягко удалён",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Безвозвратное удаление бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand purged successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Brand is not deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to purge brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/revisions": {
+            "get": {
+                "description": "Возвращает сохраненные версии бренда от новых к старым",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "История версий бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Версии бренда",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Revision"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch revisions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/revisions/{rev}/revert": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстанавливает поля бренда из указанной версии через обычное обновление: с проверкой прав, записью аудита и созданием новой версии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "brand"
+                ],
+                "summary": "Откат бренда к версии",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер версии",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand после отката",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Brand"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug of the revision is taken by another record",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revert brand",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает переводы названия и описания бренда на все языки, на которые он переведен. Основной язык хранится в самом бренде",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Переводы бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Переводы по языкам",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BrandTranslation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch translations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{id}/translations/{locale}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Создает или заменяет перевод бренда на язык из пути. Непереданное поле становится непереведенным, и вместо него показывается значение следующего языка цепочки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Сохранение перевода бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, кроме основного",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Перевод",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandTranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сохраненный перевод",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BrandTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, request body, locale or field",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to save translation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет перевод бренда на язык из пути; вместо него показываются значения следующего языка цепочки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Удаление перевода бренда",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID бренда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, кроме основного",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Translation deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or locale",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand or translation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete translation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands/{slug}/models/{model_slug}": {
+            "get": {
+                "description": "Получение модели по слагу бренда и слагу модели. Запрос по прежнему слагу бренда или модели, а также по адресу модели, перенесенной к другому бренду, перенаправляется на актуальный адрес",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Получение модели по слагам бренда и модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Слаг бренда",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Слаг модели",
+                        "name": "model_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Модель найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Model"
+                        }
+                    },
+                    "301": {
+                        "description": "Адрес устарел, актуальный адрес в заголовке Location",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid slug format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/changes/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Поток Server-Sent Events с событиями brand.* и model.*. Поле id события — sequence; при переподключении с заголовком Last-Event-ID (или параметром last_event_id) поток продолжается с места разрыва. Без него передаются только новые события. Пустой поток поддерживается комментариями-пульсами. Клиент, не успевающий читать, отключается событием error и должен переподключиться с Last-Event-ID",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "changes"
+                ],
+                "summary": "Лента изменений брендов и моделей (SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Типы событий через запятую, например brand.created,model.updated",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только события бренда и его моделей",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sequence последнего полученного события, если нельзя передать заголовок",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sequence последнего полученного события",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий; data каждого события имеет эту схему",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OutboxEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "Change feed unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/all": {
+            "get": {
+                "description": "Возвращает все модели с возможностью фильтрации и сортировки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Получение всех моделей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список моделей",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Model"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch models",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Эндпоинт для создания новой модели",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Создание новой модели",
+                "parameters": [
+                    {
+                        "description": "Данные новой модели",
+                        "name": "model",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Model"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом и телом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Model created successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Specs do not match the category schema (JSON); invalid request body or slug, brand not found or deleted, unknown category (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecsErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken (JSON) or Idempotency-Key reused with a different request or still in progress, category schema changed during the request (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create model",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Выполняет мягкое удаление модели по её ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Мягкое удаление модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели (UUIDv7)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Model soft-deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Model has been soft-deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete model",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает группы моделей одного бренда, похожих на дубликаты, по убыванию оценки. Пары находит периодическая задача по нормализованным названиям, триграммному сходству и дате релиза",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "duplicates"
+                ],
+                "summary": "Возможные дубликаты моделей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Только модели бренда",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Минимальная оценка пары (0..1)",
+                        "name": "min_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница групп",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelDuplicatePage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch model duplicates",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/duplicates/dismiss": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Отмечает открытые пары, обе записи которых перечислены в ids, как не являющиеся дубликатами. Отклоненные пары не предлагаются повторно",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "duplicates"
+                ],
+                "summary": "Отклонение возможных дубликатов моделей",
+                "parameters": [
+                    {
+                        "description": "ID моделей группы или ее части",
+                        "name": "dismiss",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DuplicateDismissRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Число отклоненных пар",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DuplicateDismissResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or fewer than two ids",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "No open duplicate candidates among given ids",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to dismiss duplicates",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/filter": {
+            "get": {
+                "description": "Возвращает все модели с возможностью фильтрации и сортировки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Фильтрация моделей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по имени модели",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по идентификатору бренда",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по популярности (целое число)",
+                        "name": "popularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по признаку премиум-модели",
+                        "name": "is_limited",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Только модели с неудаленным поколением, выпускавшимся в этом году",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Только модели с неудаленным поколением, выпускавшимся в этом году или позже",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Только модели с неудаленным поколением, выпускавшимся в этом году или раньше",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по категории модели",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по характеристике: specs.\u003cname\u003e[\u003cop\u003e]=\u003cvalue\u003e, op — eq (по умолчанию), ne, gt, gte, lt, lte; например specs.engine_volume[gte]=2.0",
+                        "name": "specs.name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поле сортировки (например, 'name', '-popularity')",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список моделей",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Model"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch models",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/restore/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстановление модели, которая была удалена ранее; модель удалённого бренда не восстанавливается",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Восстановление мягко удалённой модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели (UUIDv7)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Model restored successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug taken by another record while this one was deleted (JSON) or brand of the model is deleted or model is not deleted (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore model",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает мягко удалённые модели с временем и автором удаления, от недавно удалённых к давним. Модели, удалённые вместе с брендом, отмечены deleted_with_brand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Корзина моделей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Только модели бренда",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница корзины",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTrashPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch deleted models",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/update/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Обновление данных модели по её ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Обновление модели по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели (UUIDv7)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Обновлённые данные модели",
+                        "name": "model",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Model"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Model updated successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Specs do not match the category schema (JSON); invalid request body or slug, brand not found or deleted, unknown category, localized model (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecsErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken (JSON) or category schema changed during the request (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update model",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}": {
+            "get": {
+                "description": "Получение информации о модели по её уникальному ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Получение модели по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели (UUIDv7)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Вернуть модель в состоянии на момент времени, RFC 3339",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Язык названий и описаний (ru, en, kk); важнее Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки ответа",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Модель найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Model"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/revisions": {
+            "get": {
+                "description": "Возвращает сохраненные версии модели от новых к старым",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "История версий модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Версии модели",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Revision"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch revisions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/revisions/{rev}/revert": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстанавливает поля модели из указанной версии через обычное обновление: с проверкой прав, записью аудита и созданием новой версии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "models"
+                ],
+                "summary": "Откат модели к версии",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер версии",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Model после отката",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Model"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug of the revision is taken by another record (JSON) or its brand is deleted or specs do not match the category schema (text)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revert model",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает переводы названия модели на все языки, на которые она переведена. Основной язык хранится в самой модели",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Переводы модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Переводы по языкам",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ModelTranslation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch translations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/translations/{locale}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Создает или заменяет перевод названия модели на язык из пути",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Сохранение перевода модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, кроме основного",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Перевод",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сохраненный перевод",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, request body, locale or field",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to save translation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет перевод названия модели на язык из пути; вместо него показывается название на следующем языке цепочки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Удаление перевода модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, кроме основного",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Translation deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or locale",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or translation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete translation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает неудаленные поколения модели по годам выпуска, у каждого — неудаленные комплектации по названию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Поколения и комплектации модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поколения с комплектациями",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ModelVariant"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch variants",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет модели поколение с годами выпуска. Название уникально среди неудаленных поколений модели без учета регистра",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Создание поколения модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Поколение",
+                        "name": "generation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelGenerationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Созданное поколение",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelGeneration"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format, request body, name or years",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create generation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations/{generation_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Заменяет название и годы выпуска неудаленного поколения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Изменение поколения модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Поколение",
+                        "name": "generation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelGenerationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Измененное поколение",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelGeneration"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format, request body, name or years",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or generation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update generation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Мягко удаляет поколение вместе с его неудаленными комплектациями",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Мягкое удаление поколения модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Generation soft-deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or generation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete generation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations/{generation_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстанавливает удаленное поколение и комплектации, удаленные вместе с ним. Комплектации, удаленные отдельно, остаются в корзине",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Восстановление поколения модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Generation restored successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or deleted generation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore generation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations/{generation_id}/trims": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет комплектацию неудаленному поколению модели. Название уникально среди неудаленных комплектаций поколения без учета регистра",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Создание комплектации",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Комплектация",
+                        "name": "trim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTrimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Созданная комплектация",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTrim"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format, request body or name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or generation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create trim",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations/{generation_id}/trims/{trim_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Заменяет название и флаги неудаленной комплектации",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Изменение комплектации",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID комплектации",
+                        "name": "trim_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Комплектация",
+                        "name": "trim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTrimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Измененная комплектация",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModelTrim"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format, request body or name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model, generation or trim not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update trim",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Мягко удаляет комплектацию неудаленного поколения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Мягкое удаление комплектации",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID комплектации",
+                        "name": "trim_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trim soft-deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model, generation or trim not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete trim",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/generations/{generation_id}/trims/{trim_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Восстанавливает удаленную комплектацию. Комплектацию удаленного поколения нельзя восстановить, пока не восстановлено поколение",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Восстановление комплектации",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID поколения",
+                        "name": "generation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID комплектации",
+                        "name": "trim_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trim restored successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Model or deleted trim not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Generation is deleted or name is already taken",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore trim",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/models/{id}/variants/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает удаленные поколения модели и поколения с удаленными комплектациями; у каждого поколения перечислены только удаленные комплектации",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "variants"
+                ],
+                "summary": "Корзина поколений и комплектаций модели",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID модели",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Удаленные поколения и комплектации",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ModelVariant"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Model not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch variant trash",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/specs/schemas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает схемы характеристик всех категорий по слагу категории",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "specs"
+                ],
+                "summary": "Схемы характеристик категорий",
+                "responses": {
+                    "200": {
+                        "description": "Схемы характеристик",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SpecSchema"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch specs schemas",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/specs/schemas/{category}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает JSON Schema характеристик моделей категории",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "specs"
+                ],
+                "summary": "Схема характеристик категории",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Слаг категории",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Схема характеристик",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Specs schema not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch specs schema",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Создает или заменяет JSON Schema характеристик моделей категории. Поддерживаются type, properties, required, additionalProperties (true/false), enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern, items, minItems, maxItems, uniqueItems и аннотации; схема с другими ключевыми словами отклоняется. Имена свойств — строчные латинские буквы, цифры и подчеркивания. Замена отклоняется, если ей не соответствуют характеристики моделей категории, включая удаленные",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "specs"
+                ],
+                "summary": "Сохранение схемы характеристик категории",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Слаг категории",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Схема характеристик",
+                        "name": "schema",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecSchemaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сохраненная схема",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, category or schema",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Specs of existing models do not match the new schema",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SpecSchemaConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to save specs schema",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет схему категории, у которой нет моделей, в том числе удаленных",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "specs"
+                ],
+                "summary": "Удаление схемы характеристик категории",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Слаг категории",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Specs schema deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Specs schema not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Specs schema is used by models of the category",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete specs schema",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/completeness": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Для каждого языка, кроме основного, возвращает число неудаленных брендов и моделей, у которых не переведены название и описание, и долю переведенных полей. Описание учитывается только у брендов, у которых оно заполнено на основном языке",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Полнота переводов",
+                "responses": {
+                    "200": {
+                        "description": "Полнота по языкам",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LocaleCompleteness"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to build completeness report",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/missing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает страницу неудаленных брендов и моделей, у которых на язык не переведены поля, по сущности и названию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Записи без переводов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код языка, кроме основного",
+                        "name": "locale",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только brand или только model",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница записей",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MissingTranslationPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter or locale",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch missing translations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все подписки, включая приостановленные; секреты не возвращаются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Получение всех подписок на вебхуки",
+                "responses": {
+                    "200": {
+                        "description": "Список подписок",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.WebhookSubscription"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch webhooks",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Создает подписку на события изменения брендов и моделей. Запросы подписываются заголовком X-Webhook-Signature: sha256=HMAC-SHA256(secret, \"\u003cX-Webhook-Timestamp\u003e.\u003cтело\u003e\"). Секрет возвращается только в этом ответе",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Создание подписки на вебхуки",
+                "parameters": [
+                    {
+                        "description": "Адрес, типы событий и секрет подписки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Созданная подписка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedWebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create webhook",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет подписку вместе с журналом доставок; неотправленные доставки отменяются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Удаление подписки на вебхуки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete webhook",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/update/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Заменяет адрес, типы событий, описание и статус подписки. Непустой secret заменяет ключ подписи; is_active=false приостанавливает отправку, доставки копятся до возобновления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Изменение подписки на вебхуки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые параметры подписки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновленная подписка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update webhook",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает подписку без секрета",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Получение подписки на вебхуки по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Подписка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch webhook",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает доставки событий подписке с числом попыток, последним HTTP-статусом и ошибкой, от новых к старым",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Журнал доставок подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус доставки: pending, delivered или dead",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница журнала доставок",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDeliveryPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch webhook deliveries",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает доставку в очередь со сброшенным счетчиком попыток, в том числе после перевода в dead. Получатель увидит тот же X-Webhook-ID и ID события",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Повторная отправка доставки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID доставки",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Доставка поставлена в очередь",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to redeliver webhook",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Кто выпустил ключ",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Время истечения, nil — бессрочный",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "description": "Время последнего использования",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название клиента",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Открытая часть ключа для поиска",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "description": "Время отзыва",
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "Роли RBAC",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "description": "Выданные scope",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.APIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "Время истечения",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название клиента",
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "Роли RBAC",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "description": "Запрашиваемые scope",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.AuditEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Кто выполнил изменение (actor_guid)",
+                    "type": "string"
+                },
+                "after": {
+                    "description": "Состояние после изменения",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "Состояние до изменения",
+                    "type": "object"
+                },
+                "diff": {
+                    "description": "Измененные поля: {\"поле\": {\"before\": ..., \"after\": ...}}",
+                    "type": "object"
+                },
+                "entity_id": {
+                    "description": "ID измененной записи",
+                    "type": "string"
+                },
+                "entity_type": {
+                    "description": "brand или model",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "description": "Время изменения",
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "create, update, delete, restore",
+                    "type": "string"
+                },
+                "request_id": {
+                    "description": "ID запроса",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuditPage": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "События, от новых к старым",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AuditEvent"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число событий по фильтру",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.Brand": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Альтернативные названия; изменяются через /brands/{id}/aliases",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cover_image_url": {
+                    "description": "URL обложки",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "Кто удалил бренд",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание/история бренда",
+                    "type": "string"
+                },
+                "founded_year": {
+                    "description": "Год основания",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Флаг удаления",
+                    "type": "boolean"
+                },
+                "is_premium": {
+                    "description": "Флаг премиального бренда",
+                    "type": "boolean"
+                },
+                "is_upcoming": {
+                    "description": "Флаг \"Скоро\"",
+                    "type": "boolean"
+                },
+                "link": {
+                    "description": "Бренд на английском",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "Язык переводов в названии и описании; такой ответ нельзя передать в PUT",
+                    "type": "string"
+                },
+                "logo_url": {
+                    "description": "URL логотипа",
+                    "type": "string"
+                },
+                "merged_into": {
+                    "description": "Бренд, в который слит этот бренд",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название бренда",
+                    "type": "string"
+                },
+                "origin_country": {
+                    "description": "Страна происхождения",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "Родительский бренд (концерн, группа); null — корневой бренд",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Цепочка родительских брендов от корня; только для чтения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BrandRef"
+                    }
+                },
+                "popularity": {
+                    "description": "Индекс популярности",
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Слаг для адресов; пустой при записи — строится из названия",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandAlias": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "description": "Название в исходном написании",
+                    "type": "string"
+                },
+                "brand_id": {
+                    "description": "Бренд, к которому относится название",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "Время добавления",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Слаг названия, по нему бренд находится как по своему слагу",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandAliasRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "description": "Альтернативное название бренда",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandDuplicateGroup": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "description": "Бренды группы",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Brand"
+                    }
+                },
+                "pairs": {
+                    "description": "Пары, связавшие группу",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DuplicatePair"
+                    }
+                },
+                "score": {
+                    "description": "Наибольшая оценка пары в группе",
+                    "type": "number"
+                },
+                "suggested_target_id": {
+                    "description": "Предлагаемая цель слияния",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandDuplicatePage": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "description": "Группы по убыванию оценки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BrandDuplicateGroup"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число групп",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.BrandMergeRequest": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "Только показать результат, ничего не изменяя",
+                    "type": "boolean"
+                },
+                "fields": {
+                    "description": "Стратегии отдельных полей, переопределяют strategy",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "source_id": {
+                    "description": "Бренд-дубликат, который будет слит и удален",
+                    "type": "string"
+                },
+                "strategy": {
+                    "description": "Стратегия для всех полей: keep_target (по умолчанию) или prefer_source",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandMergeResult": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "description": "Дочерние бренды источника, перенесенные в целевой бренд",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BrandRef"
+                    }
+                },
+                "dry_run": {
+                    "description": "Результат предпросмотра, изменения не сохранены",
+                    "type": "boolean"
+                },
+                "merged_fields": {
+                    "description": "Поля цели, получившие значения источника",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "models": {
+                    "description": "Модели, перенесенные в целевой бренд",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MergedModel"
+                    }
+                },
+                "source": {
+                    "description": "Бренд-дубликат после слияния",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Brand"
+                        }
+                    ]
+                },
+                "target": {
+                    "description": "Целевой бренд после слияния",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Brand"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.BrandNode": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Альтернативные названия; изменяются через /brands/{id}/aliases",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cover_image_url": {
+                    "description": "URL обложки",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "Кто удалил бренд",
+                    "type": "string"
+                },
+                "depth": {
+                    "description": "1 — дочерний или родительский бренд, 2 — следующий уровень и т.д.",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "Описание/история бренда",
+                    "type": "string"
+                },
+                "founded_year": {
+                    "description": "Год основания",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Флаг удаления",
+                    "type": "boolean"
+                },
+                "is_premium": {
+                    "description": "Флаг премиального бренда",
+                    "type": "boolean"
+                },
+                "is_upcoming": {
+                    "description": "Флаг \"Скоро\"",
+                    "type": "boolean"
+                },
+                "link": {
+                    "description": "Бренд на английском",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "Язык переводов в названии и описании; такой ответ нельзя передать в PUT",
+                    "type": "string"
+                },
+                "logo_url": {
+                    "description": "URL логотипа",
+                    "type": "string"
+                },
+                "merged_into": {
+                    "description": "Бренд, в который слит этот бренд",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название бренда",
+                    "type": "string"
+                },
+                "origin_country": {
+                    "description": "Страна происхождения",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "Родительский бренд (концерн, группа); null — корневой бренд",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Цепочка родительских брендов от корня; только для чтения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BrandRef"
+                    }
+                },
+                "popularity": {
+                    "description": "Индекс популярности",
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Слаг для адресов; пустой при записи — строится из названия",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandRef": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название бренда",
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Слаг бренда",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandTranslation": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание/история бренда",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "Код языка",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название бренда",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время изменения перевода",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandTranslationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Описание/история бренда",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название бренда",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BrandTrashPage": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "description": "Бренды, от недавно удаленных к давним",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Brand"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число удаленных брендов",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ConflictRecord": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "description": "brand или model",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID записи",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название записи",
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Занятый слаг",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConflictResponse": {
+            "type": "object",
+            "properties": {
+                "conflict": {
+                    "description": "Запись, занявшая слаг",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ConflictRecord"
+                        }
+                    ]
+                },
+                "error": {
+                    "description": "Описание конфликта",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreatedWebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Кто создал подписку",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание подписки",
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "Типы событий, * — все",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "Отправлять ли новые события",
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "Ключ для проверки заголовка X-Webhook-Signature",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Адрес получателя",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DuplicateDismissRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "ID записей группы или ее части",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.DuplicateDismissResponse": {
+            "type": "object",
+            "properties": {
+                "dismissed": {
+                    "description": "Число отклоненных пар",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.DuplicatePair": {
+            "type": "object",
+            "properties": {
+                "detected_at": {
+                    "description": "Когда пара найдена последним запуском поиска",
+                    "type": "string"
+                },
+                "left_id": {
+                    "type": "string"
+                },
+                "name_similarity": {
+                    "description": "Триграммное сходство нормализованных названий",
+                    "type": "number"
+                },
+                "reasons": {
+                    "description": "Совпавшие признаки",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "right_id": {
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Оценка сходства 0..1",
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ForbiddenResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Описание отказа",
+                    "type": "string"
+                },
+                "forbidden_fields": {
+                    "description": "Поля, которые роль не может изменять",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "permission": {
+                    "description": "Требуемое разрешение",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.IncompatibleModel": {
+            "type": "object",
+            "properties": {
+                "model_id": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SpecViolation"
+                    }
+                }
+            }
+        },
+        "dto.IssuedAPIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "Кто выпустил ключ",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Время истечения, nil — бессрочный",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "description": "Время последнего использования",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название клиента",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Открытая часть ключа для поиска",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "description": "Время отзыва",
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "Роли RBAC",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "description": "Выданные scope",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "description": "Полное значение ключа для заголовка X-API-Key",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LocaleCompleteness": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "description": "Переводы брендов",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TranslationCoverage"
+                        }
+                    ]
+                },
+                "locale": {
+                    "description": "Код языка",
+                    "type": "string"
+                },
+                "models": {
+                    "description": "Переводы моделей",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TranslationCoverage"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.MergedModel": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Модель находится в корзине",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Название модели",
+                    "type": "string"
+                },
+                "previous_slug": {
+                    "description": "Прежний слаг, если он занят моделью цели",
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Слаг в целевом бренде",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MissingTranslation": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "description": "Бренд модели",
+                    "type": "string"
+                },
+                "entity": {
+                    "description": "brand или model",
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Непереведенные поля",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "ID записи",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название на основном языке",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MissingTranslationPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Записи по сущности и названию",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MissingTranslation"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "locale": {
+                    "description": "Язык перевода",
+                    "type": "string"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число записей без переводов",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.Model": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "type": "string"
+                },
+                "category": {
+                    "description": "Категория со схемой характеристик; null — модель без характеристик",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "Кто удалил модель",
+                    "type": "string"
+                },
+                "deleted_with_brand": {
+                    "description": "Удалена каскадно вместе с брендом",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Флаг удаления",
+                    "type": "boolean"
+                },
+                "is_limited": {
+                    "description": "Флаг ограниченного выпуска",
+                    "type": "boolean"
+                },
+                "is_upcoming": {
+                    "description": "Флаг \"Скоро\"",
+                    "type": "boolean"
+                },
+                "locale": {
+                    "description": "Язык перевода в названии; такой ответ нельзя передать в PUT",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название модели",
+                    "type": "string"
+                },
+                "release_date": {
+                    "description": "Дата релиза",
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Слаг, уникальный в пределах бренда; пустой при записи — строится из названия",
+                    "type": "string"
+                },
+                "specs": {
+                    "description": "Характеристики по схеме категории",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Specs"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelDuplicateGroup": {
+            "type": "object",
+            "properties": {
+                "models": {
+                    "description": "Модели группы",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Model"
+                    }
+                },
+                "pairs": {
+                    "description": "Пары, связавшие группу",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DuplicatePair"
+                    }
+                },
+                "score": {
+                    "description": "Наибольшая оценка пары в группе",
+                    "type": "number"
+                },
+                "suggested_target_id": {
+                    "description": "Модель, которую предлагается оставить",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelDuplicatePage": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "description": "Группы по убыванию оценки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ModelDuplicateGroup"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число групп",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ModelGeneration": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "description": "Бренд модели",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "Кто удалил поколение",
+                    "type": "string"
+                },
+                "end_year": {
+                    "description": "Последний год выпуска; null — выпускается",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Флаг удаления",
+                    "type": "boolean"
+                },
+                "model_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название поколения (Mk8)",
+                    "type": "string"
+                },
+                "start_year": {
+                    "description": "Первый год выпуска",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelGenerationRequest": {
+            "type": "object",
+            "properties": {
+                "end_year": {
+                    "description": "Последний год выпуска; null — выпускается",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Название поколения",
+                    "type": "string"
+                },
+                "start_year": {
+                    "description": "Первый год выпуска",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ModelTranslation": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "description": "Бренд модели",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "Код языка",
+                    "type": "string"
+                },
+                "model_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название модели",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время изменения перевода",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelTranslationRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Название модели",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelTrashPage": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "models": {
+                    "description": "Модели, от недавно удаленных к давним",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Model"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число удаленных моделей по фильтру",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ModelTrim": {
             "type": "object",
             "properties": {
-                "cover_image_url": {
-                    "description": "URL обложки",
+                "brand_id": {
+                    "description": "Бренд модели",
                     "type": "string"
                 },
                 "created_at": {
                     "description": "Время создания",
                     "type": "string"
                 },
-                "description": {
-                    "description": "Описание/история бренда",
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
                     "type": "string"
                 },
-                "founded_year": {
-                    "description": "Год основания",
-                    "type": "integer"
+                "deleted_by": {
+                    "description": "Кто удалил комплектацию",
+                    "type": "string"
+                },
+                "deleted_with_generation": {
+                    "description": "Удалена каскадно вместе с поколением",
+                    "type": "boolean"
+                },
+                "generation_id": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
@@ -730,44 +6362,174 @@ const docTemplate = `{
                     "description": "Флаг удаления",
                     "type": "boolean"
                 },
-                "is_premium": {
-                    "description": "Флаг премиального бренда",
+                "is_limited": {
+                    "description": "Флаг ограниченного выпуска",
                     "type": "boolean"
                 },
                 "is_upcoming": {
                     "description": "Флаг \"Скоро\"",
                     "type": "boolean"
                 },
-                "link": {
-                    "description": "Бренд на английском",
+                "name": {
+                    "description": "Название комплектации",
                     "type": "string"
                 },
-                "logo_url": {
-                    "description": "URL логотипа",
+                "updated_at": {
+                    "description": "Время обновления",
                     "type": "string"
+                }
+            }
+        },
+        "dto.ModelTrimRequest": {
+            "type": "object",
+            "properties": {
+                "is_limited": {
+                    "description": "Флаг ограниченного выпуска",
+                    "type": "boolean"
+                },
+                "is_upcoming": {
+                    "description": "Флаг \"Скоро\"",
+                    "type": "boolean"
                 },
                 "name": {
-                    "description": "Название бренда",
+                    "description": "Название комплектации",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ModelVariant": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "description": "Бренд модели",
                     "type": "string"
                 },
-                "origin_country": {
-                    "description": "Страна происхождения",
+                "created_at": {
+                    "description": "Время создания",
                     "type": "string"
                 },
-                "popularity": {
-                    "description": "Индекс популярности",
+                "deleted_at": {
+                    "description": "Время мягкого удаления",
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "Кто удалил поколение",
+                    "type": "string"
+                },
+                "end_year": {
+                    "description": "Последний год выпуска; null — выпускается",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "Флаг удаления",
+                    "type": "boolean"
+                },
+                "model_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название поколения (Mk8)",
+                    "type": "string"
+                },
+                "start_year": {
+                    "description": "Первый год выпуска",
                     "type": "integer"
                 },
+                "trims": {
+                    "description": "Комплектации поколения по названию",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ModelTrim"
+                    }
+                },
                 "updated_at": {
                     "description": "Время обновления",
                     "type": "string"
                 }
             }
         },
-        "dto.Model": {
+        "dto.OutboxEvent": {
             "type": "object",
             "properties": {
-                "brand_id": {
+                "actor": {
+                    "description": "Автор изменения",
+                    "type": "string"
+                },
+                "aggregate_id": {
+                    "description": "ID измененной записи",
+                    "type": "string"
+                },
+                "aggregate_type": {
+                    "description": "brand или model",
+                    "type": "string"
+                },
+                "data": {
+                    "description": "Состояние записи после изменения",
+                    "type": "object"
+                },
+                "id": {
+                    "description": "ID события для дедупликации",
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "description": "Время изменения",
+                    "type": "string"
+                },
+                "request_id": {
+                    "description": "ID запроса",
+                    "type": "string"
+                },
+                "sequence": {
+                    "description": "Порядковый номер события",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Тип события, например brand.created",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.Revision": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Автор изменения",
+                    "type": "string"
+                },
+                "data": {
+                    "description": "Состояние записи",
+                    "type": "object"
+                },
+                "entity_id": {
+                    "description": "ID бренда или модели",
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "Операция, создавшая версию",
+                    "type": "string"
+                },
+                "request_id": {
+                    "description": "ID запроса",
+                    "type": "string"
+                },
+                "revision": {
+                    "description": "Номер версии, начиная с 1",
+                    "type": "integer"
+                },
+                "valid_from": {
+                    "description": "Время, с которого действует версия",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SpecSchema": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Слаг категории: cars, sneakers, phones",
                     "type": "string"
                 },
                 "created_at": {
@@ -777,32 +6539,259 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "is_deleted": {
-                    "description": "Флаг удаления",
-                    "type": "boolean"
+                "schema": {
+                    "description": "JSON Schema характеристик",
+                    "type": "object"
                 },
-                "is_limited": {
-                    "description": "Флаг ограниченного выпуска",
-                    "type": "boolean"
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
                 },
-                "is_upcoming": {
-                    "description": "Флаг \"Скоро\"",
-                    "type": "boolean"
+                "version": {
+                    "description": "Растет при каждой замене схемы",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SpecSchemaConflictResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
                 },
-                "name": {
-                    "description": "Название модели",
+                "models": {
+                    "description": "Первые из них по ID",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.IncompatibleModel"
+                    }
+                },
+                "total": {
+                    "description": "Сколько моделей не проходят схему",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SpecSchemaRequest": {
+            "type": "object",
+            "properties": {
+                "schema": {
+                    "description": "JSON Schema с корнем \"type\": \"object\"",
+                    "type": "object"
+                }
+            }
+        },
+        "dto.SpecViolation": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Какое правило схемы нарушено",
                     "type": "string"
                 },
-                "release_date": {
-                    "description": "Дата релиза",
+                "path": {
+                    "description": "Путь к значению: specs.engine_volume",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.Specs": {
+            "type": "object",
+            "additionalProperties": {}
+        },
+        "dto.SpecsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SpecViolation"
+                    }
+                }
+            }
+        },
+        "dto.TranslationCoverage": {
+            "type": "object",
+            "properties": {
+                "missing": {
+                    "description": "Записей без перевода по полям",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "percent": {
+                    "description": "Доля переведенных полей, от 0 до 100",
+                    "type": "number"
+                },
+                "total": {
+                    "description": "Неудаленных записей",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Число выполненных попыток",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "Время создания",
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "description": "Время успешной доставки",
+                    "type": "string"
+                },
+                "event_id": {
+                    "description": "ID события outbox",
+                    "type": "string"
+                },
+                "event_type": {
+                    "description": "Тип события",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "description": "Ошибка последней попытки",
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "description": "HTTP-статус последней попытки",
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "description": "Время следующей попытки",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "Тело запроса",
+                    "type": "object"
+                },
+                "status": {
+                    "description": "pending, delivered или dead",
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "Время обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WebhookDeliveryPage": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "description": "Доставки, от новых к старым",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookDelivery"
+                    }
+                },
+                "limit": {
+                    "description": "Размер страницы",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "Смещение",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Общее число доставок по фильтру",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Время создания",
                     "type": "string"
                 },
+                "created_by": {
+                    "description": "Кто создал подписку",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание подписки",
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "Типы событий, * — все",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "Отправлять ли новые события",
+                    "type": "boolean"
+                },
                 "updated_at": {
                     "description": "Время обновления",
                     "type": "string"
+                },
+                "url": {
+                    "description": "Адрес получателя",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Описание подписки",
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "Типы событий, например brand.created; * — все",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "description": "По умолчанию true",
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "Ключ подписи; пусто — сгенерировать",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Адрес получателя, http или https",
+                    "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API-ключ сервисного клиента",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT в формате \"Bearer {token}\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
@@ -1,8 +1,19 @@
 package api
 
 import (
+	"Brands/internal/api/handler/apikey"
+	"Brands/internal/api/handler/audit"
 	"Brands/internal/api/handler/brand"
+	"Brands/internal/api/handler/changes"
+	"Brands/internal/api/handler/duplicate"
 	"Brands/internal/api/handler/model"
+	"Brands/internal/api/handler/specschema"
+	"Brands/internal/api/handler/translation"
+	"Brands/internal/api/handler/variant"
+	"Brands/internal/api/handler/webhook"
+	"Brands/internal/auth"
+	"Brands/internal/i18n"
+	"Brands/pkg/redact"
 	"Brands/pkg/zerohook"
 	"context"
 	"github.com/fasthttp/router"
@@ -13,24 +24,57 @@ import (
 )
 
 type service struct {
-	r            *router.Router
-	log          zerolog.Logger
-	brandHandler *brand.BrandHandler
-	modelHandler *model.ModelHandler
+	r                  *router.Router
+	log                zerolog.Logger
+	redactor           *redact.Redactor
+	auth               *auth.Authenticator
+	locales            *i18n.Negotiator
+	brandHandler       *brand.BrandHandler
+	modelHandler       *model.ModelHandler
+	apiKeyHandler      *apikey.APIKeyHandler
+	auditHandler       *audit.AuditHandler
+	webhookHandler     *webhook.WebhookHandler
+	changesHandler     *changes.ChangesHandler
+	duplicateHandler   *duplicate.DuplicateHandler
+	translationHandler *translation.TranslationHandler
+	variantHandler     *variant.VariantHandler
+	specSchemaHandler  *specschema.SpecSchemaHandler
 }
 
 func NewService(
 	log zerolog.Logger,
+	redactor *redact.Redactor,
+	authenticator *auth.Authenticator,
+	locales *i18n.Negotiator,
 	bh *brand.BrandHandler,
 	mh *model.ModelHandler,
+	akh *apikey.APIKeyHandler,
+	ah *audit.AuditHandler,
+	wh *webhook.WebhookHandler,
+	ch *changes.ChangesHandler,
+	dh *duplicate.DuplicateHandler,
+	th *translation.TranslationHandler,
+	vh *variant.VariantHandler,
+	sh *specschema.SpecSchemaHandler,
 ) (*service, error) {
 	r := router.New()
 
 	// Инициализация сервиса
 	s := &service{
-		log:          log,
-		brandHandler: bh,
-		modelHandler: mh,
+		log:                log,
+		redactor:           redactor,
+		auth:               authenticator,
+		locales:            locales,
+		brandHandler:       bh,
+		modelHandler:       mh,
+		apiKeyHandler:      akh,
+		auditHandler:       ah,
+		webhookHandler:     wh,
+		changesHandler:     ch,
+		duplicateHandler:   dh,
+		translationHandler: th,
+		variantHandler:     vh,
+		specSchemaHandler:  sh,
 	}
 	// Health check маршрут
 	r.GET("/health", func(ctx *fasthttp.RequestCtx) {
@@ -39,8 +83,16 @@ func NewService(
 	})
 
 	// Настройка маршрутов
-	s.brandHandler.SetupRoutes(r)
-	s.modelHandler.SetupRoutes(r)
+	s.brandHandler.SetupRoutes(r, s.auth)
+	s.modelHandler.SetupRoutes(r, s.auth)
+	s.apiKeyHandler.SetupRoutes(r, s.auth)
+	s.auditHandler.SetupRoutes(r, s.auth)
+	s.webhookHandler.SetupRoutes(r, s.auth)
+	s.changesHandler.SetupRoutes(r, s.auth)
+	s.duplicateHandler.SetupRoutes(r, s.auth)
+	s.translationHandler.SetupRoutes(r, s.auth)
+	s.variantHandler.SetupRoutes(r, s.auth)
+	s.specSchemaHandler.SetupRoutes(r, s.auth)
 
 	s.r = r
 	return s, nil
@@ -51,7 +103,8 @@ func (s *service) Start(ctx context.Context) error {
 		Handler: RecoveryMiddleware(
 			CORS(
 				TraceMiddleware(
-					LoggingMiddleware(s.r.Handler),
+					LoggingMiddleware(LocaleMiddleware(s.r.Handler, s.locales)),
+					s.redactor,
 				),
 			),
 		),
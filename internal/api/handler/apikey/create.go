@@ -0,0 +1,95 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	apikeyservice "Brands/internal/service/apikey"
+)
+
+// IssueAPIKey godoc
+// @Summary Выпуск API-ключа
+// @Description Выпускает API-ключ для сервисного клиента. Секрет возвращается только в этом ответе и далее не может быть получен
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param request body dto.APIKeyRequest true "Название, scope и срок действия ключа"
+// @Success 201 {object} dto.IssuedAPIKey "Выпущенный ключ"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 500 {string} string "Failed to issue api key"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /api-keys/create [post]
+func (api *APIKeyHandler) IssueAPIKey(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "APIKeyHandler.IssueAPIKey")
+	defer span.Finish()
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.APIKeyRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	issued, err := api.APIKeyService.Issue(spanCtx, &req)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "issue_api_key_error"),
+			log.Error(err),
+		)
+		if isValidationError(err) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to issue api key: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(issued)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_api_key"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal api key: %v", err))
+		return
+	}
+	ctx.Response.Header.Set(fasthttp.HeaderCacheControl, "no-store")
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusCreated)
+	ctx.Response.SetBody(data)
+}
+
+func isValidationError(err error) bool {
+	return errors.Is(err, apikeyservice.ErrEmptyName) ||
+		errors.Is(err, apikeyservice.ErrEmptyScopes) ||
+		errors.Is(err, apikeyservice.ErrUnknownScope) ||
+		errors.Is(err, apikeyservice.ErrAlreadyExpired)
+}
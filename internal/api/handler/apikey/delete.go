@@ -0,0 +1,76 @@
+package apikey
+
+import (
+	"Brands/internal/api/handler/utils"
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	apikeyrepo "Brands/internal/repository/apikey"
+)
+
+// RevokeAPIKey godoc
+// @Summary Отзыв API-ключа
+// @Description Отзывает API-ключ; запросы с ним сразу отклоняются
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param id path string true "ID API-ключа"
+// @Success 200 {string} string "API key revoked successfully"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "API key not found"
+// @Failure 500 {string} string "Failed to revoke api key"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /api-keys/revoke/{id} [delete]
+func (api *APIKeyHandler) RevokeAPIKey(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "APIKeyHandler.RevokeAPIKey")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	err = api.APIKeyService.Revoke(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, apikeyrepo.ErrAPIKeyNotFound) {
+			span.LogFields(
+				log.String("event", "api_key_not_found"),
+				log.String("api_key.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("API key not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "revoke_api_key_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to revoke api key: %v", err))
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("API key revoked successfully")
+}
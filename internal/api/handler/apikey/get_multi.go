@@ -0,0 +1,61 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetAllAPIKeys godoc
+// @Summary Получение всех API-ключей
+// @Description Возвращает все выпущенные API-ключи, включая отозванные; секреты не возвращаются
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.APIKey "Список API-ключей"
+// @Failure 500 {string} string "Failed to fetch api keys"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /api-keys/all [get]
+func (api *APIKeyHandler) GetAllAPIKeys(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "APIKeyHandler.GetAllAPIKeys")
+	defer span.Finish()
+
+	keys, err := api.APIKeyService.GetAll(spanCtx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_api_keys"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch api keys: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_api_keys"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal api keys: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,26 @@
+package apikey
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/apikey"
+	"github.com/fasthttp/router"
+)
+
+type APIKeyHandler struct {
+	APIKeyService *apikey.APIKeyService
+}
+
+func New(apiKeyService *apikey.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		APIKeyService: apiKeyService,
+	}
+}
+
+func (api *APIKeyHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	group := r.Group("/api-keys")
+	group.POST("/create", a.Require(auth.ScopeAdmin, api.IssueAPIKey))
+	group.GET("/all", a.Require(auth.ScopeAdmin, api.GetAllAPIKeys))
+	group.POST("/rotate/{id}", a.Require(auth.ScopeAdmin, api.RotateAPIKey))
+	group.DELETE("/revoke/{id}", a.Require(auth.ScopeAdmin, api.RevokeAPIKey))
+}
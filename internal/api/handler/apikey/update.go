@@ -0,0 +1,90 @@
+package apikey
+
+import (
+	"Brands/internal/api/handler/utils"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	apikeyrepo "Brands/internal/repository/apikey"
+)
+
+// RotateAPIKey godoc
+// @Summary Ротация API-ключа
+// @Description Выпускает новый секрет для активного ключа, прежний секрет сразу перестает действовать. Секрет возвращается только в этом ответе
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param id path string true "ID API-ключа"
+// @Success 200 {object} dto.IssuedAPIKey "Ключ с новым секретом"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "API key not found"
+// @Failure 500 {string} string "Failed to rotate api key"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /api-keys/rotate/{id} [post]
+func (api *APIKeyHandler) RotateAPIKey(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "APIKeyHandler.RotateAPIKey")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	issued, err := api.APIKeyService.Rotate(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, apikeyrepo.ErrAPIKeyNotFound) {
+			span.LogFields(
+				log.String("event", "api_key_not_found"),
+				log.String("api_key.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("API key not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "rotate_api_key_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to rotate api key: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(issued)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_api_key"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal api key: %v", err))
+		return
+	}
+	ctx.Response.Header.Set(fasthttp.HeaderCacheControl, "no-store")
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
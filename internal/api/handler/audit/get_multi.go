@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetAuditEvents godoc
+// @Summary Журнал изменений каталога
+// @Description Возвращает события создания, изменения, удаления и восстановления брендов и моделей, от новых к старым
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param entity_id query string false "ID бренда или модели"
+// @Param actor query string false "Автор изменения (actor_guid)"
+// @Param from query string false "Начало периода, RFC 3339"
+// @Param to query string false "Конец периода (не включительно), RFC 3339"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {object} dto.AuditPage "Страница журнала"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 500 {string} string "Failed to fetch audit events"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /audit [get]
+func (api *AuditHandler) GetAuditEvents(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "AuditHandler.GetAuditEvents")
+	defer span.Finish()
+
+	filter, err := parseFilter(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.AuditService.GetAll(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_audit_events"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch audit events: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_audit_events"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal audit events: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
+
+func parseFilter(ctx *fasthttp.RequestCtx) (dto.AuditFilter, error) {
+	var filter dto.AuditFilter
+	var err error
+
+	filter.Limit, filter.Offset, err = utils.ParsePagination(ctx)
+	if err != nil {
+		return filter, err
+	}
+	if raw := ctx.QueryArgs().Peek("entity_id"); len(raw) > 0 {
+		id, err := uuid.ParseBytes(raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid entity_id: %w", err)
+		}
+		filter.EntityID = &id
+	}
+	filter.Actor = string(ctx.QueryArgs().Peek("actor"))
+	if filter.From, err = utils.ParseTimeQuery(ctx, "from"); err != nil {
+		return filter, err
+	}
+	if filter.To, err = utils.ParseTimeQuery(ctx, "to"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/audit"
+	"github.com/fasthttp/router"
+)
+
+type AuditHandler struct {
+	AuditService *audit.AuditService
+}
+
+func New(auditService *audit.AuditService) *AuditHandler {
+	return &AuditHandler{
+		AuditService: auditService,
+	}
+}
+
+func (api *AuditHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/audit", a.Require(auth.ScopeAdmin, api.GetAuditEvents))
+}
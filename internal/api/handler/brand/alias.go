@@ -0,0 +1,307 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	brandservice "Brands/internal/service/brand"
+	"Brands/internal/slug"
+	"Brands/pkg/zerohook"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetBrandAliases godoc
+// @Summary Альтернативные названия бренда
+// @Description Возвращает сокращения, написания на других языках и прежние названия бренда, по которым он находится в фильтре по name и по слагу
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Success 200 {array} dto.BrandAlias "Альтернативные названия"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to fetch brand aliases"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Router /brands/{id}/aliases [get]
+func (api *BrandHandler) GetBrandAliases(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandAliases")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	aliases, err := api.BrandService.Aliases(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, brandrepo.ErrBrandNotFound) {
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "failed_to_fetch_aliases"),
+			log.Error(err),
+			log.String("brand.id", id.String()),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch brand aliases: %v", err))
+		return
+	}
+	writeAlias(ctx, span, aliases)
+}
+
+// CreateBrandAlias godoc
+// @Summary Добавление альтернативного названия бренда
+// @Description Добавляет бренду альтернативное название. Слаг названия строится автоматически и должен быть свободен: он не может совпадать со слагом неудаленного бренда или названием другого бренда
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param alias body dto.BrandAliasRequest true "Альтернативное название"
+// @Success 200 {object} dto.BrandAlias "Добавленное название"
+// @Failure 400 {string} string "Invalid ID format, request body or alias"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 409 {object} dto.ConflictResponse "Alias slug already taken"
+// @Failure 500 {string} string "Failed to create brand alias"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/aliases [post]
+func (api *BrandHandler) CreateBrandAlias(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.CreateBrandAlias")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	alias, ok := decodeAlias(ctx, span)
+	if !ok {
+		return
+	}
+	alias.BrandID = id
+	alias.ID, err = uuid.NewV7()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "new_uuid_error"),
+			log.Error(err),
+		)
+		zerohook.Logger.Error().Err(err).Send()
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		return
+	}
+
+	if err = api.BrandService.CreateAlias(spanCtx, alias); err != nil {
+		writeAliasError(ctx, span, alias, err, "Failed to create brand alias")
+		return
+	}
+	writeAlias(ctx, span, alias)
+}
+
+// UpdateBrandAlias godoc
+// @Summary Изменение альтернативного названия бренда
+// @Description Изменяет альтернативное название бренда; слаг названия строится заново
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param alias_id path string true "ID альтернативного названия"
+// @Param alias body dto.BrandAliasRequest true "Альтернативное название"
+// @Success 200 {object} dto.BrandAlias "Измененное название"
+// @Failure 400 {string} string "Invalid ID format, request body or alias"
+// @Failure 404 {string} string "Brand or alias not found"
+// @Failure 409 {object} dto.ConflictResponse "Alias slug already taken"
+// @Failure 500 {string} string "Failed to update brand alias"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/aliases/{alias_id} [put]
+func (api *BrandHandler) UpdateBrandAlias(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.UpdateBrandAlias")
+	defer span.Finish()
+
+	id, aliasID, ok := extractAliasPath(ctx, span)
+	if !ok {
+		return
+	}
+	alias, ok := decodeAlias(ctx, span)
+	if !ok {
+		return
+	}
+	alias.ID = aliasID
+	alias.BrandID = id
+
+	if err := api.BrandService.UpdateAlias(spanCtx, alias); err != nil {
+		writeAliasError(ctx, span, alias, err, "Failed to update brand alias")
+		return
+	}
+	writeAlias(ctx, span, alias)
+}
+
+// DeleteBrandAlias godoc
+// @Summary Удаление альтернативного названия бренда
+// @Description Удаляет альтернативное название бренда; бренд перестает находиться по нему
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param alias_id path string true "ID альтернативного названия"
+// @Success 200 {string} string "Brand alias deleted successfully"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand or alias not found"
+// @Failure 500 {string} string "Failed to delete brand alias"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/aliases/{alias_id} [delete]
+func (api *BrandHandler) DeleteBrandAlias(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.DeleteBrandAlias")
+	defer span.Finish()
+
+	id, aliasID, ok := extractAliasPath(ctx, span)
+	if !ok {
+		return
+	}
+	alias := &dto.BrandAlias{ID: aliasID, BrandID: id}
+	if err := api.BrandService.DeleteAlias(spanCtx, id, aliasID); err != nil {
+		writeAliasError(ctx, span, alias, err, "Failed to delete brand alias")
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString(fmt.Sprintf("Brand alias deleted successfully with ID: %s", aliasID))
+}
+
+// extractAliasPath извлекает ID бренда и альтернативного названия из пути
+func extractAliasPath(ctx *fasthttp.RequestCtx, span opentracing.Span) (id, aliasID uuid.UUID, ok bool) {
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err == nil {
+		aliasID, err = utils.ExtractUUIDFromPath(ctx, "alias_id")
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return id, aliasID, true
+}
+
+func decodeAlias(ctx *fasthttp.RequestCtx, span opentracing.Span) (*dto.BrandAlias, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.BrandAliasRequest
+	if err := decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return nil, false
+	}
+	return &dto.BrandAlias{Alias: req.Alias}, true
+}
+
+func writeAliasError(ctx *fasthttp.RequestCtx, span opentracing.Span, alias *dto.BrandAlias, err error, msg string) {
+	span.SetTag("error", true)
+	switch {
+	case errors.Is(err, rbac.ErrForbidden):
+		utils.WriteForbidden(ctx, err)
+	case errors.Is(err, slug.ErrConflict):
+		utils.WriteConflict(ctx, err)
+	case errors.Is(err, brandservice.ErrAliasRequired),
+		errors.Is(err, brandservice.ErrAliasTooLong),
+		errors.Is(err, slug.ErrInvalid):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.Is(err, brandrepo.ErrBrandNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", alias.BrandID))
+	case errors.Is(err, brandrepo.ErrAliasNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Brand alias not found with ID: %s", alias.ID))
+	default:
+		span.LogFields(
+			log.String("event", "brand_alias_error"),
+			log.Error(err),
+			log.String("brand.id", alias.BrandID.String()),
+			log.String("alias.id", alias.ID.String()),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func writeAlias(ctx *fasthttp.RequestCtx, span opentracing.Span, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brand aliases: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
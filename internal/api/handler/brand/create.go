@@ -1,7 +1,13 @@
 package brand
 
 import (
+	"Brands/internal/api/handler/utils"
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	brandservice "Brands/internal/service/brand"
+	"Brands/internal/slug"
+	"Brands/pkg/redact"
 	"Brands/pkg/zerohook"
 	"bytes"
 	"context"
@@ -22,9 +28,15 @@ import (
 // @Accept json
 // @Produce json
 // @Param brand body dto.Brand true "Данные нового бренда"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом и телом вернет исходный ответ"
 // @Success 200 {string} string "Brand created successfully"
-// @Failure 400 {string} string "Invalid request body"
+// @Failure 400 {string} string "Invalid request body, slug or parent brand"
+// @Failure 409 {object} dto.ConflictResponse "Slug already taken (JSON), parent brand would form a cycle or Idempotency-Key reused with a different request or still in progress (text)"
 // @Failure 500 {string} string "Failed to create brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
 // @Router /brands/create [post]
 func (api *BrandHandler) CreateBrand(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -70,9 +82,29 @@ func (api *BrandHandler) CreateBrand(ctx *fasthttp.RequestCtx) {
 	err = api.BrandService.Create(spanCtx, &brand)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrInvalid) ||
+			errors.Is(err, brandservice.ErrOwnParent) ||
+			errors.Is(err, brandrepo.ErrParentNotFound) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, brandrepo.ErrHierarchyCycle) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
 		span.LogFields(
 			log.String("event", "create_brand_error"),
-			log.Object("brand", brand),
+			redact.JSONField("brand", brand),
 			log.Error(err),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
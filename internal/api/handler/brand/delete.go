@@ -2,6 +2,8 @@ package brand
 
 import (
 	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
 	"context"
 	"fmt"
 	"net/http"
@@ -16,7 +18,7 @@ import (
 
 // DeleteBrand godoc
 // @Summary Мягкое удаление бренда
-// @Description Выполняет мягкое удаление бренда по его ID
+// @Description Выполняет мягкое удаление бренда по его ID вместе с его моделями
 // @Tags brand
 // @Accept json
 // @Produce json
@@ -24,6 +26,11 @@ import (
 // @Success 200 {string} string "Brand soft-deleted successfully"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 500 {string} string "Failed to delete brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {string} string "Brand has been soft-deleted"
 // @Router /brands/delete/{id} [delete]
 func (api *BrandHandler) DeleteBrand(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -51,6 +58,15 @@ func (api *BrandHandler) DeleteBrand(ctx *fasthttp.RequestCtx) {
 	err = api.BrandService.SoftDelete(spanCtx, id)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, brandrepo.ErrBrandSoftDeleted) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
 		if errors.Is(err, brandrepo.ErrBrandNotFound) {
 			span.LogFields(
 				log.String("event", "brand_not_found"),
@@ -75,7 +91,7 @@ func (api *BrandHandler) DeleteBrand(ctx *fasthttp.RequestCtx) {
 
 // RestoreBrand godoc
 // @Summary Восстановление мягко удалённого бренда
-// @Description Восстановление бренда, который был удалён ранее (мягкое удаление)
+// @Description Восстановление бренда, который был удалён ранее (мягкое удаление), и моделей, удалённых вместе с ним
 // @Tags brand
 // @Accept json
 // @Produce json
@@ -83,9 +99,19 @@ func (api *BrandHandler) DeleteBrand(ctx *fasthttp.RequestCtx) {
 // @Success 200 {string} string "Brand restored successfully"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 500 {string} string "Failed to restore brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {object} dto.ConflictResponse "Slug taken by another record while this one was deleted (JSON) or brand is not deleted (text)"
 // @Router /brands/restore/{id} [post]
 func (api *BrandHandler) RestoreBrand(ctx *fasthttp.RequestCtx) {
-	spanCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	spanCtx, cancel := context.WithTimeout(spanCtx, 5*time.Second)
 	defer cancel()
 	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.RestoreBrand")
 	defer span.Finish()
@@ -105,6 +131,20 @@ func (api *BrandHandler) RestoreBrand(ctx *fasthttp.RequestCtx) {
 
 	err = api.BrandService.Restore(spanCtx, id)
 	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if errors.Is(err, brandrepo.ErrBrandNotInTrash) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
 		if errors.Is(err, brandrepo.ErrBrandNotFound) {
 			span.LogFields(
 				log.String("event", "brand_not_found"),
@@ -1,9 +1,11 @@
 package brand
 
 import (
+	"Brands/pkg/redact"
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
 	"github.com/valyala/fasthttp"
@@ -18,6 +20,8 @@ import (
 // @Tags brand
 // @Accept json
 // @Produce json
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {array} dto.Brand "Список брендов"
 // @Failure 500 {string} string "Failed to fetch brand"
 // @Router /brands/all [get]
@@ -28,6 +32,7 @@ func (api *BrandHandler) GetAllBrands(ctx *fasthttp.RequestCtx) {
 		spanCtx = ctx
 	}
 	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetAllBrands")
+	defer span.Finish()
 
 	brands, err := api.BrandService.GetAll(spanCtx)
 	if err != nil {
@@ -47,7 +52,7 @@ func (api *BrandHandler) GetAllBrands(ctx *fasthttp.RequestCtx) {
 		span.SetTag("error", true)
 		span.LogFields(
 			log.String("event", "failed_to_marshal_brands"),
-			log.Object("brand", brands),
+			redact.JSONField("brand", brands),
 			log.Error(err),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
@@ -64,13 +69,16 @@ func (api *BrandHandler) GetAllBrands(ctx *fasthttp.RequestCtx) {
 // @Tags brand
 // @Accept json
 // @Produce json
-// @Param name query string false "Фильтр по имени бренда"
+// @Param name query string false "Фильтр по имени бренда или его альтернативному названию"
 // @Param origin_country query string false "Фильтр по стране происхождения"
 // @Param popularity query integer false "Фильтр по популярности (целое число)"
 // @Param is_premium query boolean false "Фильтр по признаку премиум-бренда"
 // @Param is_upcoming query boolean false "Фильтр по признаку предстоящего бренда"
 // @Param founded_year query integer false "Фильтр по году основания"
+// @Param parent_id query string false "Бренды всех уровней ниже родительского бренда"
 // @Param sort query string false "Поле сортировки (например, 'name', '-popularity')"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {array} dto.Brand "Список брендов"
 // @Failure 500 {string} string "Failed to fetch brands"
 // @Router /brands/filter [get]
@@ -117,6 +125,19 @@ func (api *BrandHandler) BrandsFilter(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
+	if parentID := ctx.QueryArgs().Peek("parent_id"); len(parentID) > 0 {
+		if parentVal, err := uuid.ParseBytes(parentID); err == nil {
+			filter["parent_id"] = parentVal
+		} else {
+			message := "Invalid parent_id value"
+			span.SetTag("error", true)
+			span.LogFields(log.String("err", message))
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(message)
+			return
+		}
+	}
+
 	sort := string(ctx.QueryArgs().Peek("sort"))
 	if sort != "" {
 		validSortFields := map[string]bool{
@@ -138,7 +159,7 @@ func (api *BrandHandler) BrandsFilter(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
-	brands, err := api.BrandService.BrandsFilter(ctx, filter, sort)
+	brands, err := api.BrandService.BrandsFilter(spanCtx, filter, sort)
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogFields(
@@ -156,7 +177,7 @@ func (api *BrandHandler) BrandsFilter(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "json_marshal_error"),
 			log.Error(err),
-			log.Object("brands", brands),
+			redact.JSONField("brands", brands),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brands data: %v", err))
@@ -2,7 +2,9 @@ package brand
 
 import (
 	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
 	brandrepo "Brands/internal/repository/brand"
+	"Brands/pkg/redact"
 	"context"
 	"encoding/json"
 	"errors"
@@ -20,6 +22,9 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path string true "ID бренда"
+// @Param as_of query string false "Вернуть бренд в состоянии на момент времени, RFC 3339"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {object} dto.Brand "Бренд найден"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 404 {string} string "Brand not found"
@@ -46,7 +51,24 @@ func (api *BrandHandler) GetBrandByID(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	brand, err := api.BrandService.GetByID(spanCtx, id)
+	asOf, err := utils.ParseTimeQuery(ctx, "as_of")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_as_of"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	var brand *dto.Brand
+	if asOf != nil {
+		brand, err = api.BrandService.GetAsOf(spanCtx, id, *asOf)
+	} else {
+		brand, err = api.BrandService.GetByID(spanCtx, id)
+	}
 	if err != nil {
 		span.SetTag("error", true)
 		if errors.Is(err, brandrepo.ErrBrandNotFound) {
@@ -74,7 +96,7 @@ func (api *BrandHandler) GetBrandByID(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "json_marshal_error"),
 			log.Error(err),
-			log.Object("brand", brand),
+			redact.JSONField("brand", brand),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brand data: %v", err))
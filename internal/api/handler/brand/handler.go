@@ -2,27 +2,43 @@ package brand
 
 import (
 	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/idempotency"
 	"Brands/internal/service/brand"
 	"github.com/fasthttp/router"
 )
 
 type BrandHandler struct {
 	BrandService *brand.BrandService
+	Idempotency  *idempotency.Keeper
 }
 
-func New(brandService *brand.BrandService) *BrandHandler {
+func New(brandService *brand.BrandService, keeper *idempotency.Keeper) *BrandHandler {
 	return &BrandHandler{
 		BrandService: brandService,
+		Idempotency:  keeper,
 	}
 }
 
-func (api *BrandHandler) SetupRoutes(r *router.Router) {
+func (api *BrandHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
 	group := r.Group("/brands")
-	group.POST("/create", api.CreateBrand)
-	group.GET("/{id}", api.GetBrandByID)
-	group.GET("/filter", api.BrandsFilter)
-	group.GET("/all", api.GetAllBrands)
-	group.PUT("/update/{id}", api.UpdateBrand)
-	group.DELETE("/delete/{id}", api.DeleteBrand)
-	group.POST("/restore/{id}", api.RestoreBrand)
+	group.POST("/create", a.Require(auth.ScopeWrite, api.Idempotency.Wrap(api.CreateBrand)))
+	group.GET("/{id}", a.Require(auth.ScopeRead, api.GetBrandByID))
+	group.GET("/by-slug/{slug}", a.Require(auth.ScopeRead, api.GetBrandBySlug))
+	group.GET("/{id}/revisions", a.Require(auth.ScopeRead, api.GetBrandRevisions))
+	group.POST("/{id}/revisions/{rev}/revert", a.Require(auth.ScopeWrite, api.RevertBrandRevision))
+	group.GET("/filter", a.Require(auth.ScopeRead, api.BrandsFilter))
+	group.GET("/all", a.Require(auth.ScopeRead, api.GetAllBrands))
+	group.PUT("/update/{id}", a.Require(auth.ScopeWrite, api.UpdateBrand))
+	group.DELETE("/delete/{id}", a.Require(auth.ScopeWrite, api.DeleteBrand))
+	group.POST("/restore/{id}", a.Require(auth.ScopeAdmin, api.RestoreBrand))
+	group.GET("/trash", a.Require(auth.ScopeWrite, api.GetBrandTrash))
+	group.DELETE("/{id}/purge", a.Require(auth.ScopeAdmin, api.PurgeBrand))
+	group.POST("/{id}/merge", a.Require(auth.ScopeAdmin, api.MergeBrand))
+	group.GET("/{id}/children", a.Require(auth.ScopeRead, api.GetBrandChildren))
+	group.GET("/{id}/ancestors", a.Require(auth.ScopeRead, api.GetBrandAncestors))
+	group.GET("/{id}/aliases", a.Require(auth.ScopeRead, api.GetBrandAliases))
+	group.POST("/{id}/aliases", a.Require(auth.ScopeWrite, api.CreateBrandAlias))
+	group.PUT("/{id}/aliases/{alias_id}", a.Require(auth.ScopeWrite, api.UpdateBrandAlias))
+	group.DELETE("/{id}/aliases/{alias_id}", a.Require(auth.ScopeWrite, api.DeleteBrandAlias))
 }
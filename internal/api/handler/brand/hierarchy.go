@@ -0,0 +1,157 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	brandrepo "Brands/internal/repository/brand"
+	brandservice "Brands/internal/service/brand"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+	"strconv"
+)
+
+// GetBrandChildren godoc
+// @Summary Дочерние бренды
+// @Description Возвращает неудаленные бренды ниже бренда до depth уровней в порядке обхода дерева: за каждым брендом следуют его потомки. Ветка ниже удаленного бренда не возвращается
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param depth query integer false "Число уровней: 1 — только дочерние бренды (по умолчанию), максимум 32"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {array} dto.BrandNode "Бренды с уровнем относительно запрошенного"
+// @Failure 400 {string} string "Invalid ID format or depth"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to fetch brand children"
+// @Router /brands/{id}/children [get]
+func (api *BrandHandler) GetBrandChildren(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandChildren")
+	defer span.Finish()
+
+	id, ok := extractHierarchyID(ctx, span)
+	if !ok {
+		return
+	}
+	depth := 1
+	if raw := ctx.QueryArgs().Peek("depth"); len(raw) > 0 {
+		var err error
+		if depth, err = strconv.Atoi(string(raw)); err != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "invalid_depth"),
+				log.Error(err),
+			)
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString("Invalid depth value")
+			return
+		}
+	}
+
+	nodes, err := api.BrandService.Children(spanCtx, id, depth)
+	if err != nil {
+		writeHierarchyError(ctx, span, id, err, "Failed to fetch brand children")
+		return
+	}
+	writeHierarchy(ctx, span, nodes)
+}
+
+// GetBrandAncestors godoc
+// @Summary Родительские бренды
+// @Description Возвращает цепочку родительских брендов от корня до непосредственного родителя. Цепочка обрывается на удаленном бренде
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {array} dto.BrandNode "Бренды с уровнем относительно запрошенного, от корня"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to fetch brand ancestors"
+// @Router /brands/{id}/ancestors [get]
+func (api *BrandHandler) GetBrandAncestors(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandAncestors")
+	defer span.Finish()
+
+	id, ok := extractHierarchyID(ctx, span)
+	if !ok {
+		return
+	}
+	nodes, err := api.BrandService.Ancestors(spanCtx, id)
+	if err != nil {
+		writeHierarchyError(ctx, span, id, err, "Failed to fetch brand ancestors")
+		return
+	}
+	writeHierarchy(ctx, span, nodes)
+}
+
+// extractHierarchyID извлекает ID бренда из пути; при ошибке отвечает 400
+func extractHierarchyID(ctx *fasthttp.RequestCtx, span opentracing.Span) (uuid.UUID, bool) {
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func writeHierarchyError(ctx *fasthttp.RequestCtx, span opentracing.Span, id uuid.UUID, err error, msg string) {
+	span.SetTag("error", true)
+	switch {
+	case errors.Is(err, brandservice.ErrInvalidDepth):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Depth must be between 1 and %d", brandrepo.MaxHierarchyDepth))
+	case errors.Is(err, brandrepo.ErrBrandNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+	default:
+		span.LogFields(
+			log.String("event", "brand_hierarchy_error"),
+			log.Error(err),
+			log.String("brand.id", id.String()),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func writeHierarchy(ctx *fasthttp.RequestCtx, span opentracing.Span, nodes []dto.BrandNode) {
+	data, err := json.Marshal(nodes)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brand hierarchy: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,120 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	brandservice "Brands/internal/service/brand"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// MergeBrand godoc
+// @Summary Слияние бренда-дубликата
+// @Description Сливает бренд source_id с брендом из пути в одной транзакции: пустые (или, по стратегии prefer_source, все) поля цели получают значения источника, модели, альтернативные названия и дочерние бренды источника переносятся в цель (цель, бывшая дочерним брендом источника, получает его родителя), название источника становится альтернативным названием цели, источник мягко удаляется с отметкой merged_into, а его слаги перенаправляют на цель. Модель, слаг которой в цели уже занят, получает слаг с суффиксом. С dry_run возвращает предпросмотр без изменений
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID целевого бренда"
+// @Param merge body dto.BrandMergeRequest true "Параметры слияния"
+// @Success 200 {object} dto.BrandMergeResult "Результат слияния или предпросмотр"
+// @Failure 400 {string} string "Invalid request body, strategy or field, source brand not found"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 409 {string} string "Target brand is a deeper descendant of the source"
+// @Failure 500 {string} string "Failed to merge brands"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/merge [post]
+func (api *BrandHandler) MergeBrand(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.MergeBrand")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.BrandMergeRequest
+	if err = decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	result, err := api.BrandService.Merge(spanCtx, id, req)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, rbac.ErrForbidden):
+			utils.WriteForbidden(ctx, err)
+		case errors.Is(err, brandservice.ErrMergeSourceRequired),
+			errors.Is(err, brandservice.ErrMergeIntoSelf),
+			errors.Is(err, brandservice.ErrUnknownMergeStrategy),
+			errors.Is(err, brandservice.ErrUnknownMergeField):
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+		case errors.Is(err, brandrepo.ErrMergeSourceNotFound):
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(fmt.Sprintf("Source brand not found with ID: %s", req.SourceID))
+		case errors.Is(err, brandrepo.ErrHierarchyCycle):
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString("Target brand is nested below a child of the source brand")
+		case errors.Is(err, brandrepo.ErrBrandNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+		default:
+			span.LogFields(
+				log.String("event", "merge_brand_error"),
+				log.Error(err),
+				log.String("brand.id", id.String()),
+				log.String("source.id", req.SourceID.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to merge brands: %v", err))
+		}
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal merge result: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,192 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
+	"Brands/internal/repository/revision"
+	"Brands/internal/slug"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	brandrepo "Brands/internal/repository/brand"
+)
+
+// GetBrandRevisions godoc
+// @Summary История версий бренда
+// @Description Возвращает сохраненные версии бренда от новых к старым
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {array} dto.Revision "Версии бренда"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to fetch revisions"
+// @Router /brands/{id}/revisions [get]
+func (api *BrandHandler) GetBrandRevisions(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandRevisions")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	limit, offset, err := utils.ParsePagination(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_pagination"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	revisions, err := api.BrandService.Revisions(spanCtx, id, limit, offset)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, brandrepo.ErrBrandNotFound) {
+			span.LogFields(
+				log.String("event", "brand_not_found"),
+				log.String("brand.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "get_revisions_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch revisions: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(revisions)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal revisions: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
+
+// RevertBrandRevision godoc
+// @Summary Откат бренда к версии
+// @Description Восстанавливает поля бренда из указанной версии через обычное обновление: с проверкой прав, записью аудита и созданием новой версии
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param rev path integer true "Номер версии"
+// @Success 200 {object} dto.Brand "Brand после отката"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Revision not found"
+// @Failure 500 {string} string "Failed to revert brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {object} dto.ConflictResponse "Slug of the revision is taken by another record"
+// @Router /brands/{id}/revisions/{rev}/revert [post]
+func (api *BrandHandler) RevertBrandRevision(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.RevertBrandRevision")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	rev, err := utils.ExtractPositiveIntFromPath(ctx, "rev")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_revision"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	brand, err := api.BrandService.Revert(spanCtx, id, rev)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, rbac.ErrForbidden):
+			utils.WriteForbidden(ctx, err)
+		case errors.Is(err, slug.ErrConflict):
+			utils.WriteConflict(ctx, err)
+		case errors.Is(err, revision.ErrRevisionNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Revision %d not found for brand %s", rev, id))
+		case errors.Is(err, brandrepo.ErrBrandNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+		default:
+			span.LogFields(
+				log.String("event", "revert_brand_error"),
+				log.Error(err),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to revert brand: %v", err))
+		}
+		return
+	}
+
+	data, err := json.Marshal(brand)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brand data: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,89 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	brandrepo "Brands/internal/repository/brand"
+	"Brands/internal/slug"
+	"Brands/pkg/redact"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetBrandBySlug godoc
+// @Summary Получение бренда по слагу
+// @Description Получение бренда по слагу. Запрос по прежнему слагу бренда, переименованного после публикации ссылки, или по слагу его альтернативного названия перенаправляется на актуальный адрес
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param slug path string true "Слаг бренда"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {object} dto.Brand "Бренд найден"
+// @Success 301 {string} string "Слаг устарел, актуальный адрес в заголовке Location"
+// @Failure 400 {string} string "Invalid slug format"
+// @Failure 404 {string} string "Brand not found"
+// @Router /brands/by-slug/{slug} [get]
+func (api *BrandHandler) GetBrandBySlug(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandBySlug")
+	defer span.Finish()
+
+	value, _ := ctx.UserValue("slug").(string)
+	brand, err := api.BrandService.GetBySlug(spanCtx, value)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, slug.ErrInvalid):
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString("Invalid slug format")
+		case errors.Is(err, brandrepo.ErrBrandNotFound):
+			span.LogFields(
+				log.String("event", "brand_not_found"),
+				log.String("brand.slug", value),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with slug: %s", value))
+		default:
+			span.LogFields(
+				log.String("event", "get_brand_error"),
+				log.Error(err),
+				log.String("brand.slug", value),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to get brand: %v", err))
+		}
+		return
+	}
+
+	if brand.Slug != value {
+		utils.RedirectPermanent(ctx, "/brands/by-slug/"+brand.Slug)
+		return
+	}
+
+	data, err := json.Marshal(brand)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+			redact.JSONField("brand", brand),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal brand data: %v", err))
+		return
+	}
+
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,151 @@
+package brand
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetBrandTrash godoc
+// @Summary Корзина брендов
+// @Description Возвращает мягко удалённые бренды с временем и автором удаления, от недавно удалённых к давним
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {object} dto.BrandTrashPage "Страница корзины"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 500 {string} string "Failed to fetch deleted brands"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /brands/trash [get]
+func (api *BrandHandler) GetBrandTrash(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.GetBrandTrash")
+	defer span.Finish()
+
+	var filter dto.TrashFilter
+	var err error
+	filter.Limit, filter.Offset, err = utils.ParsePagination(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.BrandService.Trash(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_trash"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch deleted brands: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal deleted brands: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
+
+// PurgeBrand godoc
+// @Summary Безвозвратное удаление бренда
+// @Description Безвозвратно удаляет бренд из корзины вместе со всеми его моделями, их версиями и историей слагов. Журнал аудита сохраняется. Бренд должен быть предварительно мягко удалён
+// @Tags brand
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Success 200 {string} string "Brand purged successfully"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 409 {string} string "Brand is not deleted"
+// @Failure 500 {string} string "Failed to purge brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/purge [delete]
+func (api *BrandHandler) PurgeBrand(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "BrandHandler.PurgeBrand")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	models, err := api.BrandService.Purge(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, rbac.ErrForbidden):
+			utils.WriteForbidden(ctx, err)
+		case errors.Is(err, brandrepo.ErrBrandNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+		case errors.Is(err, brandrepo.ErrBrandNotDeleted):
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+		default:
+			span.LogFields(
+				log.String("event", "purge_brand_error"),
+				log.Error(err),
+				log.String("brand.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to purge brand: %v", err))
+		}
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString(fmt.Sprintf("Brand purged successfully with %d models", models))
+}
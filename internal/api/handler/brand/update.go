@@ -3,7 +3,11 @@ package brand
 import (
 	"Brands/internal/api/handler/utils"
 	"Brands/internal/dto"
-	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	brandservice "Brands/internal/service/brand"
+	"Brands/internal/slug"
+	"Brands/pkg/redact"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -24,9 +28,14 @@ import (
 // @Param id path string true "ID бренда (UUIDv7)"
 // @Param brand body dto.Brand true "Обновлённые данные бренда"
 // @Success 200 {string} string "Brand updated successfully"
-// @Failure 400 {string} string "Invalid request body"
+// @Failure 400 {string} string "Invalid request body, slug or parent brand, or a localized brand"
+// @Failure 409 {object} dto.ConflictResponse "Slug already taken (JSON) or parent brand would form a cycle (text)"
 // @Failure 404 {string} string "Brand not found"
 // @Failure 500 {string} string "Failed to update brand"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
 // @Router /brands/update/{id} [put]
 func (api *BrandHandler) UpdateBrand(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -73,10 +82,31 @@ func (api *BrandHandler) UpdateBrand(ctx *fasthttp.RequestCtx) {
 	err = api.BrandService.Update(spanCtx, &brand)
 	if err != nil {
 		span.SetTag("error", true)
-		if errors.Is(err, modelrepo.ErrModelNotFound) {
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrInvalid) ||
+			errors.Is(err, brandservice.ErrOwnParent) ||
+			errors.Is(err, brandservice.ErrLocalized) ||
+			errors.Is(err, brandrepo.ErrParentNotFound) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, brandrepo.ErrHierarchyCycle) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, brandrepo.ErrBrandNotFound) {
 			span.LogFields(
 				log.String("event", "brand_not_found"),
-				log.String("model.id", brand.ID.String()),
+				log.String("brand.id", brand.ID.String()),
 			)
 			ctx.Response.SetStatusCode(http.StatusNotFound)
 			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", brand.ID.String()))
@@ -85,7 +115,7 @@ func (api *BrandHandler) UpdateBrand(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "update_brand_error"),
 			log.Error(err),
-			log.Object("brand", brand),
+			redact.JSONField("brand", brand),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to update brand: %v", err))
@@ -0,0 +1,22 @@
+package changes
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/changefeed"
+	"github.com/fasthttp/router"
+)
+
+type ChangesHandler struct {
+	Hub *changefeed.Hub
+}
+
+func New(hub *changefeed.Hub) *ChangesHandler {
+	return &ChangesHandler{
+		Hub: hub,
+	}
+}
+
+func (api *ChangesHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/changes/stream", a.Require(auth.ScopeRead, api.StreamChanges))
+}
@@ -0,0 +1,216 @@
+package changes
+
+import (
+	"Brands/internal/changefeed"
+	"Brands/internal/dto"
+	"Brands/internal/repository/outbox"
+	"Brands/pkg/zerohook"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const lastEventIDHeader = "Last-Event-ID"
+
+// StreamChanges godoc
+// @Summary Лента изменений брендов и моделей (SSE)
+// @Description Поток Server-Sent Events с событиями brand.* и model.*. Поле id события — sequence; при переподключении с заголовком Last-Event-ID (или параметром last_event_id) поток продолжается с места разрыва. Без него передаются только новые события. Пустой поток поддерживается комментариями-пульсами. Клиент, не успевающий читать, отключается событием error и должен переподключиться с Last-Event-ID
+// @Tags changes
+// @Produce text/event-stream
+// @Param types query string false "Типы событий через запятую, например brand.created,model.updated"
+// @Param brand_id query string false "Только события бренда и его моделей"
+// @Param last_event_id query integer false "Sequence последнего полученного события, если нельзя передать заголовок"
+// @Param Last-Event-ID header integer false "Sequence последнего полученного события"
+// @Success 200 {object} dto.OutboxEvent "Поток событий; data каждого события имеет эту схему"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 503 {string} string "Change feed unavailable"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /changes/stream [get]
+func (api *ChangesHandler) StreamChanges(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "ChangesHandler.StreamChanges")
+	defer span.Finish()
+
+	filter, lastEventID, err := parseRequest(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	sub, err := api.Hub.Subscribe(filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "subscribe_error"),
+			log.Error(err),
+		)
+		if errors.Is(err, changefeed.ErrTooManySubscribers) {
+			ctx.Response.Header.Set(fasthttp.HeaderRetryAfter, strconv.Itoa(int(api.Hub.Config().Retry.Seconds())+1))
+		}
+		ctx.Response.SetStatusCode(http.StatusServiceUnavailable)
+		ctx.Response.SetBodyString(fmt.Sprintf("Change feed unavailable: %v", err))
+		return
+	}
+	span.SetTag("changes.last_event_id", lastEventID)
+
+	ctx.SetContentType("text/event-stream")
+	ctx.Response.Header.Set(fasthttp.HeaderCacheControl, "no-cache")
+	ctx.Response.Header.Set(fasthttp.HeaderConnection, "keep-alive")
+	// Отключает буферизацию ответа в nginx
+	ctx.Response.Header.Set("X-Accel-Buffering", "no")
+	ctx.Response.SetStatusCode(http.StatusOK)
+
+	// Тело пишется после возврата из обработчика, поэтому ctx запроса
+	// внутри не используется; логгер получает только request-id
+	logCtx := context.WithValue(context.Background(), "request-id", ctx.UserValue("request-id"))
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer api.Hub.Unsubscribe(sub)
+		begin := time.Now()
+		sent, err := api.stream(w, sub, lastEventID)
+		zerohook.Logger.Info().
+			Ctx(logCtx).
+			Err(err).
+			Int("events_sent", sent).
+			Dur("duration", time.Since(begin)).
+			Msg("Change feed stream closed")
+	})
+}
+
+// stream пишет события подписки, пока клиент на связи. Если клиент передал
+// Last-Event-ID (lastEventID >= 0), сначала дочитываются сохраненные события,
+// затем из очереди пропускаются уже отправленные.
+func (api *ChangesHandler) stream(w *bufio.Writer, sub *changefeed.Subscription, lastEventID int64) (int, error) {
+	cfg := api.Hub.Config()
+	sent := 0
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", cfg.Retry.Milliseconds()); err != nil {
+		return sent, err
+	}
+
+	cursor := max(lastEventID, 0)
+	if lastEventID >= 0 {
+		var err error
+		cursor, err = api.Hub.Replay(sub.Context(), lastEventID, sub.Filter(), func(event dto.OutboxEvent) error {
+			sent++
+			return writeEvent(w, event)
+		})
+		if err != nil {
+			_ = writeError(w, err)
+			return sent, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return sent, err
+	}
+
+	heartbeat := time.NewTicker(cfg.Heartbeat)
+	defer heartbeat.Stop()
+	events := sub.Events()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				if err := sub.Err(); err != nil {
+					_ = writeError(w, err)
+					return sent, err
+				}
+				return sent, nil
+			}
+			if event.Sequence <= cursor {
+				continue
+			}
+			if err := writeEvent(w, event); err != nil {
+				return sent, err
+			}
+			sent++
+			cursor = event.Sequence
+			// Пачка событий отправляется одним сбросом буфера
+			if len(events) > 0 {
+				continue
+			}
+		case <-heartbeat.C:
+			if _, err := w.WriteString(": heartbeat\n\n"); err != nil {
+				return sent, err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return sent, err
+		}
+	}
+}
+
+func writeEvent(w *bufio.Writer, event dto.OutboxEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode change event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.EventType, data)
+	return err
+}
+
+func writeError(w *bufio.Writer, reason error) error {
+	data, _ := json.Marshal(map[string]string{"error": reason.Error()})
+	if _, err := fmt.Fprintf(w, "event: error\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// parseRequest извлекает фильтр и позицию возобновления из запроса;
+// без Last-Event-ID позиция равна -1
+func parseRequest(ctx *fasthttp.RequestCtx) (changefeed.Filter, int64, error) {
+	var filter changefeed.Filter
+	if raw := string(ctx.QueryArgs().Peek("types")); raw != "" {
+		filter.EventTypes = make(map[string]struct{})
+		for _, eventType := range strings.Split(raw, ",") {
+			eventType = strings.TrimSpace(eventType)
+			if !outbox.KnownEventType(eventType) {
+				return filter, 0, fmt.Errorf("unknown event type: %s", eventType)
+			}
+			filter.EventTypes[eventType] = struct{}{}
+		}
+	}
+	if raw := ctx.QueryArgs().Peek("brand_id"); len(raw) > 0 {
+		brandID, err := uuid.ParseBytes(raw)
+		if err != nil {
+			return filter, 0, fmt.Errorf("invalid brand_id: %w", err)
+		}
+		filter.BrandID = &brandID
+	}
+
+	raw := ctx.Request.Header.Peek(lastEventIDHeader)
+	if len(raw) == 0 {
+		raw = ctx.QueryArgs().Peek("last_event_id")
+	}
+	lastEventID := int64(-1)
+	if len(raw) > 0 {
+		var err error
+		lastEventID, err = strconv.ParseInt(string(raw), 10, 64)
+		if err != nil || lastEventID < 0 {
+			return filter, 0, fmt.Errorf("invalid Last-Event-ID: must be a non-negative integer")
+		}
+	}
+	return filter, lastEventID, nil
+}
@@ -0,0 +1,105 @@
+package duplicate
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/service/duplicate"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// DismissBrandDuplicates godoc
+// @Summary Отклонение возможных дубликатов брендов
+// @Description Отмечает открытые пары, обе записи которых перечислены в ids, как не являющиеся дубликатами. Отклоненные пары не предлагаются повторно
+// @Tags duplicates
+// @Accept json
+// @Produce json
+// @Param dismiss body dto.DuplicateDismissRequest true "ID брендов группы или ее части"
+// @Success 200 {object} dto.DuplicateDismissResponse "Число отклоненных пар"
+// @Failure 400 {string} string "Invalid request body or fewer than two ids"
+// @Failure 404 {string} string "No open duplicate candidates among given ids"
+// @Failure 500 {string} string "Failed to dismiss duplicates"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /brands/duplicates/dismiss [post]
+func (api *DuplicateHandler) DismissBrandDuplicates(ctx *fasthttp.RequestCtx) {
+	api.dismiss(ctx, "DuplicateHandler.DismissBrandDuplicates", api.DuplicateService.DismissBrands)
+}
+
+// DismissModelDuplicates godoc
+// @Summary Отклонение возможных дубликатов моделей
+// @Description Отмечает открытые пары, обе записи которых перечислены в ids, как не являющиеся дубликатами. Отклоненные пары не предлагаются повторно
+// @Tags duplicates
+// @Accept json
+// @Produce json
+// @Param dismiss body dto.DuplicateDismissRequest true "ID моделей группы или ее части"
+// @Success 200 {object} dto.DuplicateDismissResponse "Число отклоненных пар"
+// @Failure 400 {string} string "Invalid request body or fewer than two ids"
+// @Failure 404 {string} string "No open duplicate candidates among given ids"
+// @Failure 500 {string} string "Failed to dismiss duplicates"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /models/duplicates/dismiss [post]
+func (api *DuplicateHandler) DismissModelDuplicates(ctx *fasthttp.RequestCtx) {
+	api.dismiss(ctx, "DuplicateHandler.DismissModelDuplicates", api.DuplicateService.DismissModels)
+}
+
+func (api *DuplicateHandler) dismiss(
+	ctx *fasthttp.RequestCtx,
+	operation string,
+	dismiss func(ctx context.Context, ids []uuid.UUID) (int, error),
+) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, operation)
+	defer span.Finish()
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.DuplicateDismissRequest
+	if err := decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	dismissed, err := dismiss(spanCtx, req.IDs)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, duplicate.ErrTooFewIDs):
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+		case errors.Is(err, duplicate.ErrNothingToDismiss):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(err.Error())
+		default:
+			span.LogFields(
+				log.String("event", "dismiss_duplicates_error"),
+				log.Error(err),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to dismiss duplicates: %v", err))
+		}
+		return
+	}
+	writeJSON(ctx, span, dto.DuplicateDismissResponse{Dismissed: dismissed})
+}
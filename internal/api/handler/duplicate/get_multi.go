@@ -0,0 +1,169 @@
+package duplicate
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+	"strconv"
+)
+
+// GetBrandDuplicates godoc
+// @Summary Возможные дубликаты брендов
+// @Description Возвращает группы брендов, похожих на дубликаты, по убыванию оценки. Пары находит периодическая задача по нормализованным названиям, триграммному сходству, совпадающим link и logo_url, году основания и стране. Группу можно слить через POST /brands/{id}/merge или отклонить
+// @Tags duplicates
+// @Accept json
+// @Produce json
+// @Param min_score query number false "Минимальная оценка пары (0..1)"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {object} dto.BrandDuplicatePage "Страница групп"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 500 {string} string "Failed to fetch brand duplicates"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /brands/duplicates [get]
+func (api *DuplicateHandler) GetBrandDuplicates(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "DuplicateHandler.GetBrandDuplicates")
+	defer span.Finish()
+
+	filter, err := parseFilter(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.DuplicateService.BrandGroups(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_duplicates"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch brand duplicates: %v", err))
+		return
+	}
+	writeJSON(ctx, span, page)
+}
+
+// GetModelDuplicates godoc
+// @Summary Возможные дубликаты моделей
+// @Description Возвращает группы моделей одного бренда, похожих на дубликаты, по убыванию оценки. Пары находит периодическая задача по нормализованным названиям, триграммному сходству и дате релиза
+// @Tags duplicates
+// @Accept json
+// @Produce json
+// @Param brand_id query string false "Только модели бренда"
+// @Param min_score query number false "Минимальная оценка пары (0..1)"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {object} dto.ModelDuplicatePage "Страница групп"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 500 {string} string "Failed to fetch model duplicates"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /models/duplicates [get]
+func (api *DuplicateHandler) GetModelDuplicates(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "DuplicateHandler.GetModelDuplicates")
+	defer span.Finish()
+
+	filter, err := parseModelFilter(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.DuplicateService.ModelGroups(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_duplicates"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch model duplicates: %v", err))
+		return
+	}
+	writeJSON(ctx, span, page)
+}
+
+func parseFilter(ctx *fasthttp.RequestCtx) (dto.DuplicateFilter, error) {
+	var filter dto.DuplicateFilter
+	var err error
+
+	filter.Limit, filter.Offset, err = utils.ParsePagination(ctx)
+	if err != nil {
+		return filter, err
+	}
+	if raw := ctx.QueryArgs().Peek("min_score"); len(raw) > 0 {
+		filter.MinScore, err = strconv.ParseFloat(string(raw), 64)
+		if err != nil || filter.MinScore < 0 || filter.MinScore > 1 {
+			return filter, fmt.Errorf("invalid min_score: must be between 0 and 1")
+		}
+	}
+	return filter, nil
+}
+
+func parseModelFilter(ctx *fasthttp.RequestCtx) (dto.DuplicateFilter, error) {
+	filter, err := parseFilter(ctx)
+	if err != nil {
+		return filter, err
+	}
+	if raw := ctx.QueryArgs().Peek("brand_id"); len(raw) > 0 {
+		id, err := uuid.ParseBytes(raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid brand_id: %w", err)
+		}
+		filter.BrandID = &id
+	}
+	return filter, nil
+}
+
+func writeJSON(ctx *fasthttp.RequestCtx, span opentracing.Span, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal response: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,25 @@
+package duplicate
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/duplicate"
+	"github.com/fasthttp/router"
+)
+
+type DuplicateHandler struct {
+	DuplicateService *duplicate.DuplicateService
+}
+
+func New(duplicateService *duplicate.DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{
+		DuplicateService: duplicateService,
+	}
+}
+
+func (api *DuplicateHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/brands/duplicates", a.Require(auth.ScopeWrite, api.GetBrandDuplicates))
+	r.POST("/brands/duplicates/dismiss", a.Require(auth.ScopeWrite, api.DismissBrandDuplicates))
+	r.GET("/models/duplicates", a.Require(auth.ScopeWrite, api.GetModelDuplicates))
+	r.POST("/models/duplicates/dismiss", a.Require(auth.ScopeWrite, api.DismissModelDuplicates))
+}
@@ -1,7 +1,12 @@
 package model
 
 import (
+	"Brands/internal/api/handler/utils"
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	"Brands/internal/slug"
+	"Brands/pkg/redact"
 	"Brands/pkg/zerohook"
 	"bytes"
 	"context"
@@ -10,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
 	"net/http"
 )
@@ -21,9 +27,15 @@ import (
 // @Accept json
 // @Produce json
 // @Param model body dto.Model true "Данные новой модели"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом и телом вернет исходный ответ"
 // @Success 200 {string} string "Model created successfully"
-// @Failure 400 {string} string "Invalid request body"
+// @Failure 400 {object} dto.SpecsErrorResponse "Specs do not match the category schema (JSON); invalid request body or slug, brand not found or deleted, unknown category (text)"
+// @Failure 409 {object} dto.ConflictResponse "Slug already taken (JSON) or Idempotency-Key reused with a different request or still in progress, category schema changed during the request (text)"
 // @Failure 500 {string} string "Failed to create model"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
 // @Router /models/create [post]
 func (api *ModelHandler) CreateModel(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -50,7 +62,7 @@ func (api *ModelHandler) CreateModel(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "new_uuid_error"),
 			log.Error(err),
-			log.Object("model", model),
+			redact.JSONField("model", model),
 		)
 		zerohook.Logger.Error().Err(err).Send()
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
@@ -60,9 +72,34 @@ func (api *ModelHandler) CreateModel(ctx *fasthttp.RequestCtx) {
 	err = api.ModelService.Create(spanCtx, &model)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if writeSpecsError(ctx, err) {
+			return
+		}
+		if errors.Is(err, slug.ErrInvalid) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, brandrepo.ErrBrandNotFound) {
+			span.LogFields(
+				log.String("event", "brand_not_found"),
+				log.String("brand.id", model.BrandID.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", model.BrandID))
+			return
+		}
 		span.LogFields(
 			log.String("event", "create_model_error"),
-			log.Object("model", model),
+			redact.JSONField("model", model),
 			log.Error(err),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
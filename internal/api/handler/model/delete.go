@@ -2,7 +2,9 @@ package model
 
 import (
 	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
 	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/slug"
 	"context"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
@@ -22,6 +24,11 @@ import (
 // @Success 200 {string} string "Model soft-deleted successfully"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 500 {string} string "Failed to delete model"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {string} string "Model has been soft-deleted"
 // @Router /models/delete/{id} [delete]
 func (api *ModelHandler) DeleteModel(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -48,6 +55,15 @@ func (api *ModelHandler) DeleteModel(ctx *fasthttp.RequestCtx) {
 	err = api.ModelService.SoftDelete(spanCtx, id)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, modelrepo.ErrModelSoftDeleted) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
 		if errors.Is(err, modelrepo.ErrModelNotFound) {
 			span.LogFields(
 				log.String("event", "model_not_found"),
@@ -73,7 +89,7 @@ func (api *ModelHandler) DeleteModel(ctx *fasthttp.RequestCtx) {
 
 // RestoreModel godoc
 // @Summary Восстановление мягко удалённой модели
-// @Description Восстановление модели, которая была удалена ранее
+// @Description Восстановление модели, которая была удалена ранее; модель удалённого бренда не восстанавливается
 // @Tags models
 // @Accept json
 // @Produce json
@@ -81,6 +97,11 @@ func (api *ModelHandler) DeleteModel(ctx *fasthttp.RequestCtx) {
 // @Success 200 {string} string "Model restored successfully"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 500 {string} string "Failed to restore model"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {object} dto.ConflictResponse "Slug taken by another record while this one was deleted (JSON) or brand of the model is deleted or model is not deleted (text)"
 // @Router /models/restore/{id} [post]
 func (api *ModelHandler) RestoreModel(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -108,6 +129,28 @@ func (api *ModelHandler) RestoreModel(ctx *fasthttp.RequestCtx) {
 	err = api.ModelService.Restore(spanCtx, id)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if errors.Is(err, modelrepo.ErrModelNotInTrash) {
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, modelrepo.ErrBrandDeleted) {
+			span.LogFields(
+				log.String("event", "brand_deleted"),
+				log.String("model.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
 		if errors.Is(err, modelrepo.ErrModelNotFound) {
 			span.LogFields(
 				log.String("event", "model_not_found"),
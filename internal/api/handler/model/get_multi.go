@@ -1,6 +1,9 @@
 package model
 
 import (
+	"Brands/internal/dto"
+	"Brands/internal/specs"
+	"Brands/pkg/redact"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -19,6 +22,8 @@ import (
 // @Tags models
 // @Accept json
 // @Produce json
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {array} dto.Model "Список моделей"
 // @Failure 500 {string} string "Failed to fetch models"
 // @Router /models/all [get]
@@ -50,7 +55,7 @@ func (api *ModelHandler) GetAllModels(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "json_marshal_error"),
 			log.Error(err),
-			log.Object("models", models),
+			redact.JSONField("models", models),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal models data: %v", err))
@@ -71,8 +76,16 @@ func (api *ModelHandler) GetAllModels(ctx *fasthttp.RequestCtx) {
 // @Param brand_id query string false "Фильтр по идентификатору бренда"
 // @Param popularity query integer false "Фильтр по популярности (целое число)"
 // @Param is_limited query boolean false "Фильтр по признаку премиум-модели"
+// @Param year query integer false "Только модели с неудаленным поколением, выпускавшимся в этом году"
+// @Param year_from query integer false "Только модели с неудаленным поколением, выпускавшимся в этом году или позже"
+// @Param year_to query integer false "Только модели с неудаленным поколением, выпускавшимся в этом году или раньше"
+// @Param category query string false "Фильтр по категории модели"
+// @Param specs.name query string false "Фильтр по характеристике: specs.<name>[<op>]=<value>, op — eq (по умолчанию), ne, gt, gte, lt, lte; например specs.engine_volume[gte]=2.0"
 // @Param sort query string false "Поле сортировки (например, 'name', '-popularity')"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {array} dto.Model "Список моделей"
+// @Failure 400 {string} string "Invalid filter value"
 // @Failure 500 {string} string "Failed to fetch models"
 // @Router /models/filter [get]
 func (api *ModelHandler) ModelsFilter(ctx *fasthttp.RequestCtx) {
@@ -116,6 +129,31 @@ func (api *ModelHandler) ModelsFilter(ctx *fasthttp.RequestCtx) {
 			return
 		}
 	}
+	years, err := parseYearRange(ctx.QueryArgs())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(log.String("event", "invalid_year_range"), log.Error(err))
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+	if years != (dto.YearRange{}) {
+		filter["generation_years"] = years
+	}
+	if category := string(ctx.QueryArgs().Peek("category")); category != "" {
+		filter["category"] = category
+	}
+	conditions, err := parseSpecConditions(ctx.QueryArgs())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(log.String("event", "invalid_specs_filter"), log.Error(err))
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+	if len(conditions) > 0 {
+		filter["specs"] = conditions
+	}
 
 	sort := string(ctx.QueryArgs().Peek("sort"))
 	if sort != "" {
@@ -153,7 +191,7 @@ func (api *ModelHandler) ModelsFilter(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "json_marshal_error"),
 			log.Error(err),
-			log.Object("models", models),
+			redact.JSONField("models", models),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal models data: %v", err))
@@ -163,3 +201,53 @@ func (api *ModelHandler) ModelsFilter(ctx *fasthttp.RequestCtx) {
 	ctx.Response.SetStatusCode(http.StatusOK)
 	ctx.Response.SetBody(data)
 }
+
+// parseYearRange разбирает годы выпуска поколений: year задает обе границы,
+// year_from и year_to — по одной
+func parseYearRange(args *fasthttp.Args) (dto.YearRange, error) {
+	var years dto.YearRange
+	bounds := []struct {
+		name   string
+		values []*int
+	}{
+		{"year", []*int{&years.From, &years.To}},
+		{"year_from", []*int{&years.From}},
+		{"year_to", []*int{&years.To}},
+	}
+	for _, bound := range bounds {
+		raw := args.Peek(bound.name)
+		if len(raw) == 0 {
+			continue
+		}
+		year, err := strconv.Atoi(string(raw))
+		if err != nil || year <= 0 {
+			return years, fmt.Errorf("Invalid %s value", bound.name)
+		}
+		for _, v := range bound.values {
+			*v = year
+		}
+	}
+	if years.From != 0 && years.To != 0 && years.From > years.To {
+		return years, fmt.Errorf("year_from must not be greater than year_to")
+	}
+	return years, nil
+}
+
+// parseSpecConditions разбирает условия по характеристикам из параметров
+// вида specs.engine_volume[gte]=2.0
+func parseSpecConditions(args *fasthttp.Args) ([]specs.Condition, error) {
+	var conditions []specs.Condition
+	var err error
+	args.VisitAll(func(key, value []byte) {
+		if err != nil || !strings.HasPrefix(string(key), specs.FilterPrefix) {
+			return
+		}
+		var condition specs.Condition
+		condition, err = specs.ParseCondition(string(key), string(value))
+		conditions = append(conditions, condition)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
@@ -2,7 +2,9 @@ package model
 
 import (
 	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
 	modelrepo "Brands/internal/repository/model"
+	"Brands/pkg/redact"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -20,6 +22,9 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path string true "ID модели (UUIDv7)"
+// @Param as_of query string false "Вернуть модель в состоянии на момент времени, RFC 3339"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
 // @Success 200 {object} dto.Model "Модель найдена"
 // @Failure 400 {string} string "Invalid ID format"
 // @Failure 404 {string} string "Model not found"
@@ -47,7 +52,24 @@ func (api *ModelHandler) GetModelByID(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	model, err := api.ModelService.GetByID(spanCtx, id)
+	asOf, err := utils.ParseTimeQuery(ctx, "as_of")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_as_of"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	var model *dto.Model
+	if asOf != nil {
+		model, err = api.ModelService.GetAsOf(spanCtx, id, *asOf)
+	} else {
+		model, err = api.ModelService.GetByID(spanCtx, id)
+	}
 	if err != nil {
 		span.SetTag("error", true)
 		if errors.Is(err, modelrepo.ErrModelNotFound) {
@@ -75,7 +97,7 @@ func (api *ModelHandler) GetModelByID(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "json_marshal_error"),
 			log.Error(err),
-			log.Object("model", model),
+			redact.JSONField("model", model),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal model data: %v", err))
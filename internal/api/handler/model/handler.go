@@ -1,27 +1,37 @@
 package model
 
 import (
+	"Brands/internal/auth"
+	"Brands/internal/idempotency"
 	"Brands/internal/service/model"
 	"github.com/fasthttp/router"
 )
 
 type ModelHandler struct {
 	ModelService *model.ModelService
+	Idempotency  *idempotency.Keeper
 }
 
-func New(modelService *model.ModelService) *ModelHandler {
+func New(modelService *model.ModelService, keeper *idempotency.Keeper) *ModelHandler {
 	return &ModelHandler{
 		ModelService: modelService,
+		Idempotency:  keeper,
 	}
 }
 
-func (api *ModelHandler) SetupRoutes(r *router.Router) {
+func (api *ModelHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
 	group := r.Group("/models")
-	group.POST("/create", api.CreateModel)
-	group.GET("/{id}", api.GetModelByID)
-	group.GET("/all", api.GetAllModels)
-	group.GET("/filter", api.ModelsFilter)
-	group.PUT("/update/{id}", api.UpdateModel)
-	group.DELETE("/delete/{id}", api.DeleteModel)
-	group.POST("/restore/{id}", api.RestoreModel)
+	group.POST("/create", a.Require(auth.ScopeWrite, api.Idempotency.Wrap(api.CreateModel)))
+	group.GET("/{id}", a.Require(auth.ScopeRead, api.GetModelByID))
+	group.GET("/{id}/revisions", a.Require(auth.ScopeRead, api.GetModelRevisions))
+	group.POST("/{id}/revisions/{rev}/revert", a.Require(auth.ScopeWrite, api.RevertModelRevision))
+	group.GET("/all", a.Require(auth.ScopeRead, api.GetAllModels))
+	group.GET("/filter", a.Require(auth.ScopeRead, api.ModelsFilter))
+	group.PUT("/update/{id}", a.Require(auth.ScopeWrite, api.UpdateModel))
+	group.DELETE("/delete/{id}", a.Require(auth.ScopeWrite, api.DeleteModel))
+	group.POST("/restore/{id}", a.Require(auth.ScopeAdmin, api.RestoreModel))
+	group.GET("/trash", a.Require(auth.ScopeWrite, api.GetModelTrash))
+
+	// Адрес модели вложен в адрес бренда: /brands/{slug}/models/{model_slug}
+	r.GET("/brands/{slug}/models/{model_slug}", a.Require(auth.ScopeRead, api.GetModelBySlug))
 }
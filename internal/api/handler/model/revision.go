@@ -0,0 +1,203 @@
+package model
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
+	"Brands/internal/repository/revision"
+	"Brands/internal/slug"
+	"Brands/internal/specs"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	brandrepo "Brands/internal/repository/brand"
+	modelrepo "Brands/internal/repository/model"
+	modelservice "Brands/internal/service/model"
+)
+
+// GetModelRevisions godoc
+// @Summary История версий модели
+// @Description Возвращает сохраненные версии модели от новых к старым
+// @Tags models
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {array} dto.Revision "Версии модели"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Model not found"
+// @Failure 500 {string} string "Failed to fetch revisions"
+// @Router /models/{id}/revisions [get]
+func (api *ModelHandler) GetModelRevisions(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "ModelHandler.GetModelRevisions")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	limit, offset, err := utils.ParsePagination(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_pagination"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	revisions, err := api.ModelService.Revisions(spanCtx, id, limit, offset)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, modelrepo.ErrModelNotFound) {
+			span.LogFields(
+				log.String("event", "model_not_found"),
+				log.String("model.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Model not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "get_revisions_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch revisions: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(revisions)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal revisions: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
+
+// RevertModelRevision godoc
+// @Summary Откат модели к версии
+// @Description Восстанавливает поля модели из указанной версии через обычное обновление: с проверкой прав, записью аудита и созданием новой версии
+// @Tags models
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param rev path integer true "Номер версии"
+// @Success 200 {object} dto.Model "Model после отката"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Revision not found"
+// @Failure 500 {string} string "Failed to revert model"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Failure 409 {object} dto.ConflictResponse "Slug of the revision is taken by another record (JSON) or its brand is deleted or specs do not match the category schema (text)"
+// @Router /models/{id}/revisions/{rev}/revert [post]
+func (api *ModelHandler) RevertModelRevision(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "ModelHandler.RevertModelRevision")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	rev, err := utils.ExtractPositiveIntFromPath(ctx, "rev")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_revision"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	model, err := api.ModelService.Revert(spanCtx, id, rev)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, rbac.ErrForbidden):
+			utils.WriteForbidden(ctx, err)
+		case errors.Is(err, slug.ErrConflict):
+			utils.WriteConflict(ctx, err)
+		case errors.Is(err, revision.ErrRevisionNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Revision %d not found for model %s", rev, id))
+		case errors.Is(err, brandrepo.ErrBrandNotFound):
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand of revision %d is deleted: %v", rev, err))
+		case errors.Is(err, specs.ErrInvalidSpecs),
+			errors.Is(err, modelservice.ErrUnknownCategory),
+			errors.Is(err, modelrepo.ErrSchemaChanged):
+			ctx.Response.SetStatusCode(http.StatusConflict)
+			ctx.Response.SetBodyString(fmt.Sprintf("Specs of revision %d do not match the category schema: %v", rev, err))
+		case errors.Is(err, modelrepo.ErrModelNotFound):
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Model not found with ID: %s", id))
+		default:
+			span.LogFields(
+				log.String("event", "revert_model_error"),
+				log.Error(err),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to revert model: %v", err))
+		}
+		return
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal model data: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
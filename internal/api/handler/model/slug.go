@@ -0,0 +1,91 @@
+package model
+
+import (
+	"Brands/internal/api/handler/utils"
+	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/slug"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetModelBySlug godoc
+// @Summary Получение модели по слагам бренда и модели
+// @Description Получение модели по слагу бренда и слагу модели. Запрос по прежнему слагу бренда или модели, а также по адресу модели, перенесенной к другому бренду, перенаправляется на актуальный адрес
+// @Tags models
+// @Accept json
+// @Produce json
+// @Param slug path string true "Слаг бренда"
+// @Param model_slug path string true "Слаг модели"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {object} dto.Model "Модель найдена"
+// @Success 301 {string} string "Адрес устарел, актуальный адрес в заголовке Location"
+// @Failure 400 {string} string "Invalid slug format"
+// @Failure 404 {string} string "Model not found"
+// @Router /brands/{slug}/models/{model_slug} [get]
+func (api *ModelHandler) GetModelBySlug(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "ModelHandler.GetModelBySlug")
+	defer span.Finish()
+
+	brandSlug, _ := ctx.UserValue("slug").(string)
+	modelSlug, _ := ctx.UserValue("model_slug").(string)
+	model, currentBrandSlug, err := api.ModelService.GetBySlug(spanCtx, brandSlug, modelSlug)
+	if err != nil {
+		span.SetTag("error", true)
+		switch {
+		case errors.Is(err, slug.ErrInvalid):
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString("Invalid slug format")
+		case errors.Is(err, modelrepo.ErrModelNotFound):
+			span.LogFields(
+				log.String("event", "model_not_found"),
+				log.String("brand.slug", brandSlug),
+				log.String("model.slug", modelSlug),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Model not found: %s/%s", brandSlug, modelSlug))
+		default:
+			span.LogFields(
+				log.String("event", "get_model_error"),
+				log.Error(err),
+				log.String("brand.slug", brandSlug),
+				log.String("model.slug", modelSlug),
+			)
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to get model: %v", err))
+		}
+		return
+	}
+
+	if currentBrandSlug != brandSlug || model.Slug != modelSlug {
+		utils.RedirectPermanent(ctx, "/brands/"+currentBrandSlug+"/models/"+model.Slug)
+		return
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal model data: %v", err))
+		return
+	}
+
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"Brands/internal/api/handler/utils"
+	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/service/model"
+	"Brands/internal/specs"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// writeSpecsError отвечает на ошибку категории или характеристик модели;
+// false — ошибка к ним не относится
+func writeSpecsError(ctx *fasthttp.RequestCtx, err error) bool {
+	switch {
+	case errors.Is(err, specs.ErrInvalidSpecs):
+		utils.WriteInvalidSpecs(ctx, err)
+	case errors.Is(err, model.ErrUnknownCategory),
+		errors.Is(err, model.ErrSpecsWithoutCategory):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.Is(err, modelrepo.ErrSchemaChanged):
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBodyString(err.Error())
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetModelTrash godoc
+// @Summary Корзина моделей
+// @Description Возвращает мягко удалённые модели с временем и автором удаления, от недавно удалённых к давним. Модели, удалённые вместе с брендом, отмечены deleted_with_brand
+// @Tags models
+// @Accept json
+// @Produce json
+// @Param brand_id query string false "Только модели бренда"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Param lang query string false "Язык названий и описаний (ru, en, kk); важнее Accept-Language"
+// @Param Accept-Language header string false "Предпочитаемые языки ответа"
+// @Success 200 {object} dto.ModelTrashPage "Страница корзины"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 500 {string} string "Failed to fetch deleted models"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /models/trash [get]
+func (api *ModelHandler) GetModelTrash(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "ModelHandler.GetModelTrash")
+	defer span.Finish()
+
+	filter, err := parseTrashFilter(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.ModelService.Trash(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_trash"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch deleted models: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal deleted models: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
+
+func parseTrashFilter(ctx *fasthttp.RequestCtx) (dto.TrashFilter, error) {
+	var filter dto.TrashFilter
+	var err error
+
+	filter.Limit, filter.Offset, err = utils.ParsePagination(ctx)
+	if err != nil {
+		return filter, err
+	}
+	if raw := ctx.QueryArgs().Peek("brand_id"); len(raw) > 0 {
+		id, err := uuid.ParseBytes(raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid brand_id: %w", err)
+		}
+		filter.BrandID = &id
+	}
+	return filter, nil
+}
@@ -3,7 +3,12 @@ package model
 import (
 	"Brands/internal/api/handler/utils"
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
 	modelrepo "Brands/internal/repository/model"
+	modelservice "Brands/internal/service/model"
+	"Brands/internal/slug"
+	"Brands/pkg/redact"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -24,9 +29,14 @@ import (
 // @Param id path string true "ID модели (UUIDv7)"
 // @Param model body dto.Model true "Обновлённые данные модели"
 // @Success 200 {string} string "Model updated successfully"
-// @Failure 400 {string} string "Invalid request body"
+// @Failure 400 {object} dto.SpecsErrorResponse "Specs do not match the category schema (JSON); invalid request body or slug, brand not found or deleted, unknown category, localized model (text)"
+// @Failure 409 {object} dto.ConflictResponse "Slug already taken (JSON) or category schema changed during the request (text)"
 // @Failure 404 {string} string "Model not found"
 // @Failure 500 {string} string "Failed to update model"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
 // @Router /models/update/{id} [put]
 func (api *ModelHandler) UpdateModel(ctx *fasthttp.RequestCtx) {
 	var spanCtx context.Context
@@ -71,6 +81,31 @@ func (api *ModelHandler) UpdateModel(ctx *fasthttp.RequestCtx) {
 	err = api.ModelService.Update(spanCtx, &model)
 	if err != nil {
 		span.SetTag("error", true)
+		if errors.Is(err, rbac.ErrForbidden) {
+			utils.WriteForbidden(ctx, err)
+			return
+		}
+		if errors.Is(err, slug.ErrConflict) {
+			utils.WriteConflict(ctx, err)
+			return
+		}
+		if writeSpecsError(ctx, err) {
+			return
+		}
+		if errors.Is(err, slug.ErrInvalid) || errors.Is(err, modelservice.ErrLocalized) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		if errors.Is(err, brandrepo.ErrBrandNotFound) {
+			span.LogFields(
+				log.String("event", "brand_not_found"),
+				log.String("brand.id", model.BrandID.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", model.BrandID))
+			return
+		}
 		if errors.Is(err, modelrepo.ErrModelNotFound) {
 			span.LogFields(
 				log.String("event", "model_not_found"),
@@ -83,7 +118,7 @@ func (api *ModelHandler) UpdateModel(ctx *fasthttp.RequestCtx) {
 		span.LogFields(
 			log.String("event", "update_model_error"),
 			log.Error(err),
-			log.Object("model", model),
+			redact.JSONField("model", model),
 		)
 		ctx.Response.SetStatusCode(http.StatusInternalServerError)
 		ctx.Response.SetBodyString(fmt.Sprintf("Failed to update model: %v", err))
@@ -0,0 +1,26 @@
+package specschema
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/specschema"
+	"github.com/fasthttp/router"
+)
+
+type SpecSchemaHandler struct {
+	SpecSchemaService *specschema.SpecSchemaService
+}
+
+func New(specSchemaService *specschema.SpecSchemaService) *SpecSchemaHandler {
+	return &SpecSchemaHandler{
+		SpecSchemaService: specSchemaService,
+	}
+}
+
+func (api *SpecSchemaHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/specs/schemas", a.Require(auth.ScopeAdmin, api.GetAllSpecSchemas))
+	group := r.Group("/specs/schemas")
+	group.GET("/{category}", a.Require(auth.ScopeAdmin, api.GetSpecSchema))
+	group.PUT("/{category}", a.Require(auth.ScopeAdmin, api.SaveSpecSchema))
+	group.DELETE("/{category}", a.Require(auth.ScopeAdmin, api.DeleteSpecSchema))
+}
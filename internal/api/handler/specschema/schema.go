@@ -0,0 +1,158 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetAllSpecSchemas godoc
+// @Summary Схемы характеристик категорий
+// @Description Возвращает схемы характеристик всех категорий по слагу категории
+// @Tags specs
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.SpecSchema "Схемы характеристик"
+// @Failure 500 {string} string "Failed to fetch specs schemas"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /specs/schemas [get]
+func (api *SpecSchemaHandler) GetAllSpecSchemas(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "SpecSchemaHandler.GetAllSpecSchemas")
+	defer span.Finish()
+
+	schemas, err := api.SpecSchemaService.GetAll(spanCtx)
+	if err != nil {
+		writeError(ctx, span, err, "Failed to fetch specs schemas")
+		return
+	}
+	writeJSON(ctx, span, schemas)
+}
+
+// GetSpecSchema godoc
+// @Summary Схема характеристик категории
+// @Description Возвращает JSON Schema характеристик моделей категории
+// @Tags specs
+// @Accept json
+// @Produce json
+// @Param category path string true "Слаг категории"
+// @Success 200 {object} dto.SpecSchema "Схема характеристик"
+// @Failure 404 {string} string "Specs schema not found"
+// @Failure 500 {string} string "Failed to fetch specs schema"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /specs/schemas/{category} [get]
+func (api *SpecSchemaHandler) GetSpecSchema(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "SpecSchemaHandler.GetSpecSchema")
+	defer span.Finish()
+
+	schema, err := api.SpecSchemaService.Get(spanCtx, extractCategory(ctx))
+	if err != nil {
+		writeError(ctx, span, err, "Failed to fetch specs schema")
+		return
+	}
+	writeJSON(ctx, span, schema)
+}
+
+// SaveSpecSchema godoc
+// @Summary Сохранение схемы характеристик категории
+// @Description Создает или заменяет JSON Schema характеристик моделей категории. Поддерживаются type, properties, required, additionalProperties (true/false), enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern, items, minItems, maxItems, uniqueItems и аннотации; схема с другими ключевыми словами отклоняется. Имена свойств — строчные латинские буквы, цифры и подчеркивания. Замена отклоняется, если ей не соответствуют характеристики моделей категории, включая удаленные
+// @Tags specs
+// @Accept json
+// @Produce json
+// @Param category path string true "Слаг категории"
+// @Param schema body dto.SpecSchemaRequest true "Схема характеристик"
+// @Success 200 {object} dto.SpecSchema "Сохраненная схема"
+// @Failure 400 {string} string "Invalid request body, category or schema"
+// @Failure 409 {object} dto.SpecSchemaConflictResponse "Specs of existing models do not match the new schema"
+// @Failure 500 {string} string "Failed to save specs schema"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /specs/schemas/{category} [put]
+func (api *SpecSchemaHandler) SaveSpecSchema(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "SpecSchemaHandler.SaveSpecSchema")
+	defer span.Finish()
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.SpecSchemaRequest
+	if err := decoder.Decode(&req); err != nil || len(req.Schema) == 0 {
+		if err == nil {
+			err = fmt.Errorf("schema is required")
+		}
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	schema, err := api.SpecSchemaService.Save(spanCtx, extractCategory(ctx), req.Schema)
+	if err != nil {
+		writeError(ctx, span, err, "Failed to save specs schema")
+		return
+	}
+	writeJSON(ctx, span, schema)
+}
+
+// DeleteSpecSchema godoc
+// @Summary Удаление схемы характеристик категории
+// @Description Удаляет схему категории, у которой нет моделей, в том числе удаленных
+// @Tags specs
+// @Accept json
+// @Produce json
+// @Param category path string true "Слаг категории"
+// @Success 200 {string} string "Specs schema deleted successfully"
+// @Failure 404 {string} string "Specs schema not found"
+// @Failure 409 {string} string "Specs schema is used by models of the category"
+// @Failure 500 {string} string "Failed to delete specs schema"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /specs/schemas/{category} [delete]
+func (api *SpecSchemaHandler) DeleteSpecSchema(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "SpecSchemaHandler.DeleteSpecSchema")
+	defer span.Finish()
+
+	if err := api.SpecSchemaService.Delete(spanCtx, extractCategory(ctx)); err != nil {
+		writeError(ctx, span, err, "Failed to delete specs schema")
+		return
+	}
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Specs schema deleted successfully")
+}
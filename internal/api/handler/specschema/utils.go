@@ -0,0 +1,71 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	specschemarepo "Brands/internal/repository/specschema"
+	"Brands/internal/service/specschema"
+	"Brands/internal/specs"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+func extractCategory(ctx *fasthttp.RequestCtx) string {
+	category, _ := ctx.UserValue("category").(string)
+	return category
+}
+
+func writeError(ctx *fasthttp.RequestCtx, span opentracing.Span, err error, msg string) {
+	span.SetTag("error", true)
+	var incompatible *specschemarepo.IncompatibleError
+	switch {
+	case errors.Is(err, specschema.ErrInvalidCategory),
+		errors.Is(err, specs.ErrInvalidSchema):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.Is(err, specschemarepo.ErrSchemaNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Specs schema not found for category: %s", extractCategory(ctx)))
+	case errors.Is(err, specschemarepo.ErrSchemaInUse):
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.As(err, &incompatible):
+		data, _ := json.Marshal(dto.SpecSchemaConflictResponse{
+			Error:  specschemarepo.ErrIncompatibleModels.Error(),
+			Total:  incompatible.Total,
+			Models: incompatible.Models,
+		})
+		ctx.SetContentType("application/json")
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBody(data)
+	default:
+		span.LogFields(
+			log.String("event", "specs_schema_error"),
+			log.Error(err),
+			log.String("category", extractCategory(ctx)),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func writeJSON(ctx *fasthttp.RequestCtx, span opentracing.Span, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal response: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,146 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetBrandTranslations godoc
+// @Summary Переводы бренда
+// @Description Возвращает переводы названия и описания бренда на все языки, на которые он переведен. Основной язык хранится в самом бренде
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Success 200 {array} dto.BrandTranslation "Переводы по языкам"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to fetch translations"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Router /brands/{id}/translations [get]
+func (api *TranslationHandler) GetBrandTranslations(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.GetBrandTranslations")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	translations, err := api.TranslationService.BrandTranslations(spanCtx, id)
+	if err != nil {
+		writeError(ctx, span, err, id, "Failed to fetch translations")
+		return
+	}
+	writeJSON(ctx, span, translations)
+}
+
+// UpsertBrandTranslation godoc
+// @Summary Сохранение перевода бренда
+// @Description Создает или заменяет перевод бренда на язык из пути. Непереданное поле становится непереведенным, и вместо него показывается значение следующего языка цепочки
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param locale path string true "Код языка, кроме основного"
+// @Param translation body dto.BrandTranslationRequest true "Перевод"
+// @Success 200 {object} dto.BrandTranslation "Сохраненный перевод"
+// @Failure 400 {string} string "Invalid ID format, request body, locale or field"
+// @Failure 404 {string} string "Brand not found"
+// @Failure 500 {string} string "Failed to save translation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/translations/{locale} [put]
+func (api *TranslationHandler) UpsertBrandTranslation(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.UpsertBrandTranslation")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.BrandTranslationRequest
+	if err := decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	translation := &dto.BrandTranslation{
+		BrandID:     id,
+		Locale:      extractLocale(ctx),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if err := api.TranslationService.UpsertBrand(spanCtx, translation); err != nil {
+		writeError(ctx, span, err, id, "Failed to save translation")
+		return
+	}
+	writeJSON(ctx, span, translation)
+}
+
+// DeleteBrandTranslation godoc
+// @Summary Удаление перевода бренда
+// @Description Удаляет перевод бренда на язык из пути; вместо него показываются значения следующего языка цепочки
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID бренда"
+// @Param locale path string true "Код языка, кроме основного"
+// @Success 200 {string} string "Translation deleted successfully"
+// @Failure 400 {string} string "Invalid ID format or locale"
+// @Failure 404 {string} string "Brand or translation not found"
+// @Failure 500 {string} string "Failed to delete translation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /brands/{id}/translations/{locale} [delete]
+func (api *TranslationHandler) DeleteBrandTranslation(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.DeleteBrandTranslation")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	locale := extractLocale(ctx)
+	if err := api.TranslationService.DeleteBrand(spanCtx, id, locale); err != nil {
+		writeError(ctx, span, err, id, "Failed to delete translation")
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString(fmt.Sprintf("Translation deleted successfully for locale: %s", locale))
+}
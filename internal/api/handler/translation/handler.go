@@ -0,0 +1,29 @@
+package translation
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/translation"
+	"github.com/fasthttp/router"
+)
+
+type TranslationHandler struct {
+	TranslationService *translation.TranslationService
+}
+
+func New(translationService *translation.TranslationService) *TranslationHandler {
+	return &TranslationHandler{
+		TranslationService: translationService,
+	}
+}
+
+func (api *TranslationHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/brands/{id}/translations", a.Require(auth.ScopeRead, api.GetBrandTranslations))
+	r.PUT("/brands/{id}/translations/{locale}", a.Require(auth.ScopeWrite, api.UpsertBrandTranslation))
+	r.DELETE("/brands/{id}/translations/{locale}", a.Require(auth.ScopeWrite, api.DeleteBrandTranslation))
+	r.GET("/models/{id}/translations", a.Require(auth.ScopeRead, api.GetModelTranslations))
+	r.PUT("/models/{id}/translations/{locale}", a.Require(auth.ScopeWrite, api.UpsertModelTranslation))
+	r.DELETE("/models/{id}/translations/{locale}", a.Require(auth.ScopeWrite, api.DeleteModelTranslation))
+	r.GET("/translations/completeness", a.Require(auth.ScopeWrite, api.GetCompleteness))
+	r.GET("/translations/missing", a.Require(auth.ScopeWrite, api.GetMissing))
+}
@@ -0,0 +1,145 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetModelTranslations godoc
+// @Summary Переводы модели
+// @Description Возвращает переводы названия модели на все языки, на которые она переведена. Основной язык хранится в самой модели
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Success 200 {array} dto.ModelTranslation "Переводы по языкам"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Model not found"
+// @Failure 500 {string} string "Failed to fetch translations"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Router /models/{id}/translations [get]
+func (api *TranslationHandler) GetModelTranslations(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.GetModelTranslations")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	translations, err := api.TranslationService.ModelTranslations(spanCtx, id)
+	if err != nil {
+		writeError(ctx, span, err, id, "Failed to fetch translations")
+		return
+	}
+	writeJSON(ctx, span, translations)
+}
+
+// UpsertModelTranslation godoc
+// @Summary Сохранение перевода модели
+// @Description Создает или заменяет перевод названия модели на язык из пути
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param locale path string true "Код языка, кроме основного"
+// @Param translation body dto.ModelTranslationRequest true "Перевод"
+// @Success 200 {object} dto.ModelTranslation "Сохраненный перевод"
+// @Failure 400 {string} string "Invalid ID format, request body, locale or field"
+// @Failure 404 {string} string "Model not found"
+// @Failure 500 {string} string "Failed to save translation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/translations/{locale} [put]
+func (api *TranslationHandler) UpsertModelTranslation(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.UpsertModelTranslation")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.ModelTranslationRequest
+	if err := decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	translation := &dto.ModelTranslation{
+		ModelID: id,
+		Locale:  extractLocale(ctx),
+		Name:    req.Name,
+	}
+	if err := api.TranslationService.UpsertModel(spanCtx, translation); err != nil {
+		writeError(ctx, span, err, id, "Failed to save translation")
+		return
+	}
+	writeJSON(ctx, span, translation)
+}
+
+// DeleteModelTranslation godoc
+// @Summary Удаление перевода модели
+// @Description Удаляет перевод названия модели на язык из пути; вместо него показывается название на следующем языке цепочки
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param locale path string true "Код языка, кроме основного"
+// @Success 200 {string} string "Translation deleted successfully"
+// @Failure 400 {string} string "Invalid ID format or locale"
+// @Failure 404 {string} string "Model or translation not found"
+// @Failure 500 {string} string "Failed to delete translation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/translations/{locale} [delete]
+func (api *TranslationHandler) DeleteModelTranslation(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.DeleteModelTranslation")
+	defer span.Finish()
+
+	id, ok := extractID(ctx, span)
+	if !ok {
+		return
+	}
+	locale := extractLocale(ctx)
+	if err := api.TranslationService.DeleteModel(spanCtx, id, locale); err != nil {
+		writeError(ctx, span, err, id, "Failed to delete translation")
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString(fmt.Sprintf("Translation deleted successfully for locale: %s", locale))
+}
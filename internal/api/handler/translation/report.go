@@ -0,0 +1,95 @@
+package translation
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetCompleteness godoc
+// @Summary Полнота переводов
+// @Description Для каждого языка, кроме основного, возвращает число неудаленных брендов и моделей, у которых не переведены название и описание, и долю переведенных полей. Описание учитывается только у брендов, у которых оно заполнено на основном языке
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.LocaleCompleteness "Полнота по языкам"
+// @Failure 500 {string} string "Failed to build completeness report"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /translations/completeness [get]
+func (api *TranslationHandler) GetCompleteness(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.GetCompleteness")
+	defer span.Finish()
+
+	report, err := api.TranslationService.Completeness(spanCtx)
+	if err != nil {
+		writeError(ctx, span, err, uuid.Nil, "Failed to build completeness report")
+		return
+	}
+	writeJSON(ctx, span, report)
+}
+
+// GetMissing godoc
+// @Summary Записи без переводов
+// @Description Возвращает страницу неудаленных брендов и моделей, у которых на язык не переведены поля, по сущности и названию
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param locale query string true "Код языка, кроме основного"
+// @Param entity query string false "Только brand или только model"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {object} dto.MissingTranslationPage "Страница записей"
+// @Failure 400 {string} string "Invalid query parameter or locale"
+// @Failure 500 {string} string "Failed to fetch missing translations"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /translations/missing [get]
+func (api *TranslationHandler) GetMissing(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "TranslationHandler.GetMissing")
+	defer span.Finish()
+
+	filter := dto.MissingTranslationFilter{
+		Locale: string(ctx.QueryArgs().Peek("locale")),
+		Entity: string(ctx.QueryArgs().Peek("entity")),
+	}
+	var err error
+	filter.Limit, filter.Offset, err = utils.ParsePagination(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+
+	page, err := api.TranslationService.Missing(spanCtx, filter)
+	if err != nil {
+		writeError(ctx, span, err, uuid.Nil, fmt.Sprintf("Failed to fetch missing translations for locale %q", filter.Locale))
+		return
+	}
+	writeJSON(ctx, span, page)
+}
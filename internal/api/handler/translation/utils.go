@@ -0,0 +1,88 @@
+package translation
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
+	brandrepo "Brands/internal/repository/brand"
+	modelrepo "Brands/internal/repository/model"
+	translationrepo "Brands/internal/repository/translation"
+	"Brands/internal/service/translation"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+func extractID(ctx *fasthttp.RequestCtx, span opentracing.Span) (uuid.UUID, bool) {
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func extractLocale(ctx *fasthttp.RequestCtx) string {
+	locale, _ := ctx.UserValue("locale").(string)
+	return locale
+}
+
+func writeError(ctx *fasthttp.RequestCtx, span opentracing.Span, err error, id uuid.UUID, msg string) {
+	span.SetTag("error", true)
+	switch {
+	case errors.Is(err, rbac.ErrForbidden):
+		utils.WriteForbidden(ctx, err)
+	case errors.Is(err, translation.ErrUnsupportedLocale),
+		errors.Is(err, translation.ErrDefaultLocale),
+		errors.Is(err, translation.ErrEmptyTranslation),
+		errors.Is(err, translation.ErrNameRequired),
+		errors.Is(err, translation.ErrNameTooLong),
+		errors.Is(err, translation.ErrUnknownEntity):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.Is(err, brandrepo.ErrBrandNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Brand not found with ID: %s", id))
+	case errors.Is(err, modelrepo.ErrModelNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Model not found with ID: %s", id))
+	case errors.Is(err, translationrepo.ErrTranslationNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Translation not found for locale: %s", extractLocale(ctx)))
+	default:
+		span.LogFields(
+			log.String("event", "translation_error"),
+			log.Error(err),
+			log.String("entity.id", id.String()),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func writeJSON(ctx *fasthttp.RequestCtx, span opentracing.Span, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal response: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// WriteConflict отвечает 409 с описанием записи, которая уже заняла слаг
+func WriteConflict(ctx *fasthttp.RequestCtx, err error) {
+	resp := dto.ConflictResponse{Error: err.Error()}
+	var conflict *slug.ConflictError
+	if errors.As(err, &conflict) {
+		resp.Conflict = dto.ConflictRecord{
+			Entity: conflict.Entity,
+			ID:     conflict.ID,
+			Name:   conflict.Name,
+			Slug:   conflict.Slug,
+		}
+	}
+
+	data, _ := json.Marshal(resp)
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusConflict)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"strconv"
+)
+
+// ExtractPositiveIntFromPath извлекает и парсит положительное целое из пути запроса
+func ExtractPositiveIntFromPath(ctx *fasthttp.RequestCtx, key string) (int, error) {
+	raw, ok := ctx.UserValue(key).(string)
+	if !ok || raw == "" {
+		return 0, fmt.Errorf("missing %s in path", key)
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be a positive integer", key)
+	}
+	return value, nil
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// WriteForbidden отвечает 403 с описанием отказа RBAC и списком запрещенных полей
+func WriteForbidden(ctx *fasthttp.RequestCtx, err error) {
+	resp := dto.ForbiddenResponse{Error: err.Error()}
+	var forbidden *rbac.ForbiddenError
+	if errors.As(err, &forbidden) {
+		resp.Permission = forbidden.Permission
+		resp.ForbiddenFields = forbidden.Fields
+	}
+
+	data, _ := json.Marshal(resp)
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusForbidden)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"strconv"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 500
+)
+
+// ParsePagination извлекает limit и offset из query-параметров запроса.
+// Без limit используется DefaultPageLimit, значения больше MaxPageLimit отклоняются.
+func ParsePagination(ctx *fasthttp.RequestCtx) (limit, offset int, err error) {
+	limit = DefaultPageLimit
+	if raw := ctx.QueryArgs().Peek("limit"); len(raw) > 0 {
+		limit, err = strconv.Atoi(string(raw))
+		if err != nil || limit <= 0 || limit > MaxPageLimit {
+			return 0, 0, fmt.Errorf("invalid limit: must be between 1 and %d", MaxPageLimit)
+		}
+	}
+	if raw := ctx.QueryArgs().Peek("offset"); len(raw) > 0 {
+		offset, err = strconv.Atoi(string(raw))
+		if err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("invalid offset: must be a non-negative integer")
+		}
+	}
+	return limit, offset, nil
+}
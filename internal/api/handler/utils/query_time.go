@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"time"
+)
+
+// ParseTimeQuery извлекает время в формате RFC 3339 из query-параметра.
+// Возвращает nil, если параметр не передан. Время приводится к UTC,
+// так как колонки TIMESTAMP хранят его без зоны.
+func ParseTimeQuery(ctx *fasthttp.RequestCtx, key string) (*time.Time, error) {
+	raw := ctx.QueryArgs().Peek(key)
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 timestamp", key)
+	}
+	t = t.UTC()
+	return &t, nil
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// RedirectPermanent отвечает 301 с переходом на path, сохраняя query-параметры
+// запроса. Используется для запросов по устаревшему адресу, например по
+// прежнему слагу.
+func RedirectPermanent(ctx *fasthttp.RequestCtx, path string) {
+	if args := ctx.QueryArgs().QueryString(); len(args) > 0 {
+		path += "?" + string(args)
+	}
+	ctx.Response.Header.Set("Location", path)
+	ctx.Response.SetStatusCode(http.StatusMovedPermanently)
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/specschema"
+	"Brands/internal/specs"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// WriteInvalidSpecs отвечает 400 со списком нарушений схемы характеристик
+func WriteInvalidSpecs(ctx *fasthttp.RequestCtx, err error) {
+	resp := dto.SpecsErrorResponse{Error: err.Error(), Violations: []dto.SpecViolation{}}
+	var invalid *specs.ValidationError
+	if errors.As(err, &invalid) {
+		resp.Error = specs.ErrInvalidSpecs.Error()
+		resp.Violations = specschema.Violations(invalid)
+	}
+
+	data, _ := json.Marshal(resp)
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusBadRequest)
+	ctx.Response.SetBody(data)
+}
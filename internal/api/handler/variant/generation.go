@@ -0,0 +1,186 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// CreateGeneration godoc
+// @Summary Создание поколения модели
+// @Description Добавляет модели поколение с годами выпуска. Название уникально среди неудаленных поколений модели без учета регистра
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation body dto.ModelGenerationRequest true "Поколение"
+// @Success 201 {object} dto.ModelGeneration "Созданное поколение"
+// @Failure 400 {string} string "Invalid id format, request body, name or years"
+// @Failure 404 {string} string "Model not found"
+// @Failure 409 {string} string "Name is already taken"
+// @Failure 500 {string} string "Failed to create generation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations [post]
+func (api *VariantHandler) CreateGeneration(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.CreateGeneration")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id")
+	if !ok {
+		return
+	}
+	var req dto.ModelGenerationRequest
+	if !decodeBody(ctx, span, &req) {
+		return
+	}
+
+	generation := &dto.ModelGeneration{
+		ModelID:   ids[0],
+		Name:      req.Name,
+		StartYear: req.StartYear,
+		EndYear:   req.EndYear,
+	}
+	if err := api.VariantService.CreateGeneration(spanCtx, generation); err != nil {
+		writeError(ctx, span, err, ids[0], "Failed to create generation")
+		return
+	}
+	writeJSON(ctx, span, http.StatusCreated, generation)
+}
+
+// UpdateGeneration godoc
+// @Summary Изменение поколения модели
+// @Description Заменяет название и годы выпуска неудаленного поколения
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Param generation body dto.ModelGenerationRequest true "Поколение"
+// @Success 200 {object} dto.ModelGeneration "Измененное поколение"
+// @Failure 400 {string} string "Invalid id format, request body, name or years"
+// @Failure 404 {string} string "Model or generation not found"
+// @Failure 409 {string} string "Name is already taken"
+// @Failure 500 {string} string "Failed to update generation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id} [put]
+func (api *VariantHandler) UpdateGeneration(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.UpdateGeneration")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id")
+	if !ok {
+		return
+	}
+	var req dto.ModelGenerationRequest
+	if !decodeBody(ctx, span, &req) {
+		return
+	}
+
+	generation := &dto.ModelGeneration{
+		ID:        ids[1],
+		ModelID:   ids[0],
+		Name:      req.Name,
+		StartYear: req.StartYear,
+		EndYear:   req.EndYear,
+	}
+	if err := api.VariantService.UpdateGeneration(spanCtx, generation); err != nil {
+		writeError(ctx, span, err, ids[1], "Failed to update generation")
+		return
+	}
+	writeJSON(ctx, span, http.StatusOK, generation)
+}
+
+// DeleteGeneration godoc
+// @Summary Мягкое удаление поколения модели
+// @Description Мягко удаляет поколение вместе с его неудаленными комплектациями
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Success 200 {string} string "Generation soft-deleted successfully"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model or generation not found"
+// @Failure 500 {string} string "Failed to delete generation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id} [delete]
+func (api *VariantHandler) DeleteGeneration(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.DeleteGeneration")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id")
+	if !ok {
+		return
+	}
+	if err := api.VariantService.DeleteGeneration(spanCtx, ids[0], ids[1]); err != nil {
+		writeError(ctx, span, err, ids[1], "Failed to delete generation")
+		return
+	}
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Generation soft-deleted successfully")
+}
+
+// RestoreGeneration godoc
+// @Summary Восстановление поколения модели
+// @Description Восстанавливает удаленное поколение и комплектации, удаленные вместе с ним. Комплектации, удаленные отдельно, остаются в корзине
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Success 200 {string} string "Generation restored successfully"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model or deleted generation not found"
+// @Failure 409 {string} string "Name is already taken"
+// @Failure 500 {string} string "Failed to restore generation"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id}/restore [post]
+func (api *VariantHandler) RestoreGeneration(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.RestoreGeneration")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id")
+	if !ok {
+		return
+	}
+	if err := api.VariantService.RestoreGeneration(spanCtx, ids[0], ids[1]); err != nil {
+		writeError(ctx, span, err, ids[1], "Failed to restore generation")
+		return
+	}
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Generation restored successfully")
+}
@@ -0,0 +1,80 @@
+package variant
+
+import (
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetVariants godoc
+// @Summary Поколения и комплектации модели
+// @Description Возвращает неудаленные поколения модели по годам выпуска, у каждого — неудаленные комплектации по названию
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Success 200 {array} dto.ModelVariant "Поколения с комплектациями"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model not found"
+// @Failure 500 {string} string "Failed to fetch variants"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Router /models/{id}/variants [get]
+func (api *VariantHandler) GetVariants(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.GetVariants")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id")
+	if !ok {
+		return
+	}
+	variants, err := api.VariantService.GetVariants(spanCtx, ids[0])
+	if err != nil {
+		writeError(ctx, span, err, ids[0], "Failed to fetch variants")
+		return
+	}
+	writeJSON(ctx, span, http.StatusOK, variants)
+}
+
+// GetVariantTrash godoc
+// @Summary Корзина поколений и комплектаций модели
+// @Description Возвращает удаленные поколения модели и поколения с удаленными комплектациями; у каждого поколения перечислены только удаленные комплектации
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Success 200 {array} dto.ModelVariant "Удаленные поколения и комплектации"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model not found"
+// @Failure 500 {string} string "Failed to fetch variant trash"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Router /models/{id}/variants/trash [get]
+func (api *VariantHandler) GetVariantTrash(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.GetVariantTrash")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id")
+	if !ok {
+		return
+	}
+	variants, err := api.VariantService.GetTrash(spanCtx, ids[0])
+	if err != nil {
+		writeError(ctx, span, err, ids[0], "Failed to fetch variant trash")
+		return
+	}
+	writeJSON(ctx, span, http.StatusOK, variants)
+}
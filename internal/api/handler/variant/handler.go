@@ -0,0 +1,32 @@
+package variant
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/variant"
+	"github.com/fasthttp/router"
+)
+
+type VariantHandler struct {
+	VariantService *variant.VariantService
+}
+
+func New(variantService *variant.VariantService) *VariantHandler {
+	return &VariantHandler{
+		VariantService: variantService,
+	}
+}
+
+func (api *VariantHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	r.GET("/models/{id}/variants", a.Require(auth.ScopeRead, api.GetVariants))
+	group := r.Group("/models/{id}/variants")
+	group.GET("/trash", a.Require(auth.ScopeWrite, api.GetVariantTrash))
+	group.POST("/generations", a.Require(auth.ScopeWrite, api.CreateGeneration))
+	group.PUT("/generations/{generation_id}", a.Require(auth.ScopeWrite, api.UpdateGeneration))
+	group.DELETE("/generations/{generation_id}", a.Require(auth.ScopeWrite, api.DeleteGeneration))
+	group.POST("/generations/{generation_id}/restore", a.Require(auth.ScopeAdmin, api.RestoreGeneration))
+	group.POST("/generations/{generation_id}/trims", a.Require(auth.ScopeWrite, api.CreateTrim))
+	group.PUT("/generations/{generation_id}/trims/{trim_id}", a.Require(auth.ScopeWrite, api.UpdateTrim))
+	group.DELETE("/generations/{generation_id}/trims/{trim_id}", a.Require(auth.ScopeWrite, api.DeleteTrim))
+	group.POST("/generations/{generation_id}/trims/{trim_id}/restore", a.Require(auth.ScopeAdmin, api.RestoreTrim))
+}
@@ -0,0 +1,190 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// CreateTrim godoc
+// @Summary Создание комплектации
+// @Description Добавляет комплектацию неудаленному поколению модели. Название уникально среди неудаленных комплектаций поколения без учета регистра
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Param trim body dto.ModelTrimRequest true "Комплектация"
+// @Success 201 {object} dto.ModelTrim "Созданная комплектация"
+// @Failure 400 {string} string "Invalid id format, request body or name"
+// @Failure 404 {string} string "Model or generation not found"
+// @Failure 409 {string} string "Name is already taken"
+// @Failure 500 {string} string "Failed to create trim"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id}/trims [post]
+func (api *VariantHandler) CreateTrim(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.CreateTrim")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id")
+	if !ok {
+		return
+	}
+	var req dto.ModelTrimRequest
+	if !decodeBody(ctx, span, &req) {
+		return
+	}
+
+	trim := &dto.ModelTrim{
+		GenerationID: ids[1],
+		Name:         req.Name,
+		IsUpcoming:   req.IsUpcoming,
+		IsLimited:    req.IsLimited,
+	}
+	if err := api.VariantService.CreateTrim(spanCtx, ids[0], trim); err != nil {
+		writeError(ctx, span, err, ids[1], "Failed to create trim")
+		return
+	}
+	writeJSON(ctx, span, http.StatusCreated, trim)
+}
+
+// UpdateTrim godoc
+// @Summary Изменение комплектации
+// @Description Заменяет название и флаги неудаленной комплектации
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Param trim_id path string true "ID комплектации"
+// @Param trim body dto.ModelTrimRequest true "Комплектация"
+// @Success 200 {object} dto.ModelTrim "Измененная комплектация"
+// @Failure 400 {string} string "Invalid id format, request body or name"
+// @Failure 404 {string} string "Model, generation or trim not found"
+// @Failure 409 {string} string "Name is already taken"
+// @Failure 500 {string} string "Failed to update trim"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id}/trims/{trim_id} [put]
+func (api *VariantHandler) UpdateTrim(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.UpdateTrim")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id", "trim_id")
+	if !ok {
+		return
+	}
+	var req dto.ModelTrimRequest
+	if !decodeBody(ctx, span, &req) {
+		return
+	}
+
+	trim := &dto.ModelTrim{
+		ID:           ids[2],
+		GenerationID: ids[1],
+		Name:         req.Name,
+		IsUpcoming:   req.IsUpcoming,
+		IsLimited:    req.IsLimited,
+	}
+	if err := api.VariantService.UpdateTrim(spanCtx, ids[0], trim); err != nil {
+		writeError(ctx, span, err, ids[2], "Failed to update trim")
+		return
+	}
+	writeJSON(ctx, span, http.StatusOK, trim)
+}
+
+// DeleteTrim godoc
+// @Summary Мягкое удаление комплектации
+// @Description Мягко удаляет комплектацию неудаленного поколения
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Param trim_id path string true "ID комплектации"
+// @Success 200 {string} string "Trim soft-deleted successfully"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model, generation or trim not found"
+// @Failure 500 {string} string "Failed to delete trim"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id}/trims/{trim_id} [delete]
+func (api *VariantHandler) DeleteTrim(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.DeleteTrim")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id", "trim_id")
+	if !ok {
+		return
+	}
+	if err := api.VariantService.DeleteTrim(spanCtx, ids[0], ids[1], ids[2]); err != nil {
+		writeError(ctx, span, err, ids[2], "Failed to delete trim")
+		return
+	}
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Trim soft-deleted successfully")
+}
+
+// RestoreTrim godoc
+// @Summary Восстановление комплектации
+// @Description Восстанавливает удаленную комплектацию. Комплектацию удаленного поколения нельзя восстановить, пока не восстановлено поколение
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID модели"
+// @Param generation_id path string true "ID поколения"
+// @Param trim_id path string true "ID комплектации"
+// @Success 200 {string} string "Trim restored successfully"
+// @Failure 400 {string} string "Invalid id format"
+// @Failure 404 {string} string "Model or deleted trim not found"
+// @Failure 409 {string} string "Generation is deleted or name is already taken"
+// @Failure 500 {string} string "Failed to restore trim"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenResponse "Forbidden"
+// @Router /models/{id}/variants/generations/{generation_id}/trims/{trim_id}/restore [post]
+func (api *VariantHandler) RestoreTrim(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "VariantHandler.RestoreTrim")
+	defer span.Finish()
+
+	ids, ok := extractIDs(ctx, span, "id", "generation_id", "trim_id")
+	if !ok {
+		return
+	}
+	if err := api.VariantService.RestoreTrim(spanCtx, ids[0], ids[1], ids[2]); err != nil {
+		writeError(ctx, span, err, ids[2], "Failed to restore trim")
+		return
+	}
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Trim restored successfully")
+}
@@ -0,0 +1,107 @@
+package variant
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/rbac"
+	modelrepo "Brands/internal/repository/model"
+	variantrepo "Brands/internal/repository/variant"
+	"Brands/internal/service/variant"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// extractIDs извлекает из пути ID записей по ключам; при ошибке отвечает 400
+func extractIDs(ctx *fasthttp.RequestCtx, span opentracing.Span, keys ...string) ([]uuid.UUID, bool) {
+	ids := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		id, err := utils.ExtractUUIDFromPath(ctx, key)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "invalid_id"),
+				log.String("key", key),
+				log.Error(err),
+			)
+			ctx.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(fmt.Sprintf("Invalid %s format", key))
+			return nil, false
+		}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+// decodeBody разбирает тело запроса; при ошибке отвечает 400
+func decodeBody(ctx *fasthttp.RequestCtx, span opentracing.Span, v any) bool {
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	if err := decoder.Decode(v); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeError(ctx *fasthttp.RequestCtx, span opentracing.Span, err error, id uuid.UUID, msg string) {
+	span.SetTag("error", true)
+	switch {
+	case errors.Is(err, rbac.ErrForbidden):
+		utils.WriteForbidden(ctx, err)
+	case errors.Is(err, variant.ErrNameRequired),
+		errors.Is(err, variant.ErrNameTooLong),
+		errors.Is(err, variant.ErrInvalidStartYear),
+		errors.Is(err, variant.ErrInvalidYearRange):
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+	case errors.Is(err, modelrepo.ErrModelNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Model not found with ID: %s", ctx.UserValue("id")))
+	case errors.Is(err, variantrepo.ErrGenerationNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Generation not found with ID: %s", ctx.UserValue("generation_id")))
+	case errors.Is(err, variantrepo.ErrTrimNotFound):
+		ctx.Response.SetStatusCode(http.StatusNotFound)
+		ctx.Response.SetBodyString(fmt.Sprintf("Trim not found with ID: %s", ctx.UserValue("trim_id")))
+	case errors.Is(err, variantrepo.ErrNameTaken),
+		errors.Is(err, variantrepo.ErrGenerationDeleted):
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBodyString(err.Error())
+	default:
+		span.LogFields(
+			log.String("event", "variant_error"),
+			log.Error(err),
+			log.String("entity.id", id.String()),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func writeJSON(ctx *fasthttp.RequestCtx, span opentracing.Span, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "json_marshal_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal response: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(status)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookservice "Brands/internal/service/webhook"
+)
+
+// CreateWebhook godoc
+// @Summary Создание подписки на вебхуки
+// @Description Создает подписку на события изменения брендов и моделей. Запросы подписываются заголовком X-Webhook-Signature: sha256=HMAC-SHA256(secret, "<X-Webhook-Timestamp>.<тело>"). Секрет возвращается только в этом ответе
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param request body dto.WebhookSubscriptionRequest true "Адрес, типы событий и секрет подписки"
+// @Success 201 {object} dto.CreatedWebhookSubscription "Созданная подписка"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 500 {string} string "Failed to create webhook"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/create [post]
+func (api *WebhookHandler) CreateWebhook(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.CreateWebhook")
+	defer span.Finish()
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.WebhookSubscriptionRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	created, err := api.WebhookService.Create(spanCtx, &req)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "create_webhook_error"),
+			log.Error(err),
+		)
+		if isValidationError(err) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to create webhook: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(created)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_webhook"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal webhook: %v", err))
+		return
+	}
+	ctx.Response.Header.Set(fasthttp.HeaderCacheControl, "no-store")
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusCreated)
+	ctx.Response.SetBody(data)
+}
+
+func isValidationError(err error) bool {
+	return errors.Is(err, webhookservice.ErrInvalidURL) ||
+		errors.Is(err, webhookservice.ErrPrivateURL) ||
+		errors.Is(err, webhookservice.ErrEmptyEventTypes) ||
+		errors.Is(err, webhookservice.ErrUnknownEventType) ||
+		errors.Is(err, webhookservice.ErrSecretTooShort) ||
+		errors.Is(err, webhookservice.ErrUnknownStatus)
+}
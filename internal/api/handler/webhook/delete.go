@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"Brands/internal/api/handler/utils"
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookrepo "Brands/internal/repository/webhook"
+)
+
+// DeleteWebhook godoc
+// @Summary Удаление подписки на вебхуки
+// @Description Удаляет подписку вместе с журналом доставок; неотправленные доставки отменяются
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "ID подписки"
+// @Success 200 {string} string "Webhook deleted successfully"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Webhook not found"
+// @Failure 500 {string} string "Failed to delete webhook"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/delete/{id} [delete]
+func (api *WebhookHandler) DeleteWebhook(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.DeleteWebhook")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	err = api.WebhookService.Delete(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, webhookrepo.ErrSubscriptionNotFound) {
+			span.LogFields(
+				log.String("event", "webhook_not_found"),
+				log.String("webhook.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Webhook not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "delete_webhook_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to delete webhook: %v", err))
+		return
+	}
+
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString("Webhook deleted successfully")
+}
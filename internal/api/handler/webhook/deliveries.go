@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookrepo "Brands/internal/repository/webhook"
+)
+
+// GetWebhookDeliveries godoc
+// @Summary Журнал доставок подписки
+// @Description Возвращает доставки событий подписке с числом попыток, последним HTTP-статусом и ошибкой, от новых к старым
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "ID подписки"
+// @Param status query string false "Статус доставки: pending, delivered или dead"
+// @Param limit query integer false "Размер страницы (по умолчанию 50, максимум 500)"
+// @Param offset query integer false "Смещение"
+// @Success 200 {object} dto.WebhookDeliveryPage "Страница журнала доставок"
+// @Failure 400 {string} string "Invalid query parameter"
+// @Failure 404 {string} string "Webhook not found"
+// @Failure 500 {string} string "Failed to fetch webhook deliveries"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/{id}/deliveries [get]
+func (api *WebhookHandler) GetWebhookDeliveries(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.GetWebhookDeliveries")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	limit, offset, err := utils.ParsePagination(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_query"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(err.Error())
+		return
+	}
+	filter := dto.WebhookDeliveryFilter{
+		SubscriptionID: id,
+		Status:         string(ctx.QueryArgs().Peek("status")),
+		Limit:          limit,
+		Offset:         offset,
+	}
+
+	page, err := api.WebhookService.Deliveries(spanCtx, filter)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, webhookrepo.ErrSubscriptionNotFound) {
+			span.LogFields(
+				log.String("event", "webhook_not_found"),
+				log.String("webhook.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Webhook not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "failed_to_fetch_webhook_deliveries"),
+			log.Error(err),
+		)
+		if isValidationError(err) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch webhook deliveries: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_webhook_deliveries"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal webhook deliveries: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/valyala/fasthttp"
+	"net/http"
+)
+
+// GetAllWebhooks godoc
+// @Summary Получение всех подписок на вебхуки
+// @Description Возвращает все подписки, включая приостановленные; секреты не возвращаются
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.WebhookSubscription "Список подписок"
+// @Failure 500 {string} string "Failed to fetch webhooks"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/all [get]
+func (api *WebhookHandler) GetAllWebhooks(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.GetAllWebhooks")
+	defer span.Finish()
+
+	subs, err := api.WebhookService.GetAll(spanCtx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_fetch_webhooks"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch webhooks: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(subs)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_webhooks"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal webhooks: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"Brands/internal/api/handler/utils"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookrepo "Brands/internal/repository/webhook"
+)
+
+// GetWebhookByID godoc
+// @Summary Получение подписки на вебхуки по ID
+// @Description Возвращает подписку без секрета
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "ID подписки"
+// @Success 200 {object} dto.WebhookSubscription "Подписка"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Webhook not found"
+// @Failure 500 {string} string "Failed to fetch webhook"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/{id} [get]
+func (api *WebhookHandler) GetWebhookByID(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.GetWebhookByID")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	sub, err := api.WebhookService.GetByID(spanCtx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, webhookrepo.ErrSubscriptionNotFound) {
+			span.LogFields(
+				log.String("event", "webhook_not_found"),
+				log.String("webhook.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Webhook not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "failed_to_fetch_webhook"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to fetch webhook: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(sub)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_webhook"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal webhook: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
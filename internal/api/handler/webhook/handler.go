@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	_ "Brands/docs"
+	"Brands/internal/auth"
+	"Brands/internal/service/webhook"
+	"github.com/fasthttp/router"
+)
+
+type WebhookHandler struct {
+	WebhookService *webhook.WebhookService
+}
+
+func New(webhookService *webhook.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		WebhookService: webhookService,
+	}
+}
+
+func (api *WebhookHandler) SetupRoutes(r *router.Router, a *auth.Authenticator) {
+	group := r.Group("/webhooks")
+	group.POST("/create", a.Require(auth.ScopeAdmin, api.CreateWebhook))
+	group.GET("/all", a.Require(auth.ScopeAdmin, api.GetAllWebhooks))
+	group.GET("/{id}", a.Require(auth.ScopeAdmin, api.GetWebhookByID))
+	group.PUT("/update/{id}", a.Require(auth.ScopeAdmin, api.UpdateWebhook))
+	group.DELETE("/delete/{id}", a.Require(auth.ScopeAdmin, api.DeleteWebhook))
+	group.GET("/{id}/deliveries", a.Require(auth.ScopeAdmin, api.GetWebhookDeliveries))
+	group.POST("/{id}/deliveries/{delivery_id}/redeliver", a.Require(auth.ScopeAdmin, api.RedeliverWebhook))
+}
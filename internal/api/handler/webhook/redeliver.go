@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"Brands/internal/api/handler/utils"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookrepo "Brands/internal/repository/webhook"
+)
+
+// RedeliverWebhook godoc
+// @Summary Повторная отправка доставки
+// @Description Возвращает доставку в очередь со сброшенным счетчиком попыток, в том числе после перевода в dead. Получатель увидит тот же X-Webhook-ID и ID события
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "ID подписки"
+// @Param delivery_id path string true "ID доставки"
+// @Success 202 {object} dto.WebhookDelivery "Доставка поставлена в очередь"
+// @Failure 400 {string} string "Invalid ID format"
+// @Failure 404 {string} string "Delivery not found"
+// @Failure 500 {string} string "Failed to redeliver webhook"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (api *WebhookHandler) RedeliverWebhook(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.RedeliverWebhook")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+	deliveryID, err := utils.ExtractUUIDFromPath(ctx, "delivery_id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_delivery_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid delivery ID format")
+		return
+	}
+
+	delivery, err := api.WebhookService.Redeliver(spanCtx, id, deliveryID)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, webhookrepo.ErrDeliveryNotFound) {
+			span.LogFields(
+				log.String("event", "delivery_not_found"),
+				log.String("webhook.id", id.String()),
+				log.String("delivery.id", deliveryID.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Delivery not found with ID: %s", deliveryID))
+			return
+		}
+		span.LogFields(
+			log.String("event", "redeliver_webhook_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to redeliver webhook: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_delivery"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal delivery: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusAccepted)
+	ctx.Response.SetBody(data)
+}
@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"Brands/internal/api/handler/utils"
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"net/http"
+
+	webhookrepo "Brands/internal/repository/webhook"
+)
+
+// UpdateWebhook godoc
+// @Summary Изменение подписки на вебхуки
+// @Description Заменяет адрес, типы событий, описание и статус подписки. Непустой secret заменяет ключ подписи; is_active=false приостанавливает отправку, доставки копятся до возобновления
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "ID подписки"
+// @Param request body dto.WebhookSubscriptionRequest true "Новые параметры подписки"
+// @Success 200 {object} dto.WebhookSubscription "Обновленная подписка"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 404 {string} string "Webhook not found"
+// @Failure 500 {string} string "Failed to update webhook"
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /webhooks/update/{id} [put]
+func (api *WebhookHandler) UpdateWebhook(ctx *fasthttp.RequestCtx) {
+	var spanCtx context.Context
+	spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+	if !ok {
+		spanCtx = ctx
+	}
+	span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "WebhookHandler.UpdateWebhook")
+	defer span.Finish()
+
+	id, err := utils.ExtractUUIDFromPath(ctx, "id")
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "invalid_id"),
+			log.Error(err),
+		)
+		ctx.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString("Invalid ID format")
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(ctx.PostBody()))
+	var req dto.WebhookSubscriptionRequest
+	if err = decoder.Decode(&req); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "decode_error"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusBadRequest)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to decode request body: %v", err))
+		return
+	}
+
+	sub, err := api.WebhookService.Update(spanCtx, id, &req)
+	if err != nil {
+		span.SetTag("error", true)
+		if errors.Is(err, webhookrepo.ErrSubscriptionNotFound) {
+			span.LogFields(
+				log.String("event", "webhook_not_found"),
+				log.String("webhook.id", id.String()),
+			)
+			ctx.Response.SetStatusCode(http.StatusNotFound)
+			ctx.Response.SetBodyString(fmt.Sprintf("Webhook not found with ID: %s", id))
+			return
+		}
+		span.LogFields(
+			log.String("event", "update_webhook_error"),
+			log.Error(err),
+		)
+		if isValidationError(err) {
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(err.Error())
+			return
+		}
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to update webhook: %v", err))
+		return
+	}
+
+	data, err := json.Marshal(sub)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(
+			log.String("event", "failed_to_marshal_webhook"),
+			log.Error(err),
+		)
+		ctx.Response.SetStatusCode(http.StatusInternalServerError)
+		ctx.Response.SetBodyString(fmt.Sprintf("Failed to marshal webhook: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBody(data)
+}
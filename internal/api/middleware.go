@@ -2,23 +2,39 @@ package api
 
 import (
 	"Brands/internal/config"
+	"Brands/internal/i18n"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"runtime"
 	"runtime/debug"
 	"time"
 
+	"Brands/pkg/redact"
+	"Brands/pkg/tracer"
 	"Brands/pkg/zerohook"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 	"github.com/valyala/fasthttp"
 )
 
+const (
+	RequestIDHeader       = "X-Request-ID"
+	TraceIDHeader         = "X-Trace-ID"
+	AcceptLanguageHeader  = "Accept-Language"
+	ContentLanguageHeader = "Content-Language"
+
+	maxRequestIDLength = 128
+)
+
 func CORS(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
 		ctx.Response.Header.Set("Access-Control-Allow-Headers", config.CorsAllowHeaders)
 		ctx.Response.Header.Set("Access-Control-Allow-Methods", config.CorsAllowMethods)
 		ctx.Response.Header.Set("Access-Control-Allow-Origin", config.CorsAllowOrigin)
+		ctx.Response.Header.Set("Access-Control-Expose-Headers", config.CorsExposeHeaders)
 
 		next(ctx)
 	}
@@ -55,19 +71,40 @@ func RecoveryMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	}
 }
 
-func TraceMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+// TraceMiddleware открывает серверный спан запроса; тело запроса сохраняется
+// в спане с ограничениями и маскированием redactor
+func TraceMiddleware(next fasthttp.RequestHandler, redactor *redact.Redactor) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
-		requestID, ok := ctx.UserValue("request-id").(string)
-		if !ok {
-			requestID = uuid.New().String()
-			ctx.SetUserValue("request-id", requestID)
+		requestID := incomingRequestID(ctx)
+		if requestID == "" {
+			var ok bool
+			requestID, ok = ctx.UserValue("request-id").(string)
+			if !ok {
+				requestID = uuid.New().String()
+			}
 		}
+		ctx.SetUserValue("request-id", requestID)
+
 		ctxWithBackground, ok := ctx.UserValue("traceContext").(context.Context)
 		if !ok {
 			ctxWithBackground = context.Background()
 		}
+
+		// Продолжаем трассу вызывающей стороны, если она передала контекст
+		parent, err := tracer.Extract(requestHeaders(ctx))
+		if err != nil && !errors.Is(err, opentracing.ErrSpanContextNotFound) {
+			zerohook.Logger.Warn().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to extract incoming trace context")
+		}
+
 		operationName := fmt.Sprintf("%s %s", string(ctx.Method()), string(ctx.Path()))
-		span, spanCtx := opentracing.StartSpanFromContext(ctxWithBackground, operationName)
+		span, spanCtx := opentracing.StartSpanFromContext(
+			ctxWithBackground,
+			operationName,
+			ext.RPCServerOption(parent),
+		)
 		defer span.Finish()
 
 		span.SetTag("request_id", requestID)
@@ -80,9 +117,21 @@ func TraceMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 		span.SetTag("http.flavor", string(ctx.Request.Header.Protocol()))
 		span.SetTag("host.ip", string(ctx.RemoteIP().String()))
 		span.SetTag("http.request.body_size", fmt.Sprintf("%d", len(ctx.Request.Body())))
-		span.SetTag("http.request.body_stringify", string(ctx.Request.Body()))
+		if body := redactor.Body(string(ctx.Request.Header.ContentType()), ctx.Request.Body()); body != "" {
+			span.SetTag("http.request.body_stringify", body)
+		}
+		if tracestate := ctx.Request.Header.Peek(tracer.TracestateHeader); len(tracestate) > 0 {
+			span.SetTag("w3c.tracestate", string(tracestate))
+		}
+
+		traceID := tracer.TraceID(span)
+		ctx.Response.Header.Set(RequestIDHeader, requestID)
+		if traceID != "" {
+			ctx.Response.Header.Set(TraceIDHeader, traceID)
+		}
 
 		zerohook.Logger.Debug().
+			Ctx(spanCtx).
 			Str("request_id", requestID).
 			Msg("Trace context set")
 
@@ -93,6 +142,30 @@ func TraceMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	}
 }
 
+// incomingRequestID возвращает X-Request-ID вызывающей стороны,
+// если он не пустой и не длиннее maxRequestIDLength печатных символов
+func incomingRequestID(ctx *fasthttp.RequestCtx) string {
+	requestID := ctx.Request.Header.Peek(RequestIDHeader)
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return ""
+	}
+	for _, c := range requestID {
+		if c <= ' ' || c > '~' {
+			return ""
+		}
+	}
+	return string(requestID)
+}
+
+// requestHeaders копирует заголовки запроса в carrier для извлечения контекста трассировки
+func requestHeaders(ctx *fasthttp.RequestCtx) opentracing.HTTPHeadersCarrier {
+	headers := make(http.Header)
+	ctx.Request.Header.VisitAll(func(key, value []byte) {
+		headers.Add(string(key), string(value))
+	})
+	return opentracing.HTTPHeadersCarrier(headers)
+}
+
 // LoggingMiddleware логирует каждый запрос с включением request_id.
 func LoggingMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
@@ -113,7 +186,7 @@ func LoggingMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 		next(ctx)
 		end := time.Now()
 		zerohook.Logger.Info().
-			Str("request_id", requestID).
+			Ctx(ctxWithRequestID).
 			Bytes("method", ctx.Method()).
 			Str("url", string(ctx.URI().String())).
 			Int("status", ctx.Response.StatusCode()).
@@ -121,3 +194,24 @@ func LoggingMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 			Msg("Completed request")
 	}
 }
+
+// LocaleMiddleware определяет язык ответа по параметру lang и заголовку
+// Accept-Language и сохраняет его в контексте трассировки. Язык хранится
+// только в контексте: значение "locale" занято параметром пути.
+func LocaleMiddleware(next fasthttp.RequestHandler, locales *i18n.Negotiator) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		locale := locales.Negotiate(
+			string(ctx.QueryArgs().Peek("lang")),
+			string(ctx.Request.Header.Peek(AcceptLanguageHeader)),
+		)
+		traceCtx, ok := ctx.UserValue("traceContext").(context.Context)
+		if !ok {
+			traceCtx = context.Background()
+		}
+		ctx.SetUserValue("traceContext", i18n.WithLocale(traceCtx, locale))
+
+		ctx.Response.Header.Set(ContentLanguageHeader, locale.Tag)
+		ctx.Response.Header.Add("Vary", AcceptLanguageHeader)
+		next(ctx)
+	}
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// APIKeyHeader заголовок, в котором сервисные клиенты передают API-ключ
+const APIKeyHeader = "X-API-Key"
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKeyVerifier проверяет API-ключ и возвращает его субъекта.
+// Для неизвестного, истекшего или отозванного ключа возвращается ErrInvalidAPIKey.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+// SetAPIKeyVerifier включает аутентификацию по заголовку X-API-Key
+func (a *Authenticator) SetAPIKeyVerifier(verifier APIKeyVerifier) {
+	a.apiKeys = verifier
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrMissingToken      = errors.New("missing bearer token")
+	ErrInvalidToken      = errors.New("invalid token")
+	ErrInsufficientScope = errors.New("insufficient scope")
+)
+
+var supportedAlgs = []string{"RS256", "ES256", "HS256"}
+
+// Authenticator проверяет JWT и сопоставляет клеймы со scope
+type Authenticator struct {
+	cfg    Config
+	keys   *KeySet
+	parser *jwt.Parser
+	log    zerolog.Logger
+
+	apiKeys APIKeyVerifier
+}
+
+// New создает Authenticator; при выключенной аутентификации ключи не загружаются
+func New(ctx context.Context, cfg Config, log zerolog.Logger) (*Authenticator, error) {
+	a := &Authenticator{cfg: cfg, log: log}
+	if len(a.cfg.ScopeClaims) == 0 {
+		a.cfg.ScopeClaims = defaultScopeClaims
+	}
+	if len(a.cfg.RoleClaims) == 0 {
+		a.cfg.RoleClaims = defaultRoleClaims
+	}
+	if !cfg.Enabled {
+		log.Warn().Msg("JWT authentication is disabled")
+		return a, nil
+	}
+
+	keys, err := NewKeySet(ctx, cfg, log)
+	if err != nil {
+		return nil, err
+	}
+	a.keys = keys
+	go keys.Run(ctx)
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(supportedAlgs),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	a.parser = jwt.NewParser(opts...)
+	return a, nil
+}
+
+// Enabled сообщает, включена ли проверка токенов
+func (a *Authenticator) Enabled() bool {
+	return a.cfg.Enabled
+}
+
+// Authenticate проверяет подпись и клеймы токена и возвращает субъекта
+func (a *Authenticator) Authenticate(ctx context.Context, rawToken string) (*Principal, error) {
+	if rawToken == "" {
+		return nil, ErrMissingToken
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := a.keys.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.alg != token.Method.Alg() {
+			return nil, fmt.Errorf("algorithm %s does not match key %q", token.Method.Alg(), kid)
+		}
+		return key.key, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, errors.Wrap(ErrInvalidToken, "missing sub claim")
+	}
+
+	principal := &Principal{
+		Kind:    PrincipalUser,
+		Subject: subject,
+		Scopes:  make(map[string]struct{}),
+	}
+	for _, claim := range a.cfg.ScopeClaims {
+		if value, ok := claims[claim]; ok {
+			claimValues(value, principal.Scopes)
+		}
+	}
+	roles := make(map[string]struct{})
+	for _, claim := range a.cfg.RoleClaims {
+		if value, ok := claims[claim]; ok {
+			claimValues(value, roles)
+		}
+	}
+	for role := range roles {
+		principal.Roles = append(principal.Roles, role)
+	}
+	return principal, nil
+}
@@ -0,0 +1,17 @@
+package auth
+
+import "time"
+
+// Config настройки аутентификации по JWT
+type Config struct {
+	Enabled         bool          `yaml:"enabled"`          // Включить проверку токенов
+	JWKSFile        string        `yaml:"jwks_file"`        // Путь к локальному файлу JWKS
+	JWKSURL         string        `yaml:"jwks_url"`         // URL набора ключей JWKS
+	RefreshInterval time.Duration `yaml:"refresh_interval"` // Период обновления JWKS
+	Issuer          string        `yaml:"issuer"`           // Ожидаемый издатель (iss)
+	Audience        string        `yaml:"audience"`         // Ожидаемая аудитория (aud)
+	Leeway          time.Duration `yaml:"leeway"`           // Допустимое расхождение часов
+	ScopeClaims     []string      `yaml:"scope_claims"`     // Клеймы со списком scope
+	RoleClaims      []string      `yaml:"role_claims"`      // Клеймы со списком ролей RBAC
+	PublicReads     bool          `yaml:"public_reads"`     // Разрешить чтение без токена
+}
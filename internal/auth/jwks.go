@@ -0,0 +1,261 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultRefreshInterval = 10 * time.Minute
+	// minRefreshInterval ограничивает частоту внеплановых обновлений
+	// набора ключей при появлении неизвестного kid
+	minRefreshInterval = 30 * time.Second
+	fetchTimeout       = 10 * time.Second
+)
+
+// jwk ключ в формате RFC 7517
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	// Симметричный ключ
+	K string `json:"k"`
+}
+
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+// publicKey ключ проверки подписи с ожидаемым алгоритмом
+type publicKey struct {
+	alg string
+	key any
+}
+
+// KeySet кэширует набор ключей JWKS из файла или по URL и периодически
+// обновляет его, чтобы подхватывать ротацию ключей
+type KeySet struct {
+	file            string
+	url             string
+	refreshInterval time.Duration
+	client          *http.Client
+	log             zerolog.Logger
+
+	mu          sync.RWMutex
+	keys        map[string]publicKey
+	lastRefresh time.Time
+	// refreshMu не дает параллельным запросам с неизвестным kid
+	// одновременно перечитывать источник
+	refreshMu sync.Mutex
+}
+
+// NewKeySet загружает набор ключей и возвращает кэш
+func NewKeySet(ctx context.Context, cfg Config, log zerolog.Logger) (*KeySet, error) {
+	if cfg.JWKSFile == "" && cfg.JWKSURL == "" {
+		return nil, errors.New("jwks_file or jwks_url must be set")
+	}
+	ks := &KeySet{
+		file:            cfg.JWKSFile,
+		url:             cfg.JWKSURL,
+		refreshInterval: cfg.RefreshInterval,
+		client:          &http.Client{Timeout: fetchTimeout},
+		log:             log,
+		keys:            make(map[string]publicKey),
+	}
+	if ks.refreshInterval <= 0 {
+		ks.refreshInterval = defaultRefreshInterval
+	}
+	if err := ks.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Run периодически обновляет набор ключей до отмены контекста
+func (ks *KeySet) Run(ctx context.Context) {
+	ticker := time.NewTicker(ks.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.Refresh(ctx); err != nil {
+				ks.log.Error().Err(err).Msg("Failed to refresh JWKS")
+			}
+		}
+	}
+}
+
+// Refresh перечитывает набор ключей из источника
+func (ks *KeySet) Refresh(ctx context.Context) error {
+	data, err := ks.fetch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to load jwks")
+	}
+
+	var set jwkSet
+	if err = json.Unmarshal(data, &set); err != nil {
+		return errors.Wrap(err, "unable to decode jwks")
+	}
+
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			ks.log.Warn().Err(err).Str("kid", k.Kid).Msg("Skipping invalid JWK")
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("jwks contains no usable signing keys")
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.lastRefresh = time.Now()
+	ks.mu.Unlock()
+
+	ks.log.Info().Int("keys_count", len(keys)).Msg("JWKS loaded")
+	return nil
+}
+
+// Key возвращает ключ по kid; при промахе набор ключей обновляется
+// не чаще одного раза в minRefreshInterval
+func (ks *KeySet) Key(ctx context.Context, kid string) (publicKey, error) {
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	stale := time.Since(ks.lastRefresh) > minRefreshInterval
+	ks.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if stale {
+		ks.refreshMu.Lock()
+		ks.mu.RLock()
+		stale = time.Since(ks.lastRefresh) > minRefreshInterval
+		ks.mu.RUnlock()
+		if stale {
+			if err := ks.Refresh(ctx); err != nil {
+				ks.log.Error().Err(err).Msg("Failed to refresh JWKS on unknown kid")
+			}
+		}
+		ks.refreshMu.Unlock()
+
+		ks.mu.RLock()
+		key, ok = ks.keys[kid]
+		ks.mu.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+	return publicKey{}, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (ks *KeySet) fetch(ctx context.Context) ([]byte, error) {
+	if ks.file != "" {
+		return os.ReadFile(ks.file)
+	}
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected jwks response status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+func (k jwk) publicKey() (publicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return publicKey{}, errors.Wrap(err, "invalid rsa modulus")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() {
+			return publicKey{}, errors.New("invalid rsa exponent")
+		}
+		return publicKey{alg: algOrDefault(k.Alg, "RS256"), key: &rsa.PublicKey{N: n, E: int(e.Int64())}}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return publicKey{}, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return publicKey{}, errors.Wrap(err, "invalid ec x")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return publicKey{}, errors.Wrap(err, "invalid ec y")
+		}
+		curve := elliptic.P256()
+		if !curve.IsOnCurve(x, y) {
+			return publicKey{}, errors.New("ec point is not on curve")
+		}
+		return publicKey{alg: algOrDefault(k.Alg, "ES256"), key: &ecdsa.PublicKey{Curve: curve, X: x, Y: y}}, nil
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.K, "="))
+		if err != nil || len(secret) == 0 {
+			return publicKey{}, errors.New("invalid symmetric key")
+		}
+		return publicKey{alg: algOrDefault(k.Alg, "HS256"), key: secret}, nil
+	default:
+		return publicKey{}, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func algOrDefault(alg, def string) string {
+	if alg == "" {
+		return def
+	}
+	return alg
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// ActorKey ключ контекста, из которого zerohook берет actor_guid
+	ActorKey = "actor-guid"
+	// AppTagKey ключ контекста, из которого zerohook берет app_tag
+	AppTagKey = "app-tag"
+	// PrincipalUserValue ключ fasthttp.RequestCtx с субъектом запроса
+	PrincipalUserValue = "principal"
+)
+
+type principalKey struct{}
+
+var bearerPrefix = []byte("Bearer ")
+
+// PrincipalFromContext возвращает субъекта, аутентифицированного в запросе
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// ContextWithPrincipal сохраняет субъекта и его идентификатор как actor-guid;
+// для API-ключа его название дополнительно попадает в app_tag логов
+func ContextWithPrincipal(ctx context.Context, p *Principal) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, p)
+	if p.Kind == PrincipalAPIKey && p.Name != "" {
+		ctx = context.WithValue(ctx, AppTagKey, p.Name)
+	}
+	return context.WithValue(ctx, ActorKey, p.Subject)
+}
+
+// Require пропускает запрос только с валидным токеном или API-ключом,
+// содержащим scope. Субъект сохраняется в контексте трассировки запроса.
+// Заголовок X-API-Key проверяется первым и учитывается даже при выключенной
+// проверке JWT, если подключен APIKeyVerifier.
+func (a *Authenticator) Require(scope string, next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		apiKey := ctx.Request.Header.Peek(APIKeyHeader)
+		useAPIKey := len(apiKey) > 0 && a.apiKeys != nil
+		if !a.Enabled() && !useAPIKey {
+			next(ctx)
+			return
+		}
+
+		header := ctx.Request.Header.Peek(fasthttp.HeaderAuthorization)
+		if scope == ScopeRead && a.cfg.PublicReads && len(header) == 0 && len(apiKey) == 0 {
+			next(ctx)
+			return
+		}
+
+		traceCtx, ok := ctx.UserValue("traceContext").(context.Context)
+		if !ok {
+			traceCtx = context.Background()
+		}
+		span, spanCtx := opentracing.StartSpanFromContext(traceCtx, "Auth.Require")
+
+		var (
+			principal *Principal
+			err       error
+		)
+		if useAPIKey {
+			span.SetTag("auth.method", PrincipalAPIKey)
+			principal, err = a.apiKeys.VerifyAPIKey(spanCtx, string(apiKey))
+		} else {
+			var rawToken string
+			if len(header) > len(bearerPrefix) && bytes.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+				rawToken = string(bytes.TrimSpace(header[len(bearerPrefix):]))
+			}
+			principal, err = a.Authenticate(spanCtx, rawToken)
+		}
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "authentication_failed"),
+				log.Error(err),
+			)
+			span.Finish()
+			a.log.Warn().Ctx(traceCtx).Err(err).Msg("Authentication failed")
+
+			challenge := `Bearer`
+			switch {
+			case useAPIKey:
+				challenge = fmt.Sprintf(`APIKey header=%q`, APIKeyHeader)
+			case !errors.Is(err, ErrMissingToken):
+				challenge = `Bearer error="invalid_token"`
+			}
+			ctx.Response.Header.Set(fasthttp.HeaderWWWAuthenticate, challenge)
+			ctx.Response.SetStatusCode(http.StatusUnauthorized)
+			ctx.Response.SetBodyString("Unauthorized")
+			return
+		}
+
+		span.SetTag("enduser.id", principal.Subject)
+		if !principal.HasScope(scope) {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "insufficient_scope"),
+				log.String("scope", scope),
+			)
+			span.Finish()
+			a.log.Warn().
+				Ctx(ContextWithPrincipal(traceCtx, principal)).
+				Str("scope", scope).
+				Msg("Insufficient scope")
+
+			ctx.Response.Header.Set(
+				fasthttp.HeaderWWWAuthenticate,
+				fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, scope),
+			)
+			ctx.Response.SetStatusCode(http.StatusForbidden)
+			ctx.Response.SetBodyString(fmt.Sprintf("Forbidden: scope %s is required", scope))
+			return
+		}
+		span.Finish()
+
+		if parent := opentracing.SpanFromContext(traceCtx); parent != nil {
+			parent.SetTag("enduser.id", principal.Subject)
+		}
+		ctx.SetUserValue(PrincipalUserValue, principal)
+		ctx.SetUserValue("traceContext", ContextWithPrincipal(traceCtx, principal))
+		next(ctx)
+	}
+}
@@ -0,0 +1,78 @@
+package auth
+
+import "strings"
+
+const (
+	ScopeRead  = "brands:read"
+	ScopeWrite = "brands:write"
+	ScopeAdmin = "brands:admin"
+)
+
+// scopeImplies описывает иерархию: admin включает write, write включает read
+var scopeImplies = map[string][]string{
+	ScopeAdmin: {ScopeWrite, ScopeRead},
+	ScopeWrite: {ScopeRead},
+}
+
+const (
+	PrincipalUser   = "user"
+	PrincipalAPIKey = "api_key"
+)
+
+var (
+	defaultScopeClaims = []string{"scope", "scp", "scopes"}
+	defaultRoleClaims  = []string{"roles"}
+)
+
+// Principal аутентифицированный субъект запроса
+type Principal struct {
+	Kind    string // user или api_key
+	Subject string // sub токена либо ID API-ключа
+	Name    string // Название API-ключа
+	Scopes  map[string]struct{}
+	Roles   []string // Роли для проверки разрешений RBAC
+}
+
+// KnownScope проверяет, что scope поддерживается сервисом
+func KnownScope(scope string) bool {
+	switch scope {
+	case ScopeRead, ScopeWrite, ScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// HasScope проверяет наличие scope у субъекта с учетом иерархии
+func (p *Principal) HasScope(scope string) bool {
+	if p == nil {
+		return false
+	}
+	if _, ok := p.Scopes[scope]; ok {
+		return true
+	}
+	for granted := range p.Scopes {
+		for _, implied := range scopeImplies[granted] {
+			if implied == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// claimValues разбирает значение клейма со scope или ролями:
+// строку через пробел или массив строк
+func claimValues(value any, values map[string]struct{}) {
+	switch v := value.(type) {
+	case string:
+		for _, entry := range strings.Fields(v) {
+			values[entry] = struct{}{}
+		}
+	case []any:
+		for _, item := range v {
+			if entry, ok := item.(string); ok && entry != "" {
+				values[entry] = struct{}{}
+			}
+		}
+	}
+}
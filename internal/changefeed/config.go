@@ -0,0 +1,41 @@
+package changefeed
+
+import "time"
+
+const (
+	defaultPollInterval   = time.Second
+	defaultHeartbeat      = 15 * time.Second
+	defaultBufferSize     = 256
+	defaultMaxSubscribers = 1000
+	defaultRetry          = 3 * time.Second
+	defaultPageSize       = 500
+)
+
+// Config настройки ленты изменений /changes/stream
+type Config struct {
+	Enabled        bool          `yaml:"enabled"`         // Принимать подписки на ленту
+	PollInterval   time.Duration `yaml:"poll_interval"`   // Период опроса новых событий
+	Heartbeat      time.Duration `yaml:"heartbeat"`       // Период комментария-пульса в пустом потоке
+	BufferSize     int           `yaml:"buffer_size"`     // Событий в очереди соединения до его разрыва
+	MaxSubscribers int           `yaml:"max_subscribers"` // Одновременных соединений, сверх — 503
+	Retry          time.Duration `yaml:"retry"`           // Задержка переподключения, сообщаемая клиенту
+}
+
+func (c Config) withDefaults() Config {
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultPollInterval
+	}
+	if c.Heartbeat <= 0 {
+		c.Heartbeat = defaultHeartbeat
+	}
+	if c.BufferSize <= 0 {
+		c.BufferSize = defaultBufferSize
+	}
+	if c.MaxSubscribers <= 0 {
+		c.MaxSubscribers = defaultMaxSubscribers
+	}
+	if c.Retry <= 0 {
+		c.Retry = defaultRetry
+	}
+	return c
+}
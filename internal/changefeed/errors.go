@@ -0,0 +1,10 @@
+package changefeed
+
+import "github.com/pkg/errors"
+
+var (
+	ErrDisabled           = errors.New("change feed is disabled")
+	ErrTooManySubscribers = errors.New("too many change feed subscribers")
+	ErrSlowConsumer       = errors.New("subscriber is too slow, reconnect with Last-Event-ID")
+	ErrClosed             = errors.New("change feed is shutting down")
+)
@@ -0,0 +1,44 @@
+package changefeed
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"encoding/json"
+	"github.com/google/uuid"
+)
+
+// Filter отбирает события для подписчика; пустой фильтр пропускает все
+type Filter struct {
+	EventTypes map[string]struct{} // Допустимые типы событий
+	BrandID    *uuid.UUID          // Бренд, его модели, их переводы и варианты
+}
+
+// Match сообщает, нужно ли отправить событие подписчику
+func (f Filter) Match(event dto.OutboxEvent) bool {
+	if len(f.EventTypes) > 0 {
+		if _, ok := f.EventTypes[event.EventType]; !ok {
+			return false
+		}
+	}
+	if f.BrandID == nil {
+		return true
+	}
+	switch event.AggregateType {
+	case audit.EntityBrand:
+		return event.AggregateID == *f.BrandID
+	case audit.EntityModel,
+		audit.EntityBrandTranslation,
+		audit.EntityModelTranslation,
+		audit.EntityModelGeneration,
+		audit.EntityModelTrim:
+		var payload struct {
+			BrandID uuid.UUID `json:"brand_id"`
+		}
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return payload.BrandID == *f.BrandID
+	default:
+		return false
+	}
+}
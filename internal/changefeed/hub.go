@@ -0,0 +1,238 @@
+package changefeed
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/metrics"
+	"Brands/internal/pg"
+	outboxrepo "Brands/internal/repository/outbox"
+	"context"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+// Hub опрашивает outbox одним запросом на всех подписчиков и раздает новые
+// события по их очередям. Подписчик, чья очередь переполнена, отключается:
+// медленный клиент не задерживает остальных и не копит память на сервере,
+// а после переподключения с Last-Event-ID дочитывает пропущенное из базы.
+type Hub struct {
+	repo *outboxrepo.OutboxRepository
+	cfg  Config
+	log  zerolog.Logger
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+	wake   chan struct{}
+}
+
+// NewHub создает раздатчик ленты изменений
+func NewHub(cfg Config, repo *outboxrepo.OutboxRepository, logger zerolog.Logger) *Hub {
+	return &Hub{
+		repo: repo,
+		cfg:  cfg.withDefaults(),
+		log:  logger,
+		subs: make(map[*Subscription]struct{}),
+		wake: make(chan struct{}, 1),
+	}
+}
+
+// Wake запускает внеочередной опрос, например по уведомлению LISTEN/NOTIFY.
+// Не блокируется: несколько вызовов до опроса схлопываются в один.
+func (h *Hub) Wake() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Config возвращает настройки ленты с примененными значениями по умолчанию
+func (h *Hub) Config() Config {
+	return h.cfg
+}
+
+// Run раздает новые события до отмены ctx, после чего закрывает все подписки
+func (h *Hub) Run(ctx context.Context) {
+	defer h.close()
+
+	ctx = pg.WithoutTracing(ctx)
+	var cursor int64
+	for {
+		var err error
+		if cursor, err = h.repo.LastSequence(ctx); err == nil {
+			break
+		}
+		h.log.Error().Err(err).Msg("Failed to read change feed position")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.cfg.PollInterval):
+		}
+	}
+
+	ticker := time.NewTicker(h.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-h.wake:
+		}
+		for {
+			events, err := h.repo.GetSince(ctx, cursor, defaultPageSize)
+			if err != nil {
+				h.log.Error().Err(err).Int64("cursor", cursor).Msg("Failed to poll change feed")
+				break
+			}
+			for _, event := range events {
+				h.broadcast(event)
+				cursor = event.Sequence
+			}
+			if len(events) < defaultPageSize {
+				break
+			}
+		}
+	}
+}
+
+// Subscribe регистрирует подписчика. События, появившиеся после вызова,
+// попадают в его очередь; более ранние читаются через Replay.
+func (h *Hub) Subscribe(filter Filter) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.cfg.Enabled {
+		return nil, ErrDisabled
+	}
+	if h.closed {
+		return nil, ErrClosed
+	}
+	if len(h.subs) >= h.cfg.MaxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &Subscription{
+		filter: filter,
+		events: make(chan dto.OutboxEvent, h.cfg.BufferSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	h.subs[sub] = struct{}{}
+	metrics.ChangeFeedSubscribers.Set(float64(len(h.subs)))
+	return sub, nil
+}
+
+// Unsubscribe снимает подписку; повторный вызов безопасен
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub, nil)
+}
+
+// Subscribers возвращает число активных подписчиков
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs)
+}
+
+// Replay передает fn события с sequence больше after, уже сохраненные в
+// базе, и возвращает номер последнего переданного
+func (h *Hub) Replay(
+	ctx context.Context,
+	after int64,
+	filter Filter,
+	fn func(dto.OutboxEvent) error,
+) (int64, error) {
+	for {
+		events, err := h.repo.GetSince(ctx, after, defaultPageSize)
+		if err != nil {
+			return after, err
+		}
+		for _, event := range events {
+			if filter.Match(event) {
+				if err = fn(event); err != nil {
+					return after, err
+				}
+			}
+			after = event.Sequence
+		}
+		if len(events) < defaultPageSize {
+			return after, nil
+		}
+	}
+}
+
+func (h *Hub) broadcast(event dto.OutboxEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			h.log.Warn().
+				Int64("sequence", event.Sequence).
+				Int("buffer_size", h.cfg.BufferSize).
+				Msg("Change feed subscriber is lagging, disconnecting")
+			metrics.ChangeFeedLagging.Inc()
+			h.remove(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+func (h *Hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subs {
+		h.remove(sub, ErrClosed)
+	}
+}
+
+// remove вызывается под h.mu
+func (h *Hub) remove(sub *Subscription, reason error) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	metrics.ChangeFeedSubscribers.Set(float64(len(h.subs)))
+	sub.err = reason
+	close(sub.events)
+	sub.cancel()
+}
+
+// Subscription очередь событий одного соединения
+type Subscription struct {
+	filter Filter
+	events chan dto.OutboxEvent
+	ctx    context.Context
+	cancel context.CancelFunc
+	// err записывается до закрытия events и читается после
+	err error
+}
+
+// Events возвращает очередь событий; канал закрывается при отключении
+func (s *Subscription) Events() <-chan dto.OutboxEvent {
+	return s.events
+}
+
+// Context отменяется при отключении подписчика
+func (s *Subscription) Context() context.Context {
+	return s.ctx
+}
+
+// Err возвращает причину отключения после закрытия Events; nil — подписка снята самим клиентом
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Filter возвращает фильтр подписчика
+func (s *Subscription) Filter() Filter {
+	return s.filter
+}
@@ -1,18 +1,40 @@
 package config
 
-import logger "Brands/pkg/zerohook"
+import (
+	"Brands/internal/auth"
+	"Brands/internal/changefeed"
+	"Brands/internal/dedup"
+	"Brands/internal/i18n"
+	"Brands/internal/idempotency"
+	"Brands/internal/outbox"
+	"Brands/internal/pg"
+	"Brands/internal/rbac"
+	"Brands/internal/retention"
+	"Brands/internal/webhook"
+	"Brands/pkg/redact"
+	"Brands/pkg/tracer"
+	logger "Brands/pkg/zerohook"
+)
 
 type Config struct {
 	Log      logger.LoggerConfig `yaml:"log"`
 	Postgres struct {
-		Conn string `yaml:"conn"`
+		Conn       string            `yaml:"conn"`
+		RedactArgs bool              `yaml:"redact_args"`
+		Listen     pg.ListenerConfig `yaml:"listen"`
 	} `yaml:"postgres"`
-	Jaeger struct {
-		AgentHost   string `yaml:"agent_host"`
-		AgentPort   int    `yaml:"agent_port"`
-		ServiceName string `yaml:"service_name"`
-	} `yaml:"jaeger"`
-	Prometheus struct {
+	Tracing     tracer.Config      `yaml:"tracing"`
+	Redaction   redact.Config      `yaml:"redaction"`
+	Auth        auth.Config        `yaml:"auth"`
+	RBAC        rbac.Config        `yaml:"rbac"`
+	Outbox      outbox.Config      `yaml:"outbox"`
+	Webhooks    webhook.Config     `yaml:"webhooks"`
+	Changes     changefeed.Config  `yaml:"changes"`
+	Idempotency idempotency.Config `yaml:"idempotency"`
+	Retention   retention.Config   `yaml:"retention"`
+	Duplicates  dedup.Config       `yaml:"duplicates"`
+	I18n        i18n.Config        `yaml:"i18n"`
+	Prometheus  struct {
 		Port           int    `yaml:"port"`
 		MetricsPath    string `yaml:"metrics_path"`
 		ScrapeInterval string `yaml:"scrape_interval"`
@@ -20,7 +42,8 @@ type Config struct {
 }
 
 var (
-	CorsAllowHeaders = "Access-Control-Allow-Origin, Access-Control-Allow-Methods, Access-Control-Max-Age, Access-Control-Allow-Credentials, Content-Type, Authorization, Origin, X-Requested-With , Accept"
-	CorsAllowMethods = "HEAD, GET, POST, PUT, DELETE, OPTIONS"
-	CorsAllowOrigin  = "*"
+	CorsAllowHeaders  = "Access-Control-Allow-Origin, Access-Control-Allow-Methods, Access-Control-Max-Age, Access-Control-Allow-Credentials, Content-Type, Authorization, X-API-Key, Idempotency-Key, Last-Event-ID, Origin, X-Requested-With , Accept, Accept-Language, X-Request-ID, traceparent, tracestate, uber-trace-id, b3"
+	CorsAllowMethods  = "HEAD, GET, POST, PUT, DELETE, OPTIONS"
+	CorsAllowOrigin   = "*"
+	CorsExposeHeaders = "X-Request-ID, X-Trace-ID, Idempotent-Replayed, Retry-After"
 )
@@ -0,0 +1,30 @@
+package dedup
+
+import "time"
+
+const (
+	defaultInterval       = 6 * time.Hour
+	defaultMinScore       = 0.6
+	defaultNameSimilarity = 0.5
+)
+
+// Config настройки поиска возможных дубликатов брендов и моделей
+type Config struct {
+	Enabled        bool          `yaml:"enabled"`         // Периодически искать дубликаты
+	Interval       time.Duration `yaml:"interval"`        // Период запуска поиска
+	MinScore       float64       `yaml:"min_score"`       // Минимальная оценка пары для попадания в отчет
+	NameSimilarity float64       `yaml:"name_similarity"` // Минимальное триграммное сходство названий, считающееся признаком
+}
+
+func (c Config) withDefaults() Config {
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.MinScore <= 0 {
+		c.MinScore = defaultMinScore
+	}
+	if c.NameSimilarity <= 0 {
+		c.NameSimilarity = defaultNameSimilarity
+	}
+	return c
+}
@@ -0,0 +1,257 @@
+package dedup
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"bytes"
+	"github.com/google/uuid"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Веса признаков. Оценка пары объединяет их как независимые свидетельства:
+// score = 1 - Π(1 - w), поэтому слабые признаки лишь усиливают сильные.
+const (
+	weightSameName      = 0.9
+	weightSimilarName   = 0.8 // Умножается на сходство названий
+	weightNameContained = 0.6
+	weightSameLink      = 0.8
+	weightSameLogo      = 0.8
+	weightSameOrigin    = 0.3
+	weightSameRelease   = 0.3
+)
+
+// legalSuffixes слова организационно-правовой формы, не отличающие бренды
+var legalSuffixes = map[string]struct{}{
+	"inc": {}, "ltd": {}, "llc": {}, "gmbh": {}, "ag": {}, "co": {}, "corp": {},
+	"corporation": {}, "company": {}, "group": {}, "sa": {}, "spa": {}, "srl": {},
+	"bv": {}, "nv": {}, "plc": {}, "oy": {}, "ab": {}, "kk": {}, "ooo": {}, "zao": {}, "oao": {},
+}
+
+// record признаки записи, участвующие в сравнении
+type record struct {
+	id       uuid.UUID
+	name     string   // Нормализованное название
+	words    []string // Слова нормализованного названия
+	trigrams map[string]struct{}
+	link     string
+	logo     string
+	origin   string // Год основания и страна; пусто, если что-то неизвестно
+	release  string // Дата релиза модели; пусто, если неизвестна
+}
+
+// nameKey нормализует название: транслитерация и удаление диакритики как у
+// слага, слова через пробел, без организационно-правовой формы
+func nameKey(name string) []string {
+	words := strings.Split(slug.Make(name), "-")
+	kept := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := legalSuffixes[word]; !ok && word != "" {
+			kept = append(kept, word)
+		}
+	}
+	if len(kept) == 0 {
+		// Название целиком из служебных слов сравнивается как есть
+		return words
+	}
+	return kept
+}
+
+// trigrams строит множество триграмм слов так же, как pg_trgm: каждое слово
+// дополняется двумя пробелами в начале и одним в конце
+func trigrams(words []string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+// normalizeLink приводит ссылку к виду без схемы, www и завершающего слеша
+func normalizeLink(link string) string {
+	link = strings.ToLower(strings.TrimSpace(link))
+	link = strings.TrimPrefix(link, "https://")
+	link = strings.TrimPrefix(link, "http://")
+	link = strings.TrimPrefix(link, "www.")
+	return strings.TrimSuffix(link, "/")
+}
+
+func newRecord(id uuid.UUID, name string) record {
+	words := nameKey(name)
+	return record{
+		id:       id,
+		name:     strings.Join(words, " "),
+		words:    words,
+		trigrams: trigrams(words),
+	}
+}
+
+// DetectBrands находит пары брендов, похожих на дубликаты
+func DetectBrands(brands []dto.Brand, cfg Config) []dto.DuplicatePair {
+	cfg = cfg.withDefaults()
+	records := make([]record, 0, len(brands))
+	for _, brand := range brands {
+		rec := newRecord(brand.ID, brand.Name)
+		rec.link = normalizeLink(brand.Link)
+		rec.logo = strings.TrimSpace(brand.LogoURL)
+		if brand.FoundedYear != 0 && strings.TrimSpace(brand.OriginCountry) != "" {
+			rec.origin = strings.ToLower(strings.TrimSpace(brand.OriginCountry)) + "|" + strconv.Itoa(brand.FoundedYear)
+		}
+		records = append(records, rec)
+	}
+	return detect(records, cfg)
+}
+
+// DetectModels находит пары моделей одного бренда, похожих на дубликаты
+func DetectModels(models []dto.Model, cfg Config) []dto.DuplicatePair {
+	cfg = cfg.withDefaults()
+	byBrand := make(map[uuid.UUID][]record)
+	for _, model := range models {
+		rec := newRecord(model.ID, model.Name)
+		if !model.ReleaseDate.IsZero() {
+			rec.release = model.ReleaseDate.Format("2006-01-02")
+		}
+		byBrand[model.BrandID] = append(byBrand[model.BrandID], rec)
+	}
+	var pairs []dto.DuplicatePair
+	for _, records := range byBrand {
+		pairs = append(pairs, detect(records, cfg)...)
+	}
+	return pairs
+}
+
+// detect сравнивает записи, у которых есть хотя бы одна общая триграмма
+// названия или совпадающие link или logo_url. Общие триграммы считаются по
+// инвертированному индексу, поэтому пары без общих признаков не сравниваются.
+func detect(records []record, cfg Config) []dto.DuplicatePair {
+	byTrigram := make(map[string][]int)
+	byLink := make(map[string][]int)
+	byLogo := make(map[string][]int)
+	for i, rec := range records {
+		for trigram := range rec.trigrams {
+			byTrigram[trigram] = append(byTrigram[trigram], i)
+		}
+		if rec.link != "" {
+			byLink[rec.link] = append(byLink[rec.link], i)
+		}
+		if rec.logo != "" {
+			byLogo[rec.logo] = append(byLogo[rec.logo], i)
+		}
+	}
+
+	var pairs []dto.DuplicatePair
+	for i := range records {
+		shared := make(map[int]int)
+		for trigram := range records[i].trigrams {
+			for _, j := range byTrigram[trigram] {
+				if j > i {
+					shared[j]++
+				}
+			}
+		}
+		// Пары с совпадающими link или logo_url сравниваются, даже если
+		// у названий нет общих триграмм
+		for _, same := range [][]int{byLink[records[i].link], byLogo[records[i].logo]} {
+			for _, j := range same {
+				if _, ok := shared[j]; !ok && j > i {
+					shared[j] = 0
+				}
+			}
+		}
+		for j, common := range shared {
+			if pair, ok := compare(records[i], records[j], common, cfg); ok {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	return pairs
+}
+
+// compare оценивает пару записей с common общими триграммами названий
+func compare(a, b record, common int, cfg Config) (dto.DuplicatePair, bool) {
+	var reasons []string
+	miss := 1.0
+	add := func(reason string, weight float64) {
+		reasons = append(reasons, reason)
+		miss *= 1 - weight
+	}
+
+	similarity := 0.0
+	if union := len(a.trigrams) + len(b.trigrams) - common; union > 0 {
+		similarity = float64(common) / float64(union)
+	}
+	switch {
+	case a.name != "" && a.name == b.name:
+		add(dto.ReasonSameName, weightSameName)
+	case similarity >= cfg.NameSimilarity:
+		add(dto.ReasonSimilarName, weightSimilarName*similarity)
+	}
+	if a.name != b.name && (containsWords(a.words, b.words) || containsWords(b.words, a.words)) {
+		add(dto.ReasonNameContained, weightNameContained)
+	}
+	if a.link != "" && a.link == b.link {
+		add(dto.ReasonSameLink, weightSameLink)
+	}
+	if a.logo != "" && a.logo == b.logo {
+		add(dto.ReasonSameLogo, weightSameLogo)
+	}
+	if len(reasons) == 0 {
+		return dto.DuplicatePair{}, false
+	}
+	// Год и страна или дата релиза совпадают у многих записей, поэтому лишь
+	// усиливают пару, найденную по другим признакам
+	if a.origin != "" && a.origin == b.origin {
+		add(dto.ReasonSameOrigin, weightSameOrigin)
+	}
+	if a.release != "" && a.release == b.release {
+		add(dto.ReasonSameRelease, weightSameRelease)
+	}
+
+	score := round(1 - miss)
+	if score < cfg.MinScore {
+		return dto.DuplicatePair{}, false
+	}
+	left, right := a.id, b.id
+	if bytes.Compare(left[:], right[:]) > 0 {
+		left, right = right, left
+	}
+	return dto.DuplicatePair{
+		LeftID:         left,
+		RightID:        right,
+		Score:          score,
+		NameSimilarity: round(similarity),
+		Reasons:        reasons,
+	}, true
+}
+
+// containsWords сообщает, входят ли все слова short в long подряд. Короткие
+// однословные названия не учитываются: "ac" содержится слишком во многом.
+func containsWords(long, short []string) bool {
+	if len(short) == 0 || len(short) >= len(long) {
+		return false
+	}
+	if len(short) == 1 && len([]rune(short[0])) < 3 {
+		return false
+	}
+	for i := 0; i+len(short) <= len(long); i++ {
+		matched := true
+		for k, word := range short {
+			if long[i+k] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,103 @@
+package dedup
+
+import (
+	"Brands/internal/metrics"
+	"Brands/internal/repository/audit"
+	brandrepo "Brands/internal/repository/brand"
+	"Brands/internal/repository/duplicate"
+	modelrepo "Brands/internal/repository/model"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// Job периодически ищет возможные дубликаты среди неудаленных брендов и
+// моделей и сохраняет найденные пары для отчета
+type Job struct {
+	cfg        Config
+	brands     *brandrepo.BrandRepository
+	models     *modelrepo.ModelRepository
+	duplicates *duplicate.DuplicateRepository
+	log        zerolog.Logger
+}
+
+// New создает задачу поиска дубликатов
+func New(
+	cfg Config,
+	brands *brandrepo.BrandRepository,
+	models *modelrepo.ModelRepository,
+	duplicates *duplicate.DuplicateRepository,
+	logger zerolog.Logger,
+) *Job {
+	return &Job{
+		cfg:        cfg.withDefaults(),
+		brands:     brands,
+		models:     models,
+		duplicates: duplicates,
+		log:        logger,
+	}
+}
+
+// Run ищет дубликаты при запуске и далее с периодом Interval до отмены ctx
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		j.detect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Job) detect(ctx context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateJob.Detect")
+	defer span.Finish()
+
+	brands, err := j.brands.GetAll(ctx)
+	if err != nil {
+		j.fail(span, err)
+		return
+	}
+	brandPairs := DetectBrands(brands, j.cfg)
+	if err = j.duplicates.Replace(ctx, audit.EntityBrand, brandPairs); err != nil {
+		j.fail(span, err)
+		return
+	}
+
+	models, err := j.models.GetAll(ctx)
+	if err != nil {
+		j.fail(span, err)
+		return
+	}
+	modelPairs := DetectModels(models, j.cfg)
+	if err = j.duplicates.Replace(ctx, audit.EntityModel, modelPairs); err != nil {
+		j.fail(span, err)
+		return
+	}
+
+	metrics.DuplicateCandidates.WithLabelValues(audit.EntityBrand).Set(float64(len(brandPairs)))
+	metrics.DuplicateCandidates.WithLabelValues(audit.EntityModel).Set(float64(len(modelPairs)))
+	span.SetTag("brands.pairs", len(brandPairs))
+	span.SetTag("models.pairs", len(modelPairs))
+	j.log.Info().Ctx(ctx).
+		Int("brands", len(brands)).
+		Int("brand_pairs", len(brandPairs)).
+		Int("models", len(models)).
+		Int("model_pairs", len(modelPairs)).
+		Msg("Duplicate detection finished")
+}
+
+func (j *Job) fail(span opentracing.Span, err error) {
+	span.SetTag("error", true)
+	span.LogFields(
+		log.String("event", "detect_error"),
+		log.Error(err),
+	)
+	j.log.Error().Err(err).Msg("Failed to detect duplicates, will retry")
+}
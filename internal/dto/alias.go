@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// BrandAlias альтернативное название бренда: сокращение, написание на другом
+// языке или прежнее название
+type BrandAlias struct {
+	ID        uuid.UUID `json:"id"`
+	BrandID   uuid.UUID `json:"brand_id"`   // Бренд, к которому относится название
+	Alias     string    `json:"alias"`      // Название в исходном написании
+	Slug      string    `json:"slug"`       // Слаг названия, по нему бренд находится как по своему слагу
+	CreatedAt time.Time `json:"created_at"` // Время добавления
+}
+
+// BrandAliasRequest данные добавляемого или изменяемого названия
+type BrandAliasRequest struct {
+	Alias string `json:"alias"` // Альтернативное название бренда
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// APIKey представляет API-ключ сервисного клиента; сам секрет не хранится
+type APIKey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`         // Название клиента
+	Prefix     string     `json:"prefix"`       // Открытая часть ключа для поиска
+	KeyHash    []byte     `json:"-"`            // SHA-256 от полного ключа
+	Scopes     []string   `json:"scopes"`       // Выданные scope
+	Roles      []string   `json:"roles"`        // Роли RBAC
+	ExpiresAt  *time.Time `json:"expires_at"`   // Время истечения, nil — бессрочный
+	LastUsedAt *time.Time `json:"last_used_at"` // Время последнего использования
+	RevokedAt  *time.Time `json:"revoked_at"`   // Время отзыва
+	CreatedBy  string     `json:"created_by"`   // Кто выпустил ключ
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// APIKeyRequest параметры выпуска API-ключа
+type APIKeyRequest struct {
+	Name      string     `json:"name"`       // Название клиента
+	Scopes    []string   `json:"scopes"`     // Запрашиваемые scope
+	Roles     []string   `json:"roles"`      // Роли RBAC
+	ExpiresAt *time.Time `json:"expires_at"` // Время истечения
+}
+
+// IssuedAPIKey выпущенный ключ; секрет показывается только один раз
+type IssuedAPIKey struct {
+	APIKey
+	Secret string `json:"secret"` // Полное значение ключа для заголовка X-API-Key
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// AuditEvent запись журнала изменений каталога
+type AuditEvent struct {
+	ID         uuid.UUID       `json:"id"`
+	OccurredAt time.Time       `json:"occurred_at"`                 // Время изменения
+	Actor      string          `json:"actor"`                       // Кто выполнил изменение (actor_guid)
+	RequestID  string          `json:"request_id"`                  // ID запроса
+	Operation  string          `json:"operation"`                   // create, update, delete, restore
+	EntityType string          `json:"entity_type"`                 // brand или model
+	EntityID   uuid.UUID       `json:"entity_id"`                   // ID измененной записи
+	Before     json.RawMessage `json:"before" swaggertype:"object"` // Состояние до изменения
+	After      json.RawMessage `json:"after" swaggertype:"object"`  // Состояние после изменения
+	Diff       json.RawMessage `json:"diff" swaggertype:"object"`   // Измененные поля: {"поле": {"before": ..., "after": ...}}
+}
+
+// AuditFilter параметры выборки журнала изменений
+type AuditFilter struct {
+	EntityID *uuid.UUID // Фильтр по ID записи
+	Actor    string     // Фильтр по автору изменения
+	From     *time.Time // Начало периода (включительно)
+	To       *time.Time // Конец периода (не включительно)
+	Limit    int        // Размер страницы
+	Offset   int        // Смещение
+}
+
+// AuditPage страница журнала изменений
+type AuditPage struct {
+	Events []AuditEvent `json:"events"` // События, от новых к старым
+	Total  int64        `json:"total"`  // Общее число событий по фильтру
+	Limit  int          `json:"limit"`  // Размер страницы
+	Offset int          `json:"offset"` // Смещение
+}
+
+// Revision сохраненная версия бренда или модели
+type Revision struct {
+	EntityID  uuid.UUID       `json:"entity_id"`                 // ID бренда или модели
+	Revision  int             `json:"revision"`                  // Номер версии, начиная с 1
+	Operation string          `json:"operation"`                 // Операция, создавшая версию
+	Actor     string          `json:"actor"`                     // Автор изменения
+	RequestID string          `json:"request_id"`                // ID запроса
+	ValidFrom time.Time       `json:"valid_from"`                // Время, с которого действует версия
+	Data      json.RawMessage `json:"data" swaggertype:"object"` // Состояние записи
+}
@@ -7,18 +7,26 @@ import (
 
 // Brand представляет сущность бренда
 type Brand struct {
-	ID            uuid.UUID `json:"id"`
-	Name          string    `json:"name"`            // Название бренда
-	Link          string    `json:"link"`            // Бренд на английском
-	Description   string    `json:"description"`     // Описание/история бренда
-	LogoURL       string    `json:"logo_url"`        // URL логотипа
-	CoverImageURL string    `json:"cover_image_url"` // URL обложки
-	FoundedYear   int       `json:"founded_year"`    // Год основания
-	OriginCountry string    `json:"origin_country"`  // Страна происхождения
-	Popularity    int       `json:"popularity"`      // Индекс популярности
-	IsPremium     bool      `json:"is_premium"`      // Флаг премиального бренда
-	IsUpcoming    bool      `json:"is_upcoming"`     // Флаг "Скоро"
-	IsDeleted     bool      `json:"is_deleted"`      // Флаг удаления
+	ID            uuid.UUID  `json:"id"`
+	Name          string     `json:"name"`                    // Название бренда
+	Slug          string     `json:"slug"`                    // Слаг для адресов; пустой при записи — строится из названия
+	Link          string     `json:"link"`                    // Бренд на английском
+	Description   string     `json:"description"`             // Описание/история бренда
+	LogoURL       string     `json:"logo_url"`                // URL логотипа
+	CoverImageURL string     `json:"cover_image_url"`         // URL обложки
+	FoundedYear   int        `json:"founded_year"`            // Год основания
+	OriginCountry string     `json:"origin_country"`          // Страна происхождения
+	Popularity    int        `json:"popularity"`              // Индекс популярности
+	IsPremium     bool       `json:"is_premium"`              // Флаг премиального бренда
+	IsUpcoming    bool       `json:"is_upcoming"`             // Флаг "Скоро"
+	IsDeleted     bool       `json:"is_deleted"`              // Флаг удаления
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`    // Время мягкого удаления
+	DeletedBy     *string    `json:"deleted_by,omitempty"`    // Кто удалил бренд
+	MergedInto    *uuid.UUID `json:"merged_into,omitempty"`   // Бренд, в который слит этот бренд
+	ParentID      *uuid.UUID `json:"parent_id"`               // Родительский бренд (концерн, группа); null — корневой бренд
+	Aliases       []string   `json:"aliases" db:"-"`          // Альтернативные названия; изменяются через /brands/{id}/aliases
+	Path          []BrandRef `json:"path" db:"-"`             // Цепочка родительских брендов от корня; только для чтения
+	Locale        *string    `json:"locale,omitempty" db:"-"` // Язык переводов в названии и описании; такой ответ нельзя передать в PUT
 
 	CreatedAt time.Time `json:"created_at"` // Время создания
 	UpdatedAt time.Time `json:"updated_at"` // Время обновления
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/google/uuid"
+
+// ConflictResponse ответ на запись, слаг которой уже занят другой записью
+type ConflictResponse struct {
+	Error    string         `json:"error"`    // Описание конфликта
+	Conflict ConflictRecord `json:"conflict"` // Запись, занявшая слаг
+}
+
+// ConflictRecord запись, с которой возник конфликт
+type ConflictRecord struct {
+	Entity string    `json:"entity"` // brand или model
+	ID     uuid.UUID `json:"id"`     // ID записи
+	Name   string    `json:"name"`   // Название записи
+	Slug   string    `json:"slug"`   // Занятый слаг
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Признаки, по которым пара записей считается возможным дубликатом
+const (
+	ReasonSameName      = "same_name"         // Совпадают нормализованные названия
+	ReasonSimilarName   = "similar_name"      // Названия похожи по триграммам
+	ReasonNameContained = "name_contained"    // Название одной записи содержится в названии другой
+	ReasonSameLink      = "same_link"         // Совпадает link
+	ReasonSameLogo      = "same_logo"         // Совпадает logo_url
+	ReasonSameOrigin    = "same_origin"       // Совпадают год основания и страна
+	ReasonSameRelease   = "same_release_date" // Совпадает дата релиза модели
+)
+
+// Статусы пары-кандидата
+const (
+	DuplicateOpen      = "open"
+	DuplicateDismissed = "dismissed"
+)
+
+// DuplicatePair пара записей, похожих на дубликаты. LeftID всегда меньше RightID.
+type DuplicatePair struct {
+	LeftID         uuid.UUID `json:"left_id"`
+	RightID        uuid.UUID `json:"right_id"`
+	Score          float64   `json:"score"`           // Оценка сходства 0..1
+	NameSimilarity float64   `json:"name_similarity"` // Триграммное сходство нормализованных названий
+	Reasons        []string  `json:"reasons"`         // Совпавшие признаки
+	DetectedAt     time.Time `json:"detected_at"`     // Когда пара найдена последним запуском поиска
+}
+
+// BrandDuplicateGroup группа брендов, связанных парами возможных дубликатов
+type BrandDuplicateGroup struct {
+	Score             float64         `json:"score"`               // Наибольшая оценка пары в группе
+	SuggestedTargetID uuid.UUID       `json:"suggested_target_id"` // Предлагаемая цель слияния
+	Brands            []Brand         `json:"brands"`              // Бренды группы
+	Pairs             []DuplicatePair `json:"pairs"`               // Пары, связавшие группу
+}
+
+// ModelDuplicateGroup группа моделей одного бренда, связанных парами возможных дубликатов
+type ModelDuplicateGroup struct {
+	Score             float64         `json:"score"`               // Наибольшая оценка пары в группе
+	SuggestedTargetID uuid.UUID       `json:"suggested_target_id"` // Модель, которую предлагается оставить
+	Models            []Model         `json:"models"`              // Модели группы
+	Pairs             []DuplicatePair `json:"pairs"`               // Пары, связавшие группу
+}
+
+// BrandDuplicatePage страница групп возможных дубликатов брендов
+type BrandDuplicatePage struct {
+	Groups []BrandDuplicateGroup `json:"groups"` // Группы по убыванию оценки
+	Total  int                   `json:"total"`  // Общее число групп
+	Limit  int                   `json:"limit"`  // Размер страницы
+	Offset int                   `json:"offset"` // Смещение
+}
+
+// ModelDuplicatePage страница групп возможных дубликатов моделей
+type ModelDuplicatePage struct {
+	Groups []ModelDuplicateGroup `json:"groups"` // Группы по убыванию оценки
+	Total  int                   `json:"total"`  // Общее число групп
+	Limit  int                   `json:"limit"`  // Размер страницы
+	Offset int                   `json:"offset"` // Смещение
+}
+
+// DuplicateFilter параметры отчета о дубликатах
+type DuplicateFilter struct {
+	BrandID  *uuid.UUID // Только модели бренда (для отчета по моделям)
+	MinScore float64    // Минимальная оценка пары
+	Limit    int        // Размер страницы
+	Offset   int        // Смещение
+}
+
+// DuplicateDismissRequest отклонение возможных дубликатов: отклоняются все
+// открытые пары, обе записи которых перечислены в IDs
+type DuplicateDismissRequest struct {
+	IDs []uuid.UUID `json:"ids"` // ID записей группы или ее части
+}
+
+// DuplicateDismissResponse результат отклонения возможных дубликатов
+type DuplicateDismissResponse struct {
+	Dismissed int `json:"dismissed"` // Число отклоненных пар
+}
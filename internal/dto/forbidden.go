@@ -0,0 +1,8 @@
+package dto
+
+// ForbiddenResponse ответ на запрос, запрещенный политикой доступа
+type ForbiddenResponse struct {
+	Error           string   `json:"error"`                      // Описание отказа
+	Permission      string   `json:"permission"`                 // Требуемое разрешение
+	ForbiddenFields []string `json:"forbidden_fields,omitempty"` // Поля, которые роль не может изменять
+}
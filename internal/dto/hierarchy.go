@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/google/uuid"
+
+// BrandRef краткое описание бренда в цепочке иерархии
+type BrandRef struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"` // Название бренда
+	Slug string    `json:"slug"` // Слаг бренда
+}
+
+// BrandNode бренд иерархии с расстоянием до запрошенного бренда
+type BrandNode struct {
+	Brand
+	Depth int `json:"depth"` // 1 — дочерний или родительский бренд, 2 — следующий уровень и т.д.
+}
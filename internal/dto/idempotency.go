@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// Статусы ключа идемпотентности
+const (
+	IdempotencyProcessing = "processing" // Запрос с ключом выполняется
+	IdempotencyCompleted  = "completed"  // Ответ сохранен и повторяется
+)
+
+// IdempotencyRecord сохраненный результат запроса с заголовком Idempotency-Key
+type IdempotencyRecord struct {
+	Scope          string    // Владелец ключа: субъект токена или API-ключ
+	Key            string    // Значение Idempotency-Key
+	Fingerprint    []byte    // SHA-256 метода, пути и тела запроса
+	Status         string    // processing или completed
+	ResponseStatus *int      // HTTP-статус исходного ответа
+	ContentType    string    // Content-Type исходного ответа
+	ResponseBody   []byte    // Тело исходного ответа
+	CreatedAt      time.Time // Время первого запроса
+	UpdatedAt      time.Time // Время последнего изменения
+	ExpiresAt      time.Time // После этого времени ключ можно использовать заново
+}
@@ -0,0 +1,40 @@
+package dto
+
+import "github.com/google/uuid"
+
+// Стратегии слияния полей бренда
+const (
+	// MergeKeepTarget сохраняет непустые значения цели и заполняет пустые
+	// значениями источника; флаги цели сохраняются всегда
+	MergeKeepTarget = "keep_target"
+	// MergePreferSource заменяет значения цели непустыми значениями источника,
+	// а флаги — значениями флагов источника
+	MergePreferSource = "prefer_source"
+)
+
+// BrandMergeRequest запрос слияния бренда-дубликата с целевым брендом
+type BrandMergeRequest struct {
+	SourceID uuid.UUID         `json:"source_id"`          // Бренд-дубликат, который будет слит и удален
+	Strategy string            `json:"strategy,omitempty"` // Стратегия для всех полей: keep_target (по умолчанию) или prefer_source
+	Fields   map[string]string `json:"fields,omitempty"`   // Стратегии отдельных полей, переопределяют strategy
+	DryRun   bool              `json:"dry_run"`            // Только показать результат, ничего не изменяя
+}
+
+// BrandMergeResult результат слияния или его предпросмотра
+type BrandMergeResult struct {
+	DryRun       bool          `json:"dry_run"`       // Результат предпросмотра, изменения не сохранены
+	Target       Brand         `json:"target"`        // Целевой бренд после слияния
+	Source       Brand         `json:"source"`        // Бренд-дубликат после слияния
+	MergedFields []string      `json:"merged_fields"` // Поля цели, получившие значения источника
+	Models       []MergedModel `json:"models"`        // Модели, перенесенные в целевой бренд
+	Children     []BrandRef    `json:"children"`      // Дочерние бренды источника, перенесенные в целевой бренд
+}
+
+// MergedModel модель, перенесенная из источника в целевой бренд
+type MergedModel struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`                    // Название модели
+	Slug         string    `json:"slug"`                    // Слаг в целевом бренде
+	PreviousSlug string    `json:"previous_slug,omitempty"` // Прежний слаг, если он занят моделью цели
+	IsDeleted    bool      `json:"is_deleted"`              // Модель находится в корзине
+}
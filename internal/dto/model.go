@@ -6,13 +6,20 @@ import (
 )
 
 type Model struct {
-	ID          uuid.UUID `json:"id"`
-	BrandID     uuid.UUID `json:"brand_id"`
-	Name        string    `json:"name"`         // Название модели
-	ReleaseDate time.Time `json:"release_date"` // Дата релиза
-	IsUpcoming  bool      `json:"is_upcoming"`  // Флаг "Скоро"
-	IsLimited   bool      `json:"is_limited"`   // Флаг ограниченного выпуска
-	IsDeleted   bool      `json:"is_deleted"`   // Флаг удаления
+	ID               uuid.UUID  `json:"id"`
+	BrandID          uuid.UUID  `json:"brand_id"`
+	Name             string     `json:"name"`                    // Название модели
+	Slug             string     `json:"slug"`                    // Слаг, уникальный в пределах бренда; пустой при записи — строится из названия
+	ReleaseDate      time.Time  `json:"release_date"`            // Дата релиза
+	IsUpcoming       bool       `json:"is_upcoming"`             // Флаг "Скоро"
+	IsLimited        bool       `json:"is_limited"`              // Флаг ограниченного выпуска
+	Category         *string    `json:"category"`                // Категория со схемой характеристик; null — модель без характеристик
+	Specs            Specs      `json:"specs"`                   // Характеристики по схеме категории
+	Locale           *string    `json:"locale,omitempty" db:"-"` // Язык перевода в названии; такой ответ нельзя передать в PUT
+	IsDeleted        bool       `json:"is_deleted"`              // Флаг удаления
+	DeletedWithBrand bool       `json:"deleted_with_brand"`      // Удалена каскадно вместе с брендом
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`    // Время мягкого удаления
+	DeletedBy        *string    `json:"deleted_by,omitempty"`    // Кто удалил модель
 
 	CreatedAt time.Time `json:"created_at"` // Время создания
 	UpdatedAt time.Time `json:"updated_at"` // Время обновления
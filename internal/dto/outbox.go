@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// OutboxEvent доменное событие об изменении бренда или модели
+type OutboxEvent struct {
+	Sequence      int64           `json:"sequence"`                  // Порядковый номер события
+	ID            uuid.UUID       `json:"id"`                        // ID события для дедупликации
+	EventType     string          `json:"type"`                      // Тип события, например brand.created
+	AggregateType string          `json:"aggregate_type"`            // brand или model
+	AggregateID   uuid.UUID       `json:"aggregate_id"`              // ID измененной записи
+	Actor         string          `json:"actor"`                     // Автор изменения
+	RequestID     string          `json:"request_id"`                // ID запроса
+	Payload       json.RawMessage `json:"data" swaggertype:"object"` // Состояние записи после изменения
+	OccurredAt    time.Time       `json:"occurred_at"`               // Время изменения
+	PublishedAt   *time.Time      `json:"-"`                         // Время публикации релеем
+	Attempts      int             `json:"-"`                         // Число неудачных попыток публикации
+	LastError     string          `json:"-"`                         // Последняя ошибка публикации
+	ClaimedUntil  *time.Time      `json:"-"`                         // Окончание аренды события релеем
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Specs характеристики модели: объект, свойства которого описывает схема
+// категории модели
+type Specs map[string]any
+
+// SpecSchema схема характеристик моделей категории
+type SpecSchema struct {
+	ID       uuid.UUID       `json:"id"`
+	Category string          `json:"category"`                    // Слаг категории: cars, sneakers, phones
+	Schema   json.RawMessage `json:"schema" swaggertype:"object"` // JSON Schema характеристик
+	Version  int             `json:"version"`                     // Растет при каждой замене схемы
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// SpecSchemaRequest новая схема характеристик категории
+type SpecSchemaRequest struct {
+	Schema json.RawMessage `json:"schema" swaggertype:"object"` // JSON Schema с корнем "type": "object"
+}
+
+// SpecViolation нарушение схемы значением характеристики
+type SpecViolation struct {
+	Path    string `json:"path"`    // Путь к значению: specs.engine_volume
+	Message string `json:"message"` // Какое правило схемы нарушено
+}
+
+// SpecsErrorResponse ответ на характеристики, не прошедшие проверку схемой категории
+type SpecsErrorResponse struct {
+	Error      string          `json:"error"`
+	Violations []SpecViolation `json:"violations"`
+}
+
+// IncompatibleModel модель, характеристики которой не проходят новую схему
+type IncompatibleModel struct {
+	ModelID    uuid.UUID       `json:"model_id"`
+	Violations []SpecViolation `json:"violations"`
+}
+
+// SpecSchemaConflictResponse ответ на замену схемы, которой не соответствуют
+// характеристики моделей категории
+type SpecSchemaConflictResponse struct {
+	Error  string              `json:"error"`
+	Total  int                 `json:"total"`  // Сколько моделей не проходят схему
+	Models []IncompatibleModel `json:"models"` // Первые из них по ID
+}
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// BrandTranslation перевод полей бренда на язык Locale; поле без значения не
+// переведено, и вместо него показывается значение следующего языка цепочки
+type BrandTranslation struct {
+	BrandID     uuid.UUID `json:"brand_id"`
+	Locale      string    `json:"locale"`      // Код языка
+	Name        *string   `json:"name"`        // Название бренда
+	Description *string   `json:"description"` // Описание/история бренда
+	UpdatedAt   time.Time `json:"updated_at"`  // Время изменения перевода
+}
+
+// ModelTranslation перевод названия модели на язык Locale
+type ModelTranslation struct {
+	ModelID   uuid.UUID `json:"model_id"`
+	BrandID   uuid.UUID `json:"brand_id" db:"-"` // Бренд модели
+	Locale    string    `json:"locale"`          // Код языка
+	Name      string    `json:"name"`            // Название модели
+	UpdatedAt time.Time `json:"updated_at"`      // Время изменения перевода
+}
+
+// BrandTranslationRequest перевод бренда целиком: непереданное поле
+// становится непереведенным
+type BrandTranslationRequest struct {
+	Name        *string `json:"name"`        // Название бренда
+	Description *string `json:"description"` // Описание/история бренда
+}
+
+// ModelTranslationRequest перевод названия модели
+type ModelTranslationRequest struct {
+	Name string `json:"name"` // Название модели
+}
+
+// TranslationCoverage полнота переводов записей одного типа на язык
+type TranslationCoverage struct {
+	Total   int64            `json:"total"`   // Неудаленных записей
+	Missing map[string]int64 `json:"missing"` // Записей без перевода по полям
+	Percent float64          `json:"percent"` // Доля переведенных полей, от 0 до 100
+}
+
+// LocaleCompleteness полнота переводов на язык
+type LocaleCompleteness struct {
+	Locale string              `json:"locale"` // Код языка
+	Brands TranslationCoverage `json:"brands"` // Переводы брендов
+	Models TranslationCoverage `json:"models"` // Переводы моделей
+}
+
+// MissingTranslationFilter параметры выборки записей без переводов
+type MissingTranslationFilter struct {
+	Locale string // Язык перевода
+	Entity string // brand, model или пусто — обе сущности
+	Limit  int    // Размер страницы
+	Offset int    // Смещение
+}
+
+// MissingTranslation запись, у которой не переведены поля
+type MissingTranslation struct {
+	Entity  string     `json:"entity"`             // brand или model
+	ID      uuid.UUID  `json:"id"`                 // ID записи
+	BrandID *uuid.UUID `json:"brand_id,omitempty"` // Бренд модели
+	Name    string     `json:"name"`               // Название на основном языке
+	Fields  []string   `json:"fields"`             // Непереведенные поля
+}
+
+// MissingTranslationPage страница записей без переводов
+type MissingTranslationPage struct {
+	Locale string               `json:"locale"` // Язык перевода
+	Items  []MissingTranslation `json:"items"`  // Записи по сущности и названию
+	Total  int64                `json:"total"`  // Общее число записей без переводов
+	Limit  int                  `json:"limit"`  // Размер страницы
+	Offset int                  `json:"offset"` // Смещение
+}
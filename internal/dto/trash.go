@@ -0,0 +1,26 @@
+package dto
+
+import "github.com/google/uuid"
+
+// TrashFilter параметры выборки корзины
+type TrashFilter struct {
+	BrandID *uuid.UUID // Только модели бренда (для корзины моделей)
+	Limit   int        // Размер страницы
+	Offset  int        // Смещение
+}
+
+// BrandTrashPage страница мягко удаленных брендов
+type BrandTrashPage struct {
+	Brands []Brand `json:"brands"` // Бренды, от недавно удаленных к давним
+	Total  int64   `json:"total"`  // Общее число удаленных брендов
+	Limit  int     `json:"limit"`  // Размер страницы
+	Offset int     `json:"offset"` // Смещение
+}
+
+// ModelTrashPage страница мягко удаленных моделей
+type ModelTrashPage struct {
+	Models []Model `json:"models"` // Модели, от недавно удаленных к давним
+	Total  int64   `json:"total"`  // Общее число удаленных моделей по фильтру
+	Limit  int     `json:"limit"`  // Размер страницы
+	Offset int     `json:"offset"` // Смещение
+}
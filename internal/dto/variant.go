@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ModelGeneration поколение модели с годами выпуска
+type ModelGeneration struct {
+	ID        uuid.UUID  `json:"id"`
+	ModelID   uuid.UUID  `json:"model_id"`
+	BrandID   uuid.UUID  `json:"brand_id" db:"-"`      // Бренд модели
+	Name      string     `json:"name"`                 // Название поколения (Mk8)
+	StartYear int        `json:"start_year"`           // Первый год выпуска
+	EndYear   *int       `json:"end_year"`             // Последний год выпуска; null — выпускается
+	IsDeleted bool       `json:"is_deleted"`           // Флаг удаления
+	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Время мягкого удаления
+	DeletedBy *string    `json:"deleted_by,omitempty"` // Кто удалил поколение
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// ModelTrim комплектация поколения модели
+type ModelTrim struct {
+	ID                    uuid.UUID  `json:"id"`
+	GenerationID          uuid.UUID  `json:"generation_id"`
+	BrandID               uuid.UUID  `json:"brand_id" db:"-"`         // Бренд модели
+	Name                  string     `json:"name"`                    // Название комплектации
+	IsUpcoming            bool       `json:"is_upcoming"`             // Флаг "Скоро"
+	IsLimited             bool       `json:"is_limited"`              // Флаг ограниченного выпуска
+	IsDeleted             bool       `json:"is_deleted"`              // Флаг удаления
+	DeletedWithGeneration bool       `json:"deleted_with_generation"` // Удалена каскадно вместе с поколением
+	DeletedAt             *time.Time `json:"deleted_at,omitempty"`    // Время мягкого удаления
+	DeletedBy             *string    `json:"deleted_by,omitempty"`    // Кто удалил комплектацию
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// ModelVariant поколение модели вместе с комплектациями
+type ModelVariant struct {
+	ModelGeneration
+	Trims []ModelTrim `json:"trims"` // Комплектации поколения по названию
+}
+
+// ModelGenerationRequest данные создаваемого или изменяемого поколения
+type ModelGenerationRequest struct {
+	Name      string `json:"name"`       // Название поколения
+	StartYear int    `json:"start_year"` // Первый год выпуска
+	EndYear   *int   `json:"end_year"`   // Последний год выпуска; null — выпускается
+}
+
+// ModelTrimRequest данные создаваемой или изменяемой комплектации
+type ModelTrimRequest struct {
+	Name       string `json:"name"`        // Название комплектации
+	IsUpcoming bool   `json:"is_upcoming"` // Флаг "Скоро"
+	IsLimited  bool   `json:"is_limited"`  // Флаг ограниченного выпуска
+}
+
+// YearRange годы выпуска для фильтра моделей по поколениям; 0 — граница не задана
+type YearRange struct {
+	From int // Поколение выпускалось в этом году или позже
+	To   int // Поколение выпускалось в этом году или раньше
+}
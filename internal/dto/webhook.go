@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Статусы доставки вебхука
+const (
+	WebhookDeliveryPending   = "pending"   // Ожидает отправки или повтора
+	WebhookDeliveryDelivered = "delivered" // Получатель ответил 2xx
+	WebhookDeliveryDead      = "dead"      // Исчерпаны попытки доставки
+)
+
+// WebhookSubscription подписка партнера на изменения каталога
+type WebhookSubscription struct {
+	ID          uuid.UUID `json:"id"`
+	URL         string    `json:"url"`         // Адрес получателя
+	EventTypes  []string  `json:"event_types"` // Типы событий, * — все
+	Secret      string    `json:"-"`           // Ключ подписи HMAC-SHA256
+	Description string    `json:"description"` // Описание подписки
+	IsActive    bool      `json:"is_active"`   // Отправлять ли новые события
+	CreatedBy   string    `json:"created_by"`  // Кто создал подписку
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// WebhookSubscriptionRequest параметры создания и изменения подписки
+type WebhookSubscriptionRequest struct {
+	URL         string   `json:"url"`         // Адрес получателя, http или https
+	EventTypes  []string `json:"event_types"` // Типы событий, например brand.created; * — все
+	Secret      string   `json:"secret"`      // Ключ подписи; пусто — сгенерировать
+	Description string   `json:"description"` // Описание подписки
+	IsActive    *bool    `json:"is_active"`   // По умолчанию true
+}
+
+// CreatedWebhookSubscription созданная подписка; секрет показывается только один раз
+type CreatedWebhookSubscription struct {
+	WebhookSubscription
+	Secret string `json:"secret"` // Ключ для проверки заголовка X-Webhook-Signature
+}
+
+// WebhookDelivery попытки доставки одного события одной подписке
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	EventID        uuid.UUID       `json:"event_id"`                     // ID события outbox
+	EventType      string          `json:"event_type"`                   // Тип события
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"` // Тело запроса
+	Status         string          `json:"status"`                       // pending, delivered или dead
+	Attempts       int             `json:"attempts"`                     // Число выполненных попыток
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`              // Время следующей попытки
+	LastStatusCode *int            `json:"last_status_code"`             // HTTP-статус последней попытки
+	LastError      *string         `json:"last_error"`                   // Ошибка последней попытки
+	DeliveredAt    *time.Time      `json:"delivered_at"`                 // Время успешной доставки
+
+	CreatedAt time.Time `json:"created_at"` // Время создания
+	UpdatedAt time.Time `json:"updated_at"` // Время обновления
+}
+
+// WebhookDeliveryPage страница журнала доставок
+type WebhookDeliveryPage struct {
+	Deliveries []WebhookDelivery `json:"deliveries"` // Доставки, от новых к старым
+	Total      int64             `json:"total"`      // Общее число доставок по фильтру
+	Limit      int               `json:"limit"`      // Размер страницы
+	Offset     int               `json:"offset"`     // Смещение
+}
+
+// WebhookDeliveryFilter параметры выборки журнала доставок подписки
+type WebhookDeliveryFilter struct {
+	SubscriptionID uuid.UUID // ID подписки
+	Status         string    // Статус доставки, пусто — любой
+	Limit          int       // Размер страницы
+	Offset         int       // Смещение
+}
@@ -0,0 +1,18 @@
+package i18n
+
+// Config настройки языков содержимого брендов и моделей
+type Config struct {
+	Default   string              `yaml:"default"`   // Язык основных полей записей
+	Locales   []string            `yaml:"locales"`   // Поддерживаемые языки, включая основной
+	Fallbacks map[string][]string `yaml:"fallbacks"` // Запасные языки, которые пробуются перед основным
+}
+
+func (c Config) withDefaults() Config {
+	if c.Default == "" {
+		c.Default = "ru"
+	}
+	if len(c.Locales) == 0 {
+		c.Locales = []string{"ru", "en", "kk"}
+	}
+	return c
+}
@@ -0,0 +1,144 @@
+package i18n
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale язык, выбранный для запроса
+type Locale struct {
+	Tag   string   // Запрошенный поддерживаемый язык
+	Chain []string // Языки переводов в порядке проверки; основной язык не входит
+}
+
+type localeKey struct{}
+
+// WithLocale сохраняет язык запроса в контексте
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext возвращает язык запроса; без него переводы не подставляются
+func LocaleFromContext(ctx context.Context) (Locale, bool) {
+	locale, ok := ctx.Value(localeKey{}).(Locale)
+	return locale, ok
+}
+
+// Negotiator выбирает язык ответа по параметру lang и заголовку
+// Accept-Language и строит цепочку запасных языков
+type Negotiator struct {
+	cfg    Config
+	chains map[string][]string
+}
+
+// New создает выбор языка по конфигурации
+func New(cfg Config) *Negotiator {
+	cfg = cfg.withDefaults()
+	cfg.Default = normalize(cfg.Default)
+	locales := []string{cfg.Default}
+	for _, locale := range cfg.Locales {
+		if locale = normalize(locale); !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	cfg.Locales = locales
+
+	n := &Negotiator{cfg: cfg, chains: make(map[string][]string, len(locales))}
+	for _, locale := range locales {
+		n.chains[locale] = n.chain(locale)
+	}
+	return n
+}
+
+// Default возвращает основной язык записей
+func (n *Negotiator) Default() string {
+	return n.cfg.Default
+}
+
+// Supported сообщает, поддерживается ли язык
+func (n *Negotiator) Supported(locale string) bool {
+	_, ok := n.chains[locale]
+	return ok
+}
+
+// Translatable возвращает поддерживаемые языки, кроме основного: только для
+// них хранятся переводы
+func (n *Negotiator) Translatable() []string {
+	locales := make([]string, 0, len(n.cfg.Locales))
+	for _, locale := range n.cfg.Locales {
+		if locale != n.cfg.Default {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
+}
+
+// Negotiate выбирает язык: поддерживаемый lang, затем языки Accept-Language
+// по убыванию веса, затем основной язык. Региональные варианты сводятся к
+// языку: en-US выбирает en.
+func (n *Negotiator) Negotiate(lang, acceptLanguage string) Locale {
+	tag := n.cfg.Default
+	if locale := normalize(lang); n.Supported(locale) {
+		tag = locale
+	} else if locale, ok := n.accept(acceptLanguage); ok {
+		tag = locale
+	}
+	return Locale{Tag: tag, Chain: n.chains[tag]}
+}
+
+// chain строит языки переводов для locale: сам язык и его запасные языки до
+// основного, который всегда последний и берется из полей записи
+func (n *Negotiator) chain(locale string) []string {
+	chain := []string{}
+	for _, candidate := range append([]string{locale}, n.cfg.Fallbacks[locale]...) {
+		candidate = normalize(candidate)
+		if candidate == n.cfg.Default {
+			break
+		}
+		if slices.Contains(n.cfg.Locales, candidate) && !slices.Contains(chain, candidate) {
+			chain = append(chain, candidate)
+		}
+	}
+	return chain
+}
+
+// accept выбирает поддерживаемый язык с наибольшим весом из Accept-Language
+func (n *Negotiator) accept(header string) (string, bool) {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var candidates []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if locale := normalize(tag); q > 0 && n.Supported(locale) {
+			candidates = append(candidates, weighted{locale: locale, q: q})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	// При равных весах сохраняется порядок заголовка
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].locale, true
+}
+
+// normalize сводит языковой тег к коду языка в нижнем регистре: kk-KZ -> kk
+func normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if primary, _, found := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-"); found {
+		return primary
+	}
+	return tag
+}
@@ -0,0 +1,30 @@
+package idempotency
+
+import "time"
+
+const (
+	defaultTTL             = 24 * time.Hour
+	defaultLockTimeout     = time.Minute
+	defaultCleanupInterval = time.Hour
+)
+
+// Config настройки обработки заголовка Idempotency-Key
+type Config struct {
+	Enabled         bool          `yaml:"enabled"`          // Учитывать Idempotency-Key
+	TTL             time.Duration `yaml:"ttl"`              // Срок хранения ответа
+	LockTimeout     time.Duration `yaml:"lock_timeout"`     // Через сколько незавершенный запрос считается брошенным
+	CleanupInterval time.Duration `yaml:"cleanup_interval"` // Период удаления просроченных ключей
+}
+
+func (c Config) withDefaults() Config {
+	if c.TTL <= 0 {
+		c.TTL = defaultTTL
+	}
+	if c.LockTimeout <= 0 {
+		c.LockTimeout = defaultLockTimeout
+	}
+	if c.CleanupInterval <= 0 {
+		c.CleanupInterval = defaultCleanupInterval
+	}
+	return c
+}
@@ -0,0 +1,200 @@
+package idempotency
+
+import (
+	"Brands/internal/auth"
+	"Brands/internal/dto"
+	"Brands/internal/pg"
+	idempotencyrepo "Brands/internal/repository/idempotency"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	Header         = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength   = 255
+	anonymousScope = "anonymous"
+)
+
+// Keeper повторяет сохраненный ответ на запрос с уже использованным
+// Idempotency-Key вместо повторного выполнения. Ключ принадлежит
+// аутентифицированному субъекту: одинаковые ключи разных клиентов не
+// пересекаются.
+type Keeper struct {
+	repo *idempotencyrepo.IdempotencyRepository
+	cfg  Config
+	log  zerolog.Logger
+}
+
+// New создает обработчик ключей идемпотентности
+func New(cfg Config, repo *idempotencyrepo.IdempotencyRepository, logger zerolog.Logger) *Keeper {
+	return &Keeper{
+		repo: repo,
+		cfg:  cfg.withDefaults(),
+		log:  logger,
+	}
+}
+
+// Wrap добавляет обработку Idempotency-Key к обработчику. Запрос без
+// заголовка выполняется как обычно. Оборачивать нужно внутри auth.Require,
+// чтобы ключ был привязан к субъекту.
+//
+//   - первый запрос с ключом выполняется, ответ (кроме 5xx) сохраняется на TTL;
+//   - повтор с тем же телом получает сохраненный ответ и заголовок Idempotent-Replayed;
+//   - повтор с другим методом, путем или телом получает 409;
+//   - повтор, пока первый запрос еще выполняется, получает 409 и Retry-After.
+func (k *Keeper) Wrap(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	if k == nil || !k.cfg.Enabled {
+		return next
+	}
+	return func(ctx *fasthttp.RequestCtx) {
+		key := ctx.Request.Header.Peek(Header)
+		if len(key) == 0 {
+			next(ctx)
+			return
+		}
+
+		var spanCtx context.Context
+		spanCtx, ok := ctx.UserValue("traceContext").(context.Context)
+		if !ok {
+			spanCtx = ctx
+		}
+		span, spanCtx := opentracing.StartSpanFromContext(spanCtx, "Idempotency.Wrap")
+
+		if !validKey(key) {
+			span.SetTag("error", true)
+			span.Finish()
+			ctx.Response.SetStatusCode(http.StatusBadRequest)
+			ctx.Response.SetBodyString(fmt.Sprintf("Invalid %s: expected 1-%d printable ASCII characters", Header, maxKeyLength))
+			return
+		}
+
+		scope := scopeOf(ctx)
+		fingerprint := fingerprintOf(ctx)
+		span.SetTag("idempotency.key", string(key))
+		acquired, existing, err := k.repo.Acquire(spanCtx, scope, string(key), fingerprint, k.cfg.TTL, k.cfg.LockTimeout)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "acquire_idempotency_key_error"),
+				log.Error(err),
+			)
+			span.Finish()
+			ctx.Response.SetStatusCode(http.StatusInternalServerError)
+			ctx.Response.SetBodyString(fmt.Sprintf("Failed to check %s: %v", Header, err))
+			return
+		}
+		if !acquired {
+			span.SetTag("idempotency.replayed", true)
+			span.Finish()
+			k.replay(ctx, existing, fingerprint)
+			return
+		}
+		span.Finish()
+
+		// Ключ освобождается, если обработчик запаниковал или ответ не сохраняется
+		completed := false
+		defer func() {
+			if !completed {
+				if err := k.repo.Release(context.WithoutCancel(spanCtx), scope, string(key), fingerprint); err != nil {
+					k.log.Error().Ctx(spanCtx).Err(err).Msg("Failed to release idempotency key")
+				}
+			}
+		}()
+
+		next(ctx)
+
+		status := ctx.Response.StatusCode()
+		if status >= http.StatusInternalServerError || ctx.Response.IsBodyStream() {
+			return
+		}
+		err = k.repo.Complete(
+			spanCtx, scope, string(key), fingerprint,
+			status, string(ctx.Response.Header.ContentType()), ctx.Response.Body(),
+		)
+		completed = err == nil
+	}
+}
+
+// replay отвечает на повтор запроса с занятым ключом
+func (k *Keeper) replay(ctx *fasthttp.RequestCtx, existing *dto.IdempotencyRecord, fingerprint []byte) {
+	switch {
+	case !bytes.Equal(existing.Fingerprint, fingerprint):
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBodyString(fmt.Sprintf("%s has already been used with a different request", Header))
+	case existing.Status != dto.IdempotencyCompleted || existing.ResponseStatus == nil:
+		ctx.Response.Header.Set(fasthttp.HeaderRetryAfter, "1")
+		ctx.Response.SetStatusCode(http.StatusConflict)
+		ctx.Response.SetBodyString(fmt.Sprintf("A request with this %s is still being processed", Header))
+	default:
+		ctx.Response.Header.Set(ReplayedHeader, "true")
+		if existing.ContentType != "" {
+			ctx.SetContentType(existing.ContentType)
+		}
+		ctx.Response.SetStatusCode(*existing.ResponseStatus)
+		ctx.Response.SetBody(existing.ResponseBody)
+	}
+}
+
+// Run удаляет просроченные ключи до отмены ctx
+func (k *Keeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(k.cfg.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		deleted, err := k.repo.DeleteExpired(pg.WithoutTracing(ctx))
+		if err != nil {
+			k.log.Error().Err(err).Msg("Failed to delete expired idempotency keys")
+			continue
+		}
+		if deleted > 0 {
+			k.log.Info().Int64("deleted", deleted).Msg("Expired idempotency keys deleted")
+		}
+	}
+}
+
+func validKey(key []byte) bool {
+	if len(key) > maxKeyLength {
+		return false
+	}
+	for _, c := range key {
+		if c < ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// scopeOf возвращает владельца ключа: API-ключ или субъект токена
+func scopeOf(ctx *fasthttp.RequestCtx) string {
+	principal, ok := ctx.UserValue(auth.PrincipalUserValue).(*auth.Principal)
+	if !ok || principal == nil {
+		return anonymousScope
+	}
+	return principal.Kind + ":" + principal.Subject
+}
+
+// fingerprintOf хеширует метод, путь с параметрами и тело запроса
+func fingerprintOf(ctx *fasthttp.RequestCtx) []byte {
+	hash := sha256.New()
+	hash.Write(ctx.Method())
+	hash.Write([]byte{' '})
+	hash.Write(ctx.Request.URI().RequestURI())
+	hash.Write([]byte{'\n'})
+	hash.Write(ctx.Request.Body())
+	return hash.Sum(nil)
+}
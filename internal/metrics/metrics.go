@@ -34,6 +34,57 @@ var (
 		Help: "Использование памяти приложением в байтах",
 	})
 
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Время выполнения SQL-запросов в секундах",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 15),
+	}, []string{"query"})
+
+	DBQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_query_errors_total",
+		Help: "Общее количество ошибок SQL-запросов",
+	}, []string{"query"})
+
+	OutboxPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_events_published_total",
+		Help: "Общее количество опубликованных событий outbox",
+	}, []string{"type"})
+
+	OutboxPublishErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_publish_errors_total",
+		Help: "Общее количество ошибок публикации событий outbox",
+	})
+
+	OutboxPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_events_pending",
+		Help: "Количество неопубликованных событий outbox",
+	})
+
+	WebhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "webhook_deliveries_total",
+		Help: "Общее количество попыток доставки вебхуков по результату",
+	}, []string{"result"})
+
+	ChangeFeedSubscribers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "change_feed_subscribers",
+		Help: "Количество открытых соединений ленты изменений",
+	})
+
+	ChangeFeedLagging = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "change_feed_lagging_disconnects_total",
+		Help: "Общее количество соединений ленты изменений, разорванных из-за переполнения очереди",
+	})
+
+	RetentionPurged = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "retention_purged_total",
+		Help: "Общее количество записей, безвозвратно удаленных из корзины по сроку хранения",
+	}, []string{"entity"})
+
+	DuplicateCandidates = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "duplicate_candidates",
+		Help: "Количество пар возможных дубликатов, найденных последним поиском",
+	}, []string{"entity"})
+
 	// TODO: Метрики кэша
 	CacheHits = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "cache_hits_total",
@@ -61,6 +112,18 @@ func RegisterMetrics() {
 	prometheus.MustRegister(TotalRequests)
 	prometheus.MustRegister(RequestDuration)
 
+	prometheus.MustRegister(DBQueryDuration)
+	prometheus.MustRegister(DBQueryErrors)
+
+	prometheus.MustRegister(OutboxPublished)
+	prometheus.MustRegister(OutboxPublishErrors)
+	prometheus.MustRegister(OutboxPending)
+	prometheus.MustRegister(WebhookDeliveries)
+	prometheus.MustRegister(ChangeFeedSubscribers)
+	prometheus.MustRegister(ChangeFeedLagging)
+	prometheus.MustRegister(RetentionPurged)
+	prometheus.MustRegister(DuplicateCandidates)
+
 	prometheus.MustRegister(ActiveGoroutines)
 	prometheus.MustRegister(MemoryUsageBytes)
 	prometheus.MustRegister(CacheHits)
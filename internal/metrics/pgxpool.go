@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pgxPoolCollector экспортирует статистику пула соединений pgxpool.Stat
+type pgxPoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns           *prometheus.Desc
+	idleConns               *prometheus.Desc
+	totalConns              *prometheus.Desc
+	constructingConns       *prometheus.Desc
+	maxConns                *prometheus.Desc
+	acquireCount            *prometheus.Desc
+	acquireDuration         *prometheus.Desc
+	emptyAcquireCount       *prometheus.Desc
+	canceledAcquireCount    *prometheus.Desc
+	newConnsCount           *prometheus.Desc
+	maxLifetimeDestroyCount *prometheus.Desc
+	maxIdleDestroyCount     *prometheus.Desc
+}
+
+// NewPgxPoolCollector создает коллектор статистики пула соединений
+func NewPgxPoolCollector(pool *pgxpool.Pool) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("pgxpool_"+name, help, nil, nil)
+	}
+	return &pgxPoolCollector{
+		pool:                    pool,
+		acquiredConns:           desc("acquired_conns", "Количество занятых соединений пула"),
+		idleConns:               desc("idle_conns", "Количество простаивающих соединений пула"),
+		totalConns:              desc("total_conns", "Общее количество соединений пула"),
+		constructingConns:       desc("constructing_conns", "Количество устанавливаемых соединений"),
+		maxConns:                desc("max_conns", "Максимальный размер пула"),
+		acquireCount:            desc("acquire_count_total", "Общее количество успешных получений соединения"),
+		acquireDuration:         desc("acquire_duration_seconds_total", "Суммарное время ожидания соединения в секундах"),
+		emptyAcquireCount:       desc("empty_acquire_count_total", "Количество получений соединения с ожиданием из-за пустого пула"),
+		canceledAcquireCount:    desc("canceled_acquire_count_total", "Количество отмененных получений соединения"),
+		newConnsCount:           desc("new_conns_count_total", "Количество созданных соединений"),
+		maxLifetimeDestroyCount: desc("max_lifetime_destroy_count_total", "Количество соединений, закрытых по MaxConnLifetime"),
+		maxIdleDestroyCount:     desc("max_idle_destroy_count_total", "Количество соединений, закрытых по MaxConnIdleTime"),
+	}
+}
+
+func (c *pgxPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *pgxPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.newConnsCount, prometheus.CounterValue, float64(stat.NewConnsCount()))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeDestroyCount, prometheus.CounterValue, float64(stat.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleDestroyCount, prometheus.CounterValue, float64(stat.MaxIdleDestroyCount()))
+}
+
+// RegisterPgxPool регистрирует коллектор статистики пула соединений
+func RegisterPgxPool(pool *pgxpool.Pool) {
+	prometheus.MustRegister(NewPgxPoolCollector(pool))
+}
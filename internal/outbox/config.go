@@ -0,0 +1,32 @@
+package outbox
+
+import "time"
+
+const (
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+	SinkHTTP   = "http"
+
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 100
+	defaultLease        = time.Minute
+	defaultHTTPTimeout  = 5 * time.Second
+)
+
+// Config настройки релея outbox
+type Config struct {
+	Enabled      bool          `yaml:"enabled"`       // Запускать релей
+	PollInterval time.Duration `yaml:"poll_interval"` // Период опроса таблицы outbox
+	BatchSize    int           `yaml:"batch_size"`    // Максимум событий за один захват
+	Lease        time.Duration `yaml:"lease"`         // Срок аренды пачки: на ее публикацию отводится не больше
+	Sink         SinkConfig    `yaml:"sink"`          // Получатель событий
+}
+
+// SinkConfig настройки получателя событий
+type SinkConfig struct {
+	Type    string            `yaml:"type"`    // stdout, file или http
+	Path    string            `yaml:"path"`    // Файл для type: file, события дописываются построчно
+	URL     string            `yaml:"url"`     // Адрес для type: http
+	Timeout time.Duration     `yaml:"timeout"` // Таймаут HTTP-запроса
+	Headers map[string]string `yaml:"headers"` // Дополнительные HTTP-заголовки
+}
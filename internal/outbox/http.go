@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+const (
+	EventIDHeader       = "X-Event-ID"
+	EventTypeHeader     = "X-Event-Type"
+	EventSequenceHeader = "X-Event-Sequence"
+)
+
+// HTTPSink отправляет каждое событие POST-запросом; успехом считается ответ 2xx
+type HTTPSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewHTTPSink создает HTTP-получателя
+func NewHTTPSink(cfg SinkConfig) *HTTPSink {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	return &HTTPSink{
+		url:     cfg.URL,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *HTTPSink) Publish(ctx context.Context, event dto.OutboxEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HTTPSink.Publish")
+	defer span.Finish()
+	ext.SpanKindRPCClient.Set(span)
+	ext.HTTPMethod.Set(span, http.MethodPost)
+	ext.HTTPUrl.Set(span, s.url)
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode outbox event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to build outbox request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, event.ID.String())
+	req.Header.Set(EventTypeHeader, event.EventType)
+	req.Header.Set(EventSequenceHeader, strconv.FormatInt(event.Sequence, 10))
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(req.Header))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		ext.Error.Set(span, true)
+		return fmt.Errorf("unable to deliver outbox event: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		_ = resp.Body.Close()
+	}()
+	ext.HTTPStatusCode.Set(span, uint16(resp.StatusCode))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		ext.Error.Set(span, true)
+		return fmt.Errorf("outbox sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *HTTPSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"errors"
+)
+
+// MultiSink публикует событие во все получатели по очереди. При ошибке
+// событие будет опубликовано повторно во все получатели, поэтому каждый
+// из них должен переносить повторы.
+type MultiSink struct {
+	sinks []Sink
+}
+
+// NewMultiSink объединяет несколько получателей в один
+func NewMultiSink(sinks ...Sink) *MultiSink {
+	return &MultiSink{sinks: sinks}
+}
+
+func (s *MultiSink) Publish(ctx context.Context, event dto.OutboxEvent) error {
+	for _, sink := range s.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MultiSink) Close() error {
+	var errs []error
+	for _, sink := range s.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
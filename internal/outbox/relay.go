@@ -0,0 +1,124 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/metrics"
+	"Brands/internal/pg"
+	outboxrepo "Brands/internal/repository/outbox"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// Relay периодически публикует накопленные в outbox события в Sink.
+// Событие отмечается опубликованным только после успешной отправки,
+// поэтому доставка происходит не менее одного раза.
+type Relay struct {
+	repo         *outboxrepo.OutboxRepository
+	sink         Sink
+	pollInterval time.Duration
+	batchSize    int
+	lease        time.Duration
+	log          zerolog.Logger
+	wake         chan struct{}
+}
+
+// NewRelay создает релей с параметрами из конфигурации
+func NewRelay(cfg Config, repo *outboxrepo.OutboxRepository, sink Sink, logger zerolog.Logger) *Relay {
+	relay := &Relay{
+		repo:         repo,
+		sink:         sink,
+		pollInterval: cfg.PollInterval,
+		batchSize:    cfg.BatchSize,
+		lease:        cfg.Lease,
+		log:          logger,
+		wake:         make(chan struct{}, 1),
+	}
+	if relay.pollInterval <= 0 {
+		relay.pollInterval = defaultPollInterval
+	}
+	if relay.batchSize <= 0 {
+		relay.batchSize = defaultBatchSize
+	}
+	if relay.lease <= 0 {
+		relay.lease = defaultLease
+	}
+	return relay
+}
+
+// Wake запускает внеочередной опрос outbox, не дожидаясь таймера
+func (r *Relay) Wake() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run опрашивает outbox до отмены ctx
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		r.drain(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// drain публикует пачки, пока outbox не опустеет или публикация не
+// завершится ошибкой. Пустые опросы не создают трасс.
+func (r *Relay) drain(ctx context.Context) {
+	pending, err := r.repo.Pending(pg.WithoutTracing(ctx))
+	if err != nil {
+		r.log.Error().Err(err).Msg("Failed to check pending outbox events")
+		return
+	}
+	metrics.OutboxPending.Set(float64(pending))
+	if pending == 0 {
+		return
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OutboxRelay.Publish")
+	defer span.Finish()
+	span.SetTag("outbox.pending", pending)
+
+	var total int
+	for ctx.Err() == nil {
+		published, err := r.repo.ProcessBatch(ctx, r.batchSize, r.lease, r.publish)
+		total += published
+		if err != nil {
+			metrics.OutboxPublishErrors.Inc()
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "publish_error"),
+				log.Error(err),
+			)
+			r.log.Warn().Ctx(ctx).Err(err).Msg("Failed to publish outbox events, will retry")
+			break
+		}
+		if published < r.batchSize {
+			break
+		}
+	}
+	span.SetTag("outbox.published", total)
+	metrics.OutboxPending.Set(float64(max(pending-int64(total), 0)))
+}
+
+// publish отправляет события по одному и останавливается на первой ошибке,
+// чтобы не нарушать порядок доставки
+func (r *Relay) publish(ctx context.Context, events []dto.OutboxEvent) (int, error) {
+	for i, event := range events {
+		if err := r.sink.Publish(ctx, event); err != nil {
+			return i, err
+		}
+		metrics.OutboxPublished.WithLabelValues(event.EventType).Inc()
+	}
+	return len(events), nil
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Sink получатель доменных событий. Publish вызывается по одному событию
+// в порядке sequence; событие считается доставленным, если ошибки нет.
+// Повторная доставка возможна, потребители дедуплицируют события по ID.
+type Sink interface {
+	Publish(ctx context.Context, event dto.OutboxEvent) error
+	Close() error
+}
+
+// NewSink создает получателя по конфигурации
+func NewSink(cfg SinkConfig) (Sink, error) {
+	switch strings.ToLower(cfg.Type) {
+	case SinkStdout, "":
+		return NewWriterSink(os.Stdout), nil
+	case SinkFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("outbox file sink requires path")
+		}
+		file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open outbox file sink: %w", err)
+		}
+		return NewWriterSink(file), nil
+	case SinkHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("outbox http sink requires url")
+		}
+		return NewHTTPSink(cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported outbox sink type: %s", cfg.Type)
+	}
+}
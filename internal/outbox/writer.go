@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterSink пишет события построчно в формате JSON Lines
+type WriterSink struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewWriterSink создает получателя, пишущего в out
+func NewWriterSink(out io.Writer) *WriterSink {
+	return &WriterSink{out: out}
+}
+
+func (s *WriterSink) Publish(_ context.Context, event dto.OutboxEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode outbox event: %w", err)
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.out.Write(data); err != nil {
+		return fmt.Errorf("unable to write outbox event: %w", err)
+	}
+	if file, ok := s.out.(*os.File); ok && file != os.Stdout {
+		return file.Sync()
+	}
+	return nil
+}
+
+func (s *WriterSink) Close() error {
+	if closer, ok := s.out.(io.Closer); ok && s.out != os.Stdout {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package pg
+
+import (
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+const (
+	// uniqueViolation код ошибки SQLSTATE нарушения уникального ограничения
+	uniqueViolation = "23505"
+	// foreignKeyViolation код ошибки SQLSTATE нарушения внешнего ключа
+	foreignKeyViolation = "23503"
+)
+
+// IsUniqueViolation сообщает, нарушено ли уникальное ограничение или индекс constraint
+func IsUniqueViolation(err error, constraint string) bool {
+	return isViolation(err, uniqueViolation, constraint)
+}
+
+// IsForeignKeyViolation сообщает, нарушен ли внешний ключ constraint
+func IsForeignKeyViolation(err error, constraint string) bool {
+	return isViolation(err, foreignKeyViolation, constraint)
+}
+
+func isViolation(err error, code, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,152 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// ChangesChannel канал уведомлений триггеров notify_catalogue_change
+	ChangesChannel = "catalogue_changes"
+
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Операции в уведомлении об изменении
+const (
+	ChangeInsert = "insert"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+	// ChangeResync отправляется после переподключения: уведомления за время
+	// разрыва потеряны, и подписчикам нужно перечитать состояние
+	ChangeResync = "resync"
+)
+
+// ListenerConfig настройки слушателя уведомлений
+type ListenerConfig struct {
+	Enabled    bool          `yaml:"enabled"`     // Слушать уведомления об изменениях
+	MinBackoff time.Duration `yaml:"min_backoff"` // Начальная задержка переподключения
+	MaxBackoff time.Duration `yaml:"max_backoff"` // Максимальная задержка переподключения
+}
+
+// ChangeNotification уведомление об изменении бренда или модели
+type ChangeNotification struct {
+	Entity    string    `json:"entity"`    // brand или model
+	Operation string    `json:"operation"` // insert, update, delete или resync
+	ID        uuid.UUID `json:"id"`        // ID записи
+	BrandID   uuid.UUID `json:"brand_id"`  // ID бренда; для бренда совпадает с ID
+}
+
+// ChangeHandler обрабатывает уведомление. Вызывается в горутине слушателя,
+// поэтому не должен блокироваться: долгую работу следует передать дальше.
+type ChangeHandler func(ChangeNotification)
+
+// Listener держит отдельное соединение с LISTEN на канал изменений и
+// раздает уведомления подписчикам процесса. При потере соединения
+// переподключается с экспоненциальной задержкой.
+type Listener struct {
+	connConfig *pgx.ConnConfig
+	cfg        ListenerConfig
+	log        zerolog.Logger
+
+	mu       sync.RWMutex
+	handlers []ChangeHandler
+}
+
+// NewListener создает слушателя с параметрами подключения пула
+func (pg *postgres) NewListener(cfg ListenerConfig, log zerolog.Logger) *Listener {
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = defaultMinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = max(defaultMaxBackoff, cfg.MinBackoff)
+	}
+	return &Listener{
+		connConfig: pg.db.Config().ConnConfig.Copy(),
+		cfg:        cfg,
+		log:        log,
+	}
+}
+
+// Subscribe добавляет обработчик уведомлений
+func (l *Listener) Subscribe(handler ChangeHandler) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handlers = append(l.handlers, handler)
+}
+
+// Run слушает канал до отмены ctx
+func (l *Listener) Run(ctx context.Context) {
+	ctx = WithoutTracing(ctx)
+	backoff := l.cfg.MinBackoff
+	connected := false
+	for {
+		err := l.listen(ctx, func() {
+			// После разрыва часть уведомлений могла быть потеряна
+			if connected {
+				l.dispatch(ChangeNotification{Operation: ChangeResync})
+			}
+			connected = true
+			backoff = l.cfg.MinBackoff
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		l.log.Warn().Err(err).Dur("retry_in", backoff).Msg("Change listener disconnected, reconnecting")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, l.cfg.MaxBackoff)
+	}
+}
+
+// listen открывает соединение, подписывается на канал и читает уведомления
+// до ошибки; onListen вызывается после успешного LISTEN
+func (l *Listener) listen(ctx context.Context, onListen func()) error {
+	conn, err := pgx.ConnectConfig(ctx, l.connConfig)
+	if err != nil {
+		return errors.Wrap(err, "unable to connect change listener")
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+ChangesChannel); err != nil {
+		return errors.Wrap(err, "unable to listen for changes")
+	}
+	l.log.Info().Str("channel", ChangesChannel).Msg("Change listener connected")
+	onListen()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "unable to wait for change notification")
+		}
+		var change ChangeNotification
+		if err = json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			l.log.Warn().Err(err).Str("payload", notification.Payload).Msg("Malformed change notification")
+			continue
+		}
+		l.dispatch(change)
+	}
+}
+
+func (l *Listener) dispatch(change ChangeNotification) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, handler := range l.handlers {
+		handler(change)
+	}
+}
@@ -0,0 +1,73 @@
+package pg
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"Brands/internal/metrics"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// Имя запроса задается комментарием вида "-- name: BrandRepository.GetByID"
+	reQueryName = regexp.MustCompile(`(?m)^\s*--\s*name:\s*(\S+)`)
+	// Таблица, к которой обращается запрос, если имя не задано явно
+	reQueryTable = regexp.MustCompile(`(?i)\b(?:from|into|update)\s+([a-z_][a-z0-9_.]*)`)
+)
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	name  string
+	begin time.Time
+}
+
+// metricsQueryTracer собирает метрики длительности и ошибок SQL-запросов
+type metricsQueryTracer struct{}
+
+func (tracer *metricsQueryTracer) TraceQueryStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryStartData,
+) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{
+		name:  queryName(data.SQL),
+		begin: time.Now(),
+	})
+}
+
+func (tracer *metricsQueryTracer) TraceQueryEnd(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryEndData,
+) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	metrics.DBQueryDuration.
+		WithLabelValues(start.name).
+		Observe(time.Since(start.begin).Seconds())
+	if data.Err != nil {
+		metrics.DBQueryErrors.WithLabelValues(start.name).Inc()
+	}
+}
+
+// queryName возвращает имя запроса для метрик: явное имя из комментария
+// "-- name: ..." либо операцию и таблицу ("SELECT brands")
+func queryName(sql string) string {
+	if match := reQueryName.FindStringSubmatch(sql); match != nil {
+		return match[1]
+	}
+
+	operation := queryOperation(sql)
+	if operation == "" {
+		return "unknown"
+	}
+	if match := reQueryTable.FindStringSubmatch(sql); match != nil {
+		return operation + " " + strings.ToLower(match[1])
+	}
+	return operation
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -23,6 +24,7 @@ var (
 func NewPG(
 	ctx context.Context,
 	conn string,
+	redactArgs bool,
 	log zerolog.Logger,
 ) (*postgres, error) {
 	var err error
@@ -34,10 +36,17 @@ func NewPG(
 			return
 		}
 
-		// Добавляем трейсер для логирования запросов
-		cfg.ConnConfig.Tracer = &myQueryTracer{
-			log: log,
-		}
+		// Добавляем трейсеры для логирования запросов, сбора метрик и спанов
+		cfg.ConnConfig.Tracer = multitracer.New(
+			&myQueryTracer{
+				log:        log,
+				redactArgs: redactArgs,
+			},
+			&metricsQueryTracer{},
+			&spanQueryTracer{
+				redactArgs: redactArgs,
+			},
+		)
 
 		// Создаем пул соединений с базой данных
 		db, poolErr := pgxpool.NewWithConfig(ctx, cfg)
@@ -87,7 +96,8 @@ func (pg *postgres) Pool() *pgxpool.Pool {
 }
 
 type myQueryTracer struct {
-	log zerolog.Logger
+	log        zerolog.Logger
+	redactArgs bool
 }
 
 func (tracer *myQueryTracer) TraceQueryStart(
@@ -95,7 +105,16 @@ func (tracer *myQueryTracer) TraceQueryStart(
 	_ *pgx.Conn,
 	data pgx.TraceQueryStartData,
 ) context.Context {
-	tracer.log.Info().Str("sql", data.SQL).Interface("args", data.Args).Send()
+	if untraced(ctx) {
+		return ctx
+	}
+	event := tracer.log.Info().Ctx(ctx).Str("sql", data.SQL)
+	if tracer.redactArgs {
+		event = event.Str("args", redactedArgs)
+	} else {
+		event = event.Interface("args", data.Args)
+	}
+	event.Send()
 	return ctx
 }
 
@@ -104,7 +123,7 @@ func (tracer *myQueryTracer) TraceQueryEnd(
 	_ *pgx.Conn,
 	data pgx.TraceQueryEndData,
 ) {
-	if data.Err != nil {
-		tracer.log.Error().Err(data.Err).Interface("args", data.CommandTag).Send()
+	if data.Err != nil && !untraced(ctx) {
+		tracer.log.Error().Ctx(ctx).Err(data.Err).Interface("args", data.CommandTag).Send()
 	}
 }
@@ -0,0 +1,225 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+const (
+	dbSystem       = "postgresql"
+	redactedArgs   = "[REDACTED]"
+	maxStatementSz = 4096
+)
+
+type spanKey struct{}
+
+type untracedKey struct{}
+
+// WithoutTracing отключает спаны и журнал запросов для контекста. Используется
+// фоновыми опросами, которые иначе создавали бы корневую трассу и запись
+// в журнале на каждый тик; ошибки опроса журналирует вызывающая сторона.
+func WithoutTracing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, untracedKey{}, true)
+}
+
+func untraced(ctx context.Context) bool {
+	disabled, _ := ctx.Value(untracedKey{}).(bool)
+	return disabled
+}
+
+// spanQueryTracer создает дочерние спаны для запросов, батчей,
+// COPY и подключений к базе данных
+type spanQueryTracer struct {
+	// redactArgs скрывает значения аргументов запросов в спанах
+	redactArgs bool
+}
+
+func (tracer *spanQueryTracer) TraceQueryStart(
+	ctx context.Context,
+	conn *pgx.Conn,
+	data pgx.TraceQueryStartData,
+) context.Context {
+	if untraced(ctx) {
+		return ctx
+	}
+	span, ctx := tracer.startSpan(ctx, conn, "pg.query "+queryName(data.SQL))
+	ext.DBStatement.Set(span, truncateStatement(data.SQL))
+	span.SetTag("db.operation", queryOperation(data.SQL))
+	span.SetTag("db.args", tracer.formatArgs(data.Args))
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (tracer *spanQueryTracer) TraceQueryEnd(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryEndData,
+) {
+	finishSpan(ctx, data.CommandTag, data.Err)
+}
+
+func (tracer *spanQueryTracer) TraceBatchStart(
+	ctx context.Context,
+	conn *pgx.Conn,
+	data pgx.TraceBatchStartData,
+) context.Context {
+	if untraced(ctx) {
+		return ctx
+	}
+	span, ctx := tracer.startSpan(ctx, conn, "pg.batch")
+	if data.Batch != nil {
+		span.SetTag("db.batch.size", data.Batch.Len())
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (tracer *spanQueryTracer) TraceBatchQuery(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceBatchQueryData,
+) {
+	span, ok := ctx.Value(spanKey{}).(opentracing.Span)
+	if !ok {
+		return
+	}
+	fields := []log.Field{
+		log.String("event", "batch_query"),
+		log.String("db.statement", truncateStatement(data.SQL)),
+		log.String("db.args", tracer.formatArgs(data.Args)),
+		log.Int64("db.rows_affected", data.CommandTag.RowsAffected()),
+	}
+	if data.Err != nil {
+		ext.Error.Set(span, true)
+		fields = append(fields, log.Error(data.Err))
+	}
+	span.LogFields(fields...)
+}
+
+func (tracer *spanQueryTracer) TraceBatchEnd(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceBatchEndData,
+) {
+	finishSpan(ctx, pgconn.CommandTag{}, data.Err)
+}
+
+func (tracer *spanQueryTracer) TraceCopyFromStart(
+	ctx context.Context,
+	conn *pgx.Conn,
+	data pgx.TraceCopyFromStartData,
+) context.Context {
+	if untraced(ctx) {
+		return ctx
+	}
+	span, ctx := tracer.startSpan(ctx, conn, "pg.copy_from "+data.TableName.Sanitize())
+	span.SetTag("db.operation", "COPY")
+	span.SetTag("db.sql.table", data.TableName.Sanitize())
+	span.SetTag("db.copy.columns", strings.Join(data.ColumnNames, ","))
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (tracer *spanQueryTracer) TraceCopyFromEnd(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceCopyFromEndData,
+) {
+	finishSpan(ctx, data.CommandTag, data.Err)
+}
+
+func (tracer *spanQueryTracer) TraceConnectStart(
+	ctx context.Context,
+	data pgx.TraceConnectStartData,
+) context.Context {
+	if untraced(ctx) {
+		return ctx
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pg.connect")
+	ext.SpanKindRPCClient.Set(span)
+	ext.DBType.Set(span, "sql")
+	span.SetTag("db.system", dbSystem)
+	if cfg := data.ConnConfig; cfg != nil {
+		ext.DBInstance.Set(span, cfg.Database)
+		ext.DBUser.Set(span, cfg.User)
+		ext.PeerHostname.Set(span, cfg.Host)
+		ext.PeerPort.Set(span, cfg.Port)
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (tracer *spanQueryTracer) TraceConnectEnd(
+	ctx context.Context,
+	data pgx.TraceConnectEndData,
+) {
+	finishSpan(ctx, pgconn.CommandTag{}, data.Err)
+}
+
+func (tracer *spanQueryTracer) startSpan(
+	ctx context.Context,
+	conn *pgx.Conn,
+	operationName string,
+) (opentracing.Span, context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, operationName)
+	ext.SpanKindRPCClient.Set(span)
+	ext.DBType.Set(span, "sql")
+	span.SetTag("db.system", dbSystem)
+	if conn != nil {
+		cfg := conn.Config()
+		ext.DBInstance.Set(span, cfg.Database)
+		ext.DBUser.Set(span, cfg.User)
+		ext.PeerHostname.Set(span, cfg.Host)
+		ext.PeerPort.Set(span, cfg.Port)
+	}
+	return span, ctx
+}
+
+func (tracer *spanQueryTracer) formatArgs(args []any) string {
+	if tracer.redactArgs && len(args) > 0 {
+		return redactedArgs
+	}
+	return fmt.Sprintf("%v", args)
+}
+
+// finishSpan завершает спан, сохраненный в контексте при старте операции
+func finishSpan(ctx context.Context, tag pgconn.CommandTag, err error) {
+	span, ok := ctx.Value(spanKey{}).(opentracing.Span)
+	if !ok {
+		return
+	}
+	defer span.Finish()
+
+	span.SetTag("db.rows_affected", tag.RowsAffected())
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(
+			log.String("event", "error"),
+			log.Error(err),
+		)
+	}
+}
+
+func queryOperation(sql string) string {
+	for _, line := range strings.Split(sql, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) > 0 {
+			return strings.ToUpper(fields[0])
+		}
+	}
+	return ""
+}
+
+func truncateStatement(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if len(sql) > maxStatementSz {
+		return sql[:maxStatementSz] + "..."
+	}
+	return sql
+}
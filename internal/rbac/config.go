@@ -0,0 +1,18 @@
+package rbac
+
+// Config политика доступа: роли и их разрешения
+type Config struct {
+	Enabled       bool            `yaml:"enabled"`        // Проверять разрешения в сервисном слое
+	AnonymousRole string          `yaml:"anonymous_role"` // Роль запросов без субъекта, пусто — запрет записи
+	Roles         map[string]Role `yaml:"roles"`          // Роли по имени
+}
+
+// Role набор разрешений роли
+type Role struct {
+	// Permissions разрешения вида <сущность>:<действие>, например brand:update;
+	// допускаются brand:* и *
+	Permissions []string `yaml:"permissions"`
+	// Fields поля, доступные роли для записи, по сущностям (имена из JSON);
+	// если сущность не указана, доступны все поля
+	Fields map[string][]string `yaml:"fields"`
+}
@@ -0,0 +1,27 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// ForbiddenError отказ в действии или в записи отдельных полей
+type ForbiddenError struct {
+	Permission string   // Недостающее разрешение
+	Fields     []string // Поля, которые роль не может изменять
+}
+
+func (e *ForbiddenError) Error() string {
+	if len(e.Fields) > 0 {
+		return fmt.Sprintf("forbidden: %s is not allowed for fields %s", e.Permission, strings.Join(e.Fields, ", "))
+	}
+	return fmt.Sprintf("forbidden: permission %s is required", e.Permission)
+}
+
+func (e *ForbiddenError) Is(target error) bool {
+	return target == ErrForbidden
+}
@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"reflect"
+	"strings"
+)
+
+// systemFields заполняются сервисом и не подлежат проверке прав на запись
+var systemFields = map[string]struct{}{
+	"id":                 {},
+	"is_deleted":         {},
+	"deleted_with_brand": {},
+	"deleted_at":         {},
+	"deleted_by":         {},
+	"merged_into":        {},
+	"created_at":         {},
+	"updated_at":         {},
+}
+
+// ChangedFields возвращает JSON-имена полей, значения которых в after
+// отличаются от before. Для создания записи before передается нулевым значением.
+func ChangedFields[T any](before, after T) []string {
+	bv := reflect.Indirect(reflect.ValueOf(before))
+	av := reflect.Indirect(reflect.ValueOf(after))
+	if bv.Kind() != reflect.Struct || av.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var changed []string
+	t := av.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := jsonName(field)
+		if name == "" || !field.IsExported() {
+			continue
+		}
+		if _, ok := systemFields[name]; ok {
+			continue
+		}
+		if !reflect.DeepEqual(bv.Field(i).Interface(), av.Field(i).Interface()) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return field.Name
+}
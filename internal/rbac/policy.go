@@ -0,0 +1,160 @@
+package rbac
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"Brands/internal/auth"
+)
+
+const (
+	EntityBrand = "brand"
+	EntityModel = "model"
+
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+	ActionPurge   = "purge"
+	ActionMerge   = "merge"
+	// ActionTranslate изменение переводов полей записи на другие языки
+	ActionTranslate = "translate"
+
+	wildcard = "*"
+)
+
+// Policy проверяет разрешения субъекта запроса по его ролям
+type Policy struct {
+	enabled       bool
+	anonymousRole string
+	roles         map[string]role
+}
+
+type role struct {
+	permissions map[string]struct{}
+	// fields разрешенные поля по сущностям; nil для сущности — все поля
+	fields map[string]map[string]struct{}
+}
+
+// New создает политику по конфигурации
+func New(cfg Config) *Policy {
+	p := &Policy{
+		enabled:       cfg.Enabled,
+		anonymousRole: cfg.AnonymousRole,
+		roles:         make(map[string]role, len(cfg.Roles)),
+	}
+	for name, r := range cfg.Roles {
+		compiled := role{
+			permissions: make(map[string]struct{}, len(r.Permissions)),
+			fields:      make(map[string]map[string]struct{}, len(r.Fields)),
+		}
+		for _, perm := range r.Permissions {
+			compiled.permissions[strings.TrimSpace(perm)] = struct{}{}
+		}
+		for entity, fields := range r.Fields {
+			allowed := make(map[string]struct{}, len(fields))
+			for _, field := range fields {
+				allowed[strings.TrimSpace(field)] = struct{}{}
+			}
+			compiled.fields[entity] = allowed
+		}
+		p.roles[name] = compiled
+	}
+	return p
+}
+
+// Enabled сообщает, применяется ли политика
+func (p *Policy) Enabled() bool {
+	return p != nil && p.enabled
+}
+
+// Authorize проверяет разрешение на действие над сущностью
+func (p *Policy) Authorize(ctx context.Context, entity, action string) error {
+	if !p.Enabled() {
+		return nil
+	}
+	if len(p.grantingRoles(ctx, entity, action)) == 0 {
+		return &ForbiddenError{Permission: permission(entity, action)}
+	}
+	return nil
+}
+
+// AuthorizeFields проверяет разрешение на действие и на запись каждого
+// из переданных полей; в ошибке перечисляются все запрещенные поля
+func (p *Policy) AuthorizeFields(ctx context.Context, entity, action string, fields []string) error {
+	if !p.Enabled() {
+		return nil
+	}
+	roles := p.grantingRoles(ctx, entity, action)
+	if len(roles) == 0 {
+		return &ForbiddenError{Permission: permission(entity, action)}
+	}
+
+	var forbidden []string
+	for _, field := range fields {
+		if !fieldAllowed(roles, entity, field) {
+			forbidden = append(forbidden, field)
+		}
+	}
+	if len(forbidden) > 0 {
+		sort.Strings(forbidden)
+		return &ForbiddenError{Permission: permission(entity, action), Fields: forbidden}
+	}
+	return nil
+}
+
+// grantingRoles возвращает роли субъекта, дающие разрешение на действие
+func (p *Policy) grantingRoles(ctx context.Context, entity, action string) []role {
+	var granting []role
+	for _, name := range p.principalRoles(ctx) {
+		r, ok := p.roles[name]
+		if !ok {
+			continue
+		}
+		if r.allows(entity, action) {
+			granting = append(granting, r)
+		}
+	}
+	return granting
+}
+
+func (p *Policy) principalRoles(ctx context.Context) []string {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		if p.anonymousRole == "" {
+			return nil
+		}
+		return []string{p.anonymousRole}
+	}
+	return principal.Roles
+}
+
+func (r role) allows(entity, action string) bool {
+	for _, perm := range []string{wildcard, entity + ":" + wildcard, permission(entity, action)} {
+		if _, ok := r.permissions[perm]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldAllowed(roles []role, entity, field string) bool {
+	for _, r := range roles {
+		allowed, restricted := r.fields[entity]
+		if !restricted {
+			return true
+		}
+		if _, ok := allowed[wildcard]; ok {
+			return true
+		}
+		if _, ok := allowed[field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func permission(entity, action string) string {
+	return entity + ":" + action
+}
@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Create сохраняет новый API-ключ
+func (r *APIKeyRepository) Create(ctx context.Context, key *dto.APIKey) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.Create")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.Create
+		INSERT INTO api_keys (id, name, prefix, key_hash, scopes, roles, expires_at, created_by, created_at, updated_at)
+		VALUES (@id, @name, @prefix, @key_hash, @scopes, @roles, @expires_at, @created_by, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+	args := pgx.NamedArgs{
+		"id":         key.ID,
+		"name":       key.Name,
+		"prefix":     key.Prefix,
+		"key_hash":   key.KeyHash,
+		"scopes":     key.Scopes,
+		"roles":      key.Roles,
+		"expires_at": key.ExpiresAt,
+		"created_by": key.CreatedBy,
+	}
+	err := r.pool.QueryRow(ctx, query, args).Scan(&key.CreatedAt, &key.UpdatedAt)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("api_key_name", key.Name).Msg("Failed to create api key")
+		return fmt.Errorf("unable to create api key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Revoke отзывает API-ключ
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.Revoke")
+	defer span.Finish()
+
+	query := `-- name: APIKeyRepository.Revoke
+		UPDATE api_keys SET revoked_at = NOW(), updated_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+	cmdTag, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("api_key_id", id.String()).Msg("Failed to revoke api key")
+		return fmt.Errorf("unable to revoke api key: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		span.LogFields(log.Error(ErrAPIKeyNotFound))
+		r.log.Warn().Ctx(ctx).Str("api_key_id", id.String()).Msg("No active api key found to revoke")
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetAll получает все API-ключи, включая отозванные
+func (r *APIKeyRepository) GetAll(ctx context.Context) ([]dto.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.GetAll")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.GetAll
+		SELECT *
+		FROM api_keys
+		ORDER BY created_at DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to execute GetAll query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	keys, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.APIKey])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetAll").Msg("Failed to collect rows into api keys")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+	return keys, nil
+}
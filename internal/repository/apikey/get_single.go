@@ -0,0 +1,65 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// GetByID получает API-ключ по ID
+func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.GetByID")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.GetByID
+		SELECT *
+		FROM api_keys
+		WHERE id = $1
+	`
+	return r.getOne(ctx, span, query, id)
+}
+
+// GetByPrefix получает API-ключ по открытому префиксу
+func (r *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*dto.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.GetByPrefix")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.GetByPrefix
+		SELECT *
+		FROM api_keys
+		WHERE prefix = $1
+	`
+	return r.getOne(ctx, span, query, prefix)
+}
+
+func (r *APIKeyRepository) getOne(
+	ctx context.Context,
+	span opentracing.Span,
+	query string,
+	arg any,
+) (*dto.APIKey, error) {
+	rows, err := r.pool.Query(ctx, query, arg)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch api key")
+		return nil, fmt.Errorf("unable to get api key: %w", err)
+	}
+
+	key, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.APIKey])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect api key row")
+		return nil, fmt.Errorf("unable to collect rows: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type APIKeyRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*APIKeyRepository, error) {
+	return &APIKeyRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Rotate заменяет секрет активного API-ключа
+func (r *APIKeyRepository) Rotate(ctx context.Context, key *dto.APIKey) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.Rotate")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.Rotate
+		UPDATE api_keys
+		SET prefix = @prefix,
+		    key_hash = @key_hash,
+		    last_used_at = NULL,
+		    updated_at = NOW()
+		WHERE id = @id AND revoked_at IS NULL
+	`
+	args := pgx.NamedArgs{
+		"id":       key.ID,
+		"prefix":   key.Prefix,
+		"key_hash": key.KeyHash,
+	}
+	cmdTag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("api_key_id", key.ID.String()).Msg("Failed to rotate api key")
+		return fmt.Errorf("unable to rotate api key: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		span.LogFields(log.Error(ErrAPIKeyNotFound))
+		r.log.Warn().Ctx(ctx).Str("api_key_id", key.ID.String()).Msg("No active api key found to rotate")
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// TouchLastUsed обновляет время последнего использования ключа не чаще раза в минуту
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyRepository.TouchLastUsed")
+	defer span.Finish()
+
+	query := `
+		-- name: APIKeyRepository.TouchLastUsed
+		UPDATE api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+	_, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("api_key_id", id.String()).Msg("Failed to update api key last usage")
+		return fmt.Errorf("unable to update api key last usage: %w", err)
+	}
+	return nil
+}
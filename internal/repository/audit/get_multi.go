@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"strings"
+)
+
+// GetAll получает страницу журнала изменений по фильтру, от новых событий к старым
+func (r *AuditRepository) GetAll(ctx context.Context, filter dto.AuditFilter) (*dto.AuditPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "AuditRepository.GetAll")
+	defer span.Finish()
+
+	var conditions []string
+	args := pgx.NamedArgs{
+		"limit":  filter.Limit,
+		"offset": filter.Offset,
+	}
+	if filter.EntityID != nil {
+		conditions = append(conditions, "entity_id = @entity_id")
+		args["entity_id"] = *filter.EntityID
+	}
+	if filter.Actor != "" {
+		conditions = append(conditions, "actor = @actor")
+		args["actor"] = filter.Actor
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "occurred_at >= @from")
+		args["from"] = *filter.From
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "occurred_at < @to")
+		args["to"] = *filter.To
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+		-- name: AuditRepository.GetAll
+		SELECT *, COUNT(*) OVER () AS total
+		FROM audit_events
+		%s
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT @limit OFFSET @offset
+	`, where)
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to execute audit GetAll query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	type auditRow struct {
+		dto.AuditEvent
+		Total int64
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[auditRow])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetAll").Msg("Failed to collect rows into audit events")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+
+	page := &dto.AuditPage{
+		Events: make([]dto.AuditEvent, 0, len(collected)),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, row := range collected {
+		page.Events = append(page.Events, row.AuditEvent)
+		page.Total = row.Total
+	}
+	if len(collected) == 0 && filter.Offset > 0 {
+		// За пределами последней страницы оконная функция ничего не вернет
+		countQuery := fmt.Sprintf(`-- name: AuditRepository.Count
+			SELECT COUNT(*) FROM audit_events %s`, where)
+		delete(args, "limit")
+		delete(args, "offset")
+		if err = r.pool.QueryRow(ctx, countQuery, args).Scan(&page.Total); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Msg("Failed to count audit events")
+			return nil, fmt.Errorf("error counting audit events: %w", err)
+		}
+	}
+	return page, nil
+}
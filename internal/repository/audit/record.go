@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"Brands/internal/auth"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"reflect"
+)
+
+const (
+	OperationCreate  = "create"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+	OperationRestore = "restore"
+	// OperationPurge безвозвратное удаление записи из корзины
+	OperationPurge = "purge"
+	// OperationMerge слияние бренда-дубликата с другим брендом
+	OperationMerge = "merge"
+
+	EntityBrand = "brand"
+	EntityModel = "model"
+	// EntityBrandTranslation и EntityModelTranslation переводы записей на
+	// один язык; ID сущности — ID переведенной записи
+	EntityBrandTranslation = "brand_translation"
+	EntityModelTranslation = "model_translation"
+	// EntityModelGeneration и EntityModelTrim поколения и комплектации моделей
+	EntityModelGeneration = "model_generation"
+	EntityModelTrim       = "model_trim"
+	// EntitySpecSchema схема характеристик моделей категории
+	EntitySpecSchema = "spec_schema"
+
+	// anonymousActor записывается, когда запрос выполнен без аутентификации
+	anonymousActor = "anonymous"
+)
+
+// Record пишет событие аудита в транзакции изменения записи, чтобы журнал
+// и данные не могли разойтись. before равен nil при создании записи.
+func Record(
+	ctx context.Context,
+	tx pgx.Tx,
+	operation, entityType string,
+	entityID uuid.UUID,
+	before, after any,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "audit.Record")
+	defer span.Finish()
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("unable to generate audit event id: %w", err)
+	}
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+	diff, err := Diff(beforeJSON, afterJSON)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		-- name: audit.Record
+		INSERT INTO audit_events (id, occurred_at, actor, request_id, operation, entity_type, entity_id, before, after, diff)
+		VALUES (@id, NOW(), @actor, @request_id, @operation, @entity_type, @entity_id, @before, @after, @diff)
+	`
+	args := pgx.NamedArgs{
+		"id":          id,
+		"actor":       ActorFromContext(ctx),
+		"request_id":  RequestIDFromContext(ctx),
+		"operation":   operation,
+		"entity_type": entityType,
+		"entity_id":   entityID,
+		"before":      beforeJSON,
+		"after":       afterJSON,
+		"diff":        diff,
+	}
+	if _, err = tx.Exec(ctx, query, args); err != nil {
+		span.LogFields(log.Error(err))
+		return fmt.Errorf("unable to write audit event: %w", err)
+	}
+	return nil
+}
+
+// Diff возвращает поля верхнего уровня, отличающиеся в двух JSON-снимках,
+// в виде {"поле": {"before": ..., "after": ...}}
+func Diff(before, after json.RawMessage) (json.RawMessage, error) {
+	var b, a map[string]any
+	if len(before) > 0 {
+		if err := json.Unmarshal(before, &b); err != nil {
+			return nil, fmt.Errorf("unable to decode audit snapshot: %w", err)
+		}
+	}
+	if len(after) > 0 {
+		if err := json.Unmarshal(after, &a); err != nil {
+			return nil, fmt.Errorf("unable to decode audit snapshot: %w", err)
+		}
+	}
+
+	diff := make(map[string]map[string]any)
+	for key, value := range a {
+		if prev, ok := b[key]; !ok || !reflect.DeepEqual(prev, value) {
+			diff[key] = map[string]any{"before": b[key], "after": value}
+		}
+	}
+	for key, prev := range b {
+		if _, ok := a[key]; !ok {
+			diff[key] = map[string]any{"before": prev, "after": nil}
+		}
+	}
+	return json.Marshal(diff)
+}
+
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode audit snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// ActorFromContext возвращает автора изменения из контекста запроса
+func ActorFromContext(ctx context.Context) string {
+	if actor := contextString(ctx, auth.ActorKey); actor != "" {
+		return actor
+	}
+	return anonymousActor
+}
+
+// RequestIDFromContext возвращает ID запроса, в котором выполняется изменение
+func RequestIDFromContext(ctx context.Context) string {
+	return contextString(ctx, "request-id")
+}
+
+func contextString(ctx context.Context, key string) string {
+	value, _ := ctx.Value(key).(string)
+	return value
+}
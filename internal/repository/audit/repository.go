@@ -0,0 +1,21 @@
+package audit
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type AuditRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*AuditRepository, error) {
+	return &AuditRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,294 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/pg"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"Brands/internal/slug"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// aliasConstraint уникальный индекс слага альтернативного названия
+const aliasConstraint = "uq_brand_aliases_slug"
+
+// Querier выполняет запросы через пул соединений или в транзакции
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// LoadAliases заполняет альтернативные названия брендов одним запросом;
+// бренд без названий получает пустой список
+func LoadAliases(ctx context.Context, q Querier, brands ...*dto.Brand) error {
+	if len(brands) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(brands))
+	byID := make(map[uuid.UUID][]*dto.Brand, len(brands))
+	for _, brand := range brands {
+		brand.Aliases = []string{}
+		if _, ok := byID[brand.ID]; !ok {
+			ids = append(ids, brand.ID)
+		}
+		byID[brand.ID] = append(byID[brand.ID], brand)
+	}
+
+	query := `
+		-- name: BrandRepository.LoadAliases
+		SELECT brand_id, alias FROM brand_aliases WHERE brand_id = ANY(@ids) ORDER BY alias, id
+	`
+	rows, err := q.Query(ctx, query, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return errors.Wrap(err, "unable to load brand aliases")
+	}
+	var brandID uuid.UUID
+	var alias string
+	_, err = pgx.ForEachRow(rows, []any{&brandID, &alias}, func() error {
+		for _, brand := range byID[brandID] {
+			brand.Aliases = append(brand.Aliases, alias)
+		}
+		return nil
+	})
+	return errors.Wrap(err, "unable to load brand aliases")
+}
+
+// GetAliases получает альтернативные названия неудаленного бренда
+func (r *BrandRepository) GetAliases(ctx context.Context, brandID uuid.UUID) ([]dto.BrandAlias, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.GetAliases")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.GetAliases
+		SELECT a.*
+		FROM brands b
+		JOIN brand_aliases a ON a.brand_id = b.id
+		WHERE b.id = @brand_id AND b.is_deleted = false
+		ORDER BY a.alias, a.id
+	`
+	exists := `-- name: BrandRepository.Exists
+		SELECT EXISTS (SELECT 1 FROM brands WHERE id = @brand_id AND is_deleted = false)`
+
+	args := pgx.NamedArgs{"brand_id": brandID}
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to fetch brand aliases")
+		return nil, fmt.Errorf("unable to get brand aliases: %w", err)
+	}
+	aliases, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.BrandAlias])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to collect brand aliases")
+		return nil, fmt.Errorf("unable to collect brand aliases: %w", err)
+	}
+	if len(aliases) > 0 {
+		return aliases, nil
+	}
+
+	// Пустой список возможен и у бренда без названий, и у отсутствующего бренда
+	var found bool
+	if err = r.pool.QueryRow(ctx, exists, args).Scan(&found); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to check brand existence")
+		return nil, fmt.Errorf("unable to check brand existence: %w", err)
+	}
+	if !found {
+		r.log.Warn().Ctx(ctx).Str("brand_id", brandID.String()).Msg("Brand not found")
+		return nil, ErrBrandNotFound
+	}
+	return []dto.BrandAlias{}, nil
+}
+
+// CreateAlias добавляет бренду альтернативное название
+func (r *BrandRepository) CreateAlias(ctx context.Context, alias *dto.BrandAlias) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.CreateAlias")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.CreateAlias
+		INSERT INTO brand_aliases (id, brand_id, alias, slug, created_at)
+		VALUES (@id, @brand_id, @alias, @slug, NOW())
+		RETURNING *
+	`
+	created, err := r.mutateAlias(ctx, alias, query)
+	if err != nil {
+		return r.aliasError(ctx, span, alias, err, "Failed to create brand alias")
+	}
+	*alias = *created
+	return nil
+}
+
+// UpdateAlias изменяет альтернативное название бренда
+func (r *BrandRepository) UpdateAlias(ctx context.Context, alias *dto.BrandAlias) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.UpdateAlias")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.UpdateAlias
+		UPDATE brand_aliases SET alias = @alias, slug = @slug
+		WHERE id = @id AND brand_id = @brand_id
+		RETURNING *
+	`
+	updated, err := r.mutateAlias(ctx, alias, query)
+	if err != nil {
+		return r.aliasError(ctx, span, alias, err, "Failed to update brand alias")
+	}
+	*alias = *updated
+	return nil
+}
+
+// DeleteAlias удаляет альтернативное название бренда
+func (r *BrandRepository) DeleteAlias(ctx context.Context, brandID, aliasID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.DeleteAlias")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.DeleteAlias
+		DELETE FROM brand_aliases
+		WHERE id = @id AND brand_id = @brand_id
+		RETURNING *
+	`
+	alias := &dto.BrandAlias{ID: aliasID, BrandID: brandID}
+	if _, err := r.mutateAlias(ctx, alias, query); err != nil {
+		return r.aliasError(ctx, span, alias, err, "Failed to delete brand alias")
+	}
+	return nil
+}
+
+// mutateAlias выполняет изменение альтернативного названия в транзакции,
+// блокирующей бренд. Изменение названий считается изменением бренда: у
+// бренда обновляется updated_at, а аудит, новая версия и доменное событие
+// содержат списки названий до и после.
+func (r *BrandRepository) mutateAlias(ctx context.Context, alias *dto.BrandAlias, mutation string) (*dto.BrandAlias, error) {
+	lock := `-- name: BrandRepository.LockForAlias
+		SELECT * FROM brands WHERE id = @brand_id AND is_deleted = false FOR UPDATE`
+	touch := `-- name: BrandRepository.TouchForAlias
+		UPDATE brands SET updated_at = NOW() WHERE id = @brand_id RETURNING *`
+
+	args := pgx.NamedArgs{
+		"id":       alias.ID,
+		"brand_id": alias.BrandID,
+		"alias":    alias.Alias,
+		"slug":     alias.Slug,
+	}
+	var result *dto.BrandAlias
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lock, args)
+		before, err := collectBrand(rows, err)
+		if err != nil {
+			return err
+		}
+		if err = LoadAliases(ctx, tx, before); err != nil {
+			return err
+		}
+		if alias.Slug != "" {
+			// Слаг другого бренда важнее названия при поиске по слагу
+			if err = r.brandSlugClash(ctx, tx, alias.Slug); err != nil {
+				return err
+			}
+		}
+
+		rows, err = tx.Query(ctx, mutation, args)
+		if err != nil {
+			return err
+		}
+		result, err = pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.BrandAlias])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAliasNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(ctx, touch, args)
+		after, err := collectBrand(rows, err)
+		if err != nil {
+			return err
+		}
+		return recordMutation(ctx, tx, audit.OperationUpdate, alias.BrandID, before, after)
+	})
+	if pg.IsUniqueViolation(err, aliasConstraint) {
+		return nil, r.aliasConflict(ctx, alias.Slug, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// brandSlugClash сообщает о конфликте, если слаг занят неудаленным брендом
+func (r *BrandRepository) brandSlugClash(ctx context.Context, tx pgx.Tx, value string) error {
+	query := `-- name: BrandRepository.BrandSlugClash
+		SELECT * FROM brands WHERE slug = @slug AND is_deleted = false`
+	rows, err := tx.Query(ctx, query, pgx.NamedArgs{"slug": value})
+	clash, err := collectBrand(rows, err)
+	if errors.Is(err, ErrBrandNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &slug.ConflictError{
+		Entity: "brand",
+		ID:     clash.ID,
+		Name:   clash.Name,
+		Slug:   clash.Slug,
+	}
+}
+
+// aliasConflict находит бренд, которому уже принадлежит название со слагом
+// value, после нарушения уникальности
+func (r *BrandRepository) aliasConflict(ctx context.Context, value string, cause error) error {
+	query := `
+		-- name: BrandRepository.AliasConflict
+		SELECT b.*
+		FROM brand_aliases a
+		JOIN brands b ON b.id = a.brand_id
+		WHERE a.slug = @slug
+	`
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"slug": value})
+	clash, err := collectBrand(rows, err)
+	if err != nil {
+		// Конфликтующее название успело исчезнуть; сообщаем исходную ошибку
+		r.log.Warn().Ctx(ctx).Err(err).Str("slug", value).Msg("Failed to find clashing brand alias")
+		return cause
+	}
+	return &slug.ConflictError{
+		Entity: "brand",
+		ID:     clash.ID,
+		Name:   clash.Name,
+		Slug:   value,
+	}
+}
+
+// aliasError логирует ошибку изменения альтернативного названия; ожидаемые
+// ошибки возвращаются как есть, чтобы вызывающие могли их различить
+func (r *BrandRepository) aliasError(
+	ctx context.Context,
+	span opentracing.Span,
+	alias *dto.BrandAlias,
+	err error,
+	msg string,
+) error {
+	span.LogFields(log.Error(err))
+	if errors.Is(err, ErrBrandNotFound) || errors.Is(err, ErrAliasNotFound) || errors.Is(err, slug.ErrConflict) {
+		r.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("brand_id", alias.BrandID.String()).
+			Str("alias_id", alias.ID.String()).
+			Msg(msg)
+		return err
+	}
+	r.log.Error().Ctx(ctx).
+		Err(err).
+		Str("brand_id", alias.BrandID.String()).
+		Str("alias_id", alias.ID.String()).
+		Msg(msg)
+	return fmt.Errorf("unable to change brand alias: %w", err)
+}
@@ -0,0 +1,109 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/pg"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"Brands/internal/repository/revision"
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// mutateAudited выполняет изменение бренда, запись события аудита, новой
+// версии, истории слагов и доменного события в outbox в одной транзакции.
+// lock блокирует текущую версию записи для снимка "до" (пустой при
+// создании), mutation изменяет запись и возвращает ее через RETURNING *.
+// Новый родитель бренда проверяется до записи изменения (см. checkParent).
+// cascade, если задан, выполняется в той же транзакции после изменения
+// бренда и получает снимки до и после. Доменные события транзакции, в том
+// числе каскадные, пишутся в outbox ее последним шагом (см. outbox.BeginFunc),
+// поэтому блокировка sequence не удерживается во время блокировки моделей
+// и дочерних брендов. Нарушение уникальности слага
+// возвращается как *slug.ConflictError, отсутствующий родитель — как
+// ErrParentNotFound.
+func (r *BrandRepository) mutateAudited(
+	ctx context.Context,
+	operation string,
+	id uuid.UUID,
+	lock, mutation string,
+	args pgx.NamedArgs,
+	cascade func(ctx context.Context, tx pgx.Tx, before, after *dto.Brand) error,
+) (*dto.Brand, error) {
+	var after *dto.Brand
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var before *dto.Brand
+		if lock != "" {
+			rows, err := tx.Query(ctx, lock, args)
+			if before, err = collectBrand(rows, err); err != nil {
+				return err
+			}
+		}
+		rows, err := tx.Query(ctx, mutation, args)
+		if after, err = collectBrand(rows, err); err != nil {
+			return err
+		}
+		if err = checkParent(ctx, tx, before, after); err != nil {
+			return err
+		}
+		if err = recordMutation(ctx, tx, operation, id, before, after); err != nil {
+			return err
+		}
+		if cascade != nil {
+			return cascade(ctx, tx, before, after)
+		}
+		return nil
+	})
+	if pg.IsUniqueViolation(err, slugConstraint) {
+		value, _ := args["slug"].(string)
+		return nil, r.slugConflict(ctx, id, value, err)
+	}
+	if pg.IsForeignKeyViolation(err, parentConstraint) {
+		return nil, ErrParentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// recordMutation записывает историю слагов, событие аудита, новую версию и
+// доменное событие в outbox для измененного в транзакции бренда. Снимки без
+// загруженных альтернативных названий и цепочки родителей дополняются ими.
+func recordMutation(ctx context.Context, tx pgx.Tx, operation string, id uuid.UUID, before, after *dto.Brand) error {
+	for _, brand := range []*dto.Brand{before, after} {
+		if brand != nil && brand.Aliases == nil {
+			if err := LoadAliases(ctx, tx, brand); err != nil {
+				return err
+			}
+		}
+		if brand != nil && brand.Path == nil {
+			if err := LoadPaths(ctx, tx, brand); err != nil {
+				return err
+			}
+		}
+	}
+	if err := recordSlugChange(ctx, tx, before, after); err != nil {
+		return err
+	}
+	if err := audit.Record(ctx, tx, operation, audit.EntityBrand, id, before, after); err != nil {
+		return err
+	}
+	if err := revision.Record(ctx, tx, audit.EntityBrand, id, operation, after); err != nil {
+		return err
+	}
+	return outbox.Record(ctx, tx, audit.EntityBrand, operation, id, after)
+}
+
+func collectBrand(rows pgx.Rows, err error) (*dto.Brand, error) {
+	if err != nil {
+		return nil, err
+	}
+	brand, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.Brand])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBrandNotFound
+	}
+	return brand, err
+}
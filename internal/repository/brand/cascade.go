@@ -0,0 +1,66 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"Brands/internal/repository/revision"
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// modelChange снимки модели до и после каскадного изменения
+type modelChange struct {
+	before, after *dto.Model
+}
+
+// cascadeModels изменяет модели бренда вслед за удалением или
+// восстановлением бренда. lock блокирует затрагиваемые модели для снимков
+// "до", mutation изменяет их и возвращает через RETURNING *. Для каждой
+// измененной модели записываются аудит, версия и событие outbox, как при
+// прямом изменении модели. Возвращает число заблокированных моделей и
+// измененные модели: mutation может пропустить часть заблокированных.
+func cascadeModels(
+	ctx context.Context,
+	tx pgx.Tx,
+	operation string,
+	lock, mutation string,
+	args pgx.NamedArgs,
+) (locked int, changed []modelChange, err error) {
+	rows, err := tx.Query(ctx, lock, args)
+	if err != nil {
+		return 0, nil, err
+	}
+	current, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Model])
+	if err != nil || len(current) == 0 {
+		return 0, nil, err
+	}
+	before := make(map[uuid.UUID]*dto.Model, len(current))
+	for _, model := range current {
+		before[model.ID] = model
+	}
+
+	rows, err = tx.Query(ctx, mutation, args)
+	if err != nil {
+		return len(current), nil, err
+	}
+	updated, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Model])
+	if err != nil {
+		return len(current), nil, err
+	}
+	changed = make([]modelChange, 0, len(updated))
+	for _, after := range updated {
+		if err = audit.Record(ctx, tx, operation, audit.EntityModel, after.ID, before[after.ID], after); err != nil {
+			return len(current), nil, err
+		}
+		if err = revision.Record(ctx, tx, audit.EntityModel, after.ID, operation, after); err != nil {
+			return len(current), nil, err
+		}
+		if err = outbox.Record(ctx, tx, audit.EntityModel, operation, after.ID, after); err != nil {
+			return len(current), nil, err
+		}
+		changed = append(changed, modelChange{before: before[after.ID], after: after})
+	}
+	return len(current), changed, nil
+}
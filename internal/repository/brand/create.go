@@ -6,8 +6,10 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
 )
 
 // Create создает новый бренд
@@ -19,13 +21,16 @@ func (r *BrandRepository) Create(
 	defer span.Finish()
 
 	query := `
-		INSERT INTO brands (id, name, link, description, logo_url, cover_image_url, founded_year, origin_country, popularity, is_premium, is_upcoming, created_at, updated_at, is_deleted)
-		VALUES (@id, @name, @link, @description, @logo_url, @cover_image_url, @founded_year, @origin_country, @popularity, @is_premium, @is_upcoming, NOW(), NOW(), false)
+		-- name: BrandRepository.Create
+		INSERT INTO brands (id, name, slug, link, description, logo_url, cover_image_url, founded_year, origin_country, popularity, is_premium, is_upcoming, parent_id, created_at, updated_at, is_deleted)
+		VALUES (@id, @name, @slug, @link, @description, @logo_url, @cover_image_url, @founded_year, @origin_country, @popularity, @is_premium, @is_upcoming, @parent_id, NOW(), NOW(), false)
+		RETURNING *
 	`
 
 	args := pgx.NamedArgs{
 		"id":              brand.ID,
 		"name":            brand.Name,
+		"slug":            brand.Slug,
 		"link":            brand.Link,
 		"description":     brand.Description,
 		"logo_url":        brand.LogoURL,
@@ -35,13 +40,20 @@ func (r *BrandRepository) Create(
 		"popularity":      brand.Popularity,
 		"is_premium":      brand.IsPremium,
 		"is_upcoming":     brand.IsUpcoming,
+		"parent_id":       brand.ParentID,
 	}
-	_, err := r.pool.Exec(ctx, query, args)
+	created, err := r.mutateAudited(ctx, audit.OperationCreate, brand.ID, "", query, args, nil)
 	if err != nil {
+		if errors.Is(err, ErrParentNotFound) || errors.Is(err, ErrHierarchyCycle) {
+			span.LogFields(log.Error(err))
+			r.log.Warn().Ctx(ctx).Interface("brand", brand).Err(err).Msg("Invalid parent brand")
+			return err
+		}
 		span.LogFields(log.Error(err))
-		r.log.Error().Interface("brand", brand).Err(err).Msg("Failed to create brand")
+		r.log.Error().Ctx(ctx).Interface("brand", brand).Err(err).Msg("Failed to create brand")
 		return fmt.Errorf("unable to create brand: %w", err)
 	}
+	*brand = *created
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package brand
 
 import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
@@ -10,56 +12,131 @@ import (
 	"github.com/pkg/errors"
 )
 
-// SoftDelete мягко удаляет бренд
+// SoftDelete мягко удаляет бренд вместе с его моделями. Модели отмечаются
+// как удаленные каскадно, чтобы восстановление бренда вернуло только их.
+// Уже удаленный бренд не удаляется повторно: возвращается ErrBrandSoftDeleted.
 func (r *BrandRepository) SoftDelete(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.SoftDelete")
 	defer span.Finish()
 
-	query := `UPDATE brands SET is_deleted = true, updated_at = NOW() WHERE id = $1`
-	cmdTag, err := r.pool.Exec(ctx, query, id)
+	lock := `-- name: BrandRepository.LockForSoftDelete
+		SELECT * FROM brands WHERE id = @id AND is_deleted = false FOR UPDATE`
+	query := `-- name: BrandRepository.SoftDelete
+		UPDATE brands SET is_deleted = true, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	lockModels := `-- name: BrandRepository.LockModelsForCascadeDelete
+		SELECT * FROM models WHERE brand_id = @id AND is_deleted = false ORDER BY id FOR UPDATE`
+	deleteModels := `-- name: BrandRepository.CascadeDeleteModels
+		UPDATE models
+		SET is_deleted = true, deleted_with_brand = true, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE brand_id = @id AND is_deleted = false
+		RETURNING *`
+
+	args := pgx.NamedArgs{"id": id, "actor": audit.ActorFromContext(ctx)}
+	var cascaded []modelChange
+	_, err := r.mutateAudited(ctx, audit.OperationDelete, id, lock, query, args,
+		func(ctx context.Context, tx pgx.Tx, _, _ *dto.Brand) (err error) {
+			_, cascaded, err = cascadeModels(ctx, tx, audit.OperationDelete, lockModels, deleteModels, args)
+			return err
+		})
+	if errors.Is(err, ErrBrandNotFound) {
+		err = r.stateConflict(ctx, id)
+	}
 
 	if err != nil {
 		span.LogFields(log.Error(err))
-		if errors.Is(err, pgx.ErrNoRows) {
-			r.log.Warn().Str("brand_id", id.String()).Msg("Brand not found")
-			return ErrBrandNotFound
+		if errors.Is(err, ErrBrandNotFound) || errors.Is(err, ErrBrandSoftDeleted) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Brand cannot be soft deleted")
+			return err
 		}
-		r.log.Error().Err(err).Str("brand_id", id.String()).Msg("Failed to soft delete brand")
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to soft delete brand")
 		return fmt.Errorf("unable to soft delete brand: %w", err)
 	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrBrandNotFound))
-		r.log.Warn().
-			Interface("brand_id", id.String()).
-			Msg("No brand found to update")
-		return ErrBrandNotFound
+	span.SetTag("models.cascaded", len(cascaded))
+	if len(cascaded) > 0 {
+		r.log.Info().Ctx(ctx).Str("brand_id", id.String()).Int("models", len(cascaded)).Msg("Brand models deleted with brand")
 	}
 	return nil
 }
 
-// Restore восстанавливает мягко удалённый бренд
+// Restore восстанавливает мягко удалённый бренд и модели, удаленные вместе
+// с ним. Модели, удаленные отдельно до удаления бренда, остаются удаленными.
+// Модель, слаг которой за это время занят другой моделью бренда, не
+// восстанавливается и остается в корзине с отметкой каскадного удаления.
+// Неудаленный бренд не восстанавливается: возвращается ErrBrandNotInTrash.
 func (r *BrandRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.Restore")
 	defer span.Finish()
 
-	query := `UPDATE brands SET is_deleted = false, updated_at = NOW() WHERE id = $1`
-	cmdTag, err := r.pool.Exec(ctx, query, id)
+	lock := `-- name: BrandRepository.LockForRestore
+		SELECT * FROM brands WHERE id = @id AND is_deleted = true FOR UPDATE`
+	query := `-- name: BrandRepository.Restore
+		UPDATE brands SET is_deleted = false, deleted_at = NULL, deleted_by = NULL, merged_into = NULL, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	lockModels := `-- name: BrandRepository.LockModelsForCascadeRestore
+		SELECT * FROM models
+		WHERE brand_id = @id AND is_deleted = true AND deleted_with_brand = true
+		ORDER BY id
+		FOR UPDATE`
+	restoreModels := `-- name: BrandRepository.CascadeRestoreModels
+		UPDATE models m
+		SET is_deleted = false, deleted_with_brand = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE m.brand_id = @id AND m.is_deleted = true AND m.deleted_with_brand = true
+		  AND NOT EXISTS (
+		      SELECT 1 FROM models o
+		      WHERE o.brand_id = m.brand_id AND o.slug = m.slug AND o.is_deleted = false
+		  )
+		RETURNING *`
+
+	args := pgx.NamedArgs{"id": id}
+	var cascaded int
+	var restored []modelChange
+	_, err := r.mutateAudited(ctx, audit.OperationRestore, id, lock, query, args,
+		func(ctx context.Context, tx pgx.Tx, _, _ *dto.Brand) (err error) {
+			cascaded, restored, err = cascadeModels(ctx, tx, audit.OperationRestore, lockModels, restoreModels, args)
+			return err
+		})
+	if errors.Is(err, ErrBrandNotFound) {
+		err = r.stateConflict(ctx, id)
+	}
 
 	if err != nil {
 		span.LogFields(log.Error(err))
-		if errors.Is(err, pgx.ErrNoRows) {
-			r.log.Warn().Str("brand_id", id.String()).Msg("Brand not found")
-			return ErrBrandNotFound
+		if errors.Is(err, ErrBrandNotFound) || errors.Is(err, ErrBrandNotInTrash) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Brand cannot be restored")
+			return err
 		}
-		r.log.Error().Err(err).Str("brand_id", id.String()).Msg("Failed to restore brand")
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to restore brand")
 		return fmt.Errorf("unable to restore brand: %w", err)
 	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrBrandNotFound))
-		r.log.Warn().
-			Interface("brand_id", id.String()).
-			Msg("No brand found to update")
-		return ErrBrandNotFound
+	span.SetTag("models.restored", len(restored))
+	if skipped := cascaded - len(restored); skipped > 0 {
+		r.log.Warn().Ctx(ctx).
+			Str("brand_id", id.String()).
+			Int("models", skipped).
+			Msg("Brand models left deleted: their slugs are taken by other models")
 	}
 	return nil
 }
+
+// stateConflict объясняет, почему удаление или восстановление не нашло бренд
+// в нужном состоянии: ErrBrandNotFound, если бренда нет, ErrBrandSoftDeleted,
+// если он уже удален, иначе ErrBrandNotInTrash
+func (r *BrandRepository) stateConflict(ctx context.Context, id uuid.UUID) error {
+	var deleted bool
+	query := `-- name: BrandRepository.GetDeletedState
+		SELECT is_deleted FROM brands WHERE id = $1`
+	err := r.pool.QueryRow(ctx, query, id).Scan(&deleted)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return ErrBrandNotFound
+	case err != nil:
+		return fmt.Errorf("unable to check brand (%s) state: %w", id, err)
+	case deleted:
+		return ErrBrandSoftDeleted
+	default:
+		return ErrBrandNotInTrash
+	}
+}
@@ -7,4 +7,15 @@ import (
 var (
 	ErrBrandNotFound    = errors.New("brand not found")
 	ErrBrandSoftDeleted = errors.New("brand has been soft-deleted")
+	ErrBrandNotDeleted  = errors.New("brand is not deleted, soft-delete it before purging")
+	// ErrBrandNotInTrash восстанавливаемый бренд не удален
+	ErrBrandNotInTrash = errors.New("brand is not deleted, nothing to restore")
+	// ErrMergeSourceNotFound бренд-дубликат для слияния не найден или удален
+	ErrMergeSourceNotFound = errors.New("merge source brand not found")
+	// ErrAliasNotFound альтернативное название не найдено у бренда
+	ErrAliasNotFound = errors.New("brand alias not found")
+	// ErrParentNotFound родительский бренд не найден или удален
+	ErrParentNotFound = errors.New("parent brand not found")
+	// ErrHierarchyCycle бренд оказался бы среди собственных предков
+	ErrHierarchyCycle = errors.New("brand cannot be a descendant of itself")
 )
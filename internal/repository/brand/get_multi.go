@@ -4,6 +4,7 @@ import (
 	"Brands/internal/dto"
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
@@ -17,6 +18,7 @@ func (r *BrandRepository) GetAll(ctx context.Context) ([]dto.Brand, error) {
 
 	// Стартуем с базового SQL-запроса
 	query := `
+        -- name: BrandRepository.GetAll
         SELECT * 
         FROM brands 
         WHERE is_deleted = false
@@ -25,7 +27,7 @@ func (r *BrandRepository) GetAll(ctx context.Context) ([]dto.Brand, error) {
 	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Msg("Failed to execute GetAll query")
 		return nil, fmt.Errorf("error executing query: %w", err)
@@ -39,12 +41,17 @@ func (r *BrandRepository) GetAll(ctx context.Context) ([]dto.Brand, error) {
 			log.Error(err),
 			log.String("event", "collect_rows_error"),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "GetAll").
 			Msg("Failed to collect rows into brands")
 		return nil, fmt.Errorf("error collecting rows: %w", err)
 	}
+	if err = loadRelatedOf(ctx, r.pool, brands); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to load brand aliases and paths")
+		return nil, err
+	}
 	return brands, nil
 }
 
@@ -61,6 +68,7 @@ func (r *BrandRepository) BrandsFilter(
 	argCounter := 1
 
 	queryBuilder.WriteString(`
+		-- name: BrandRepository.BrandsFilter
 		SELECT * 
 		FROM brands 
 		WHERE is_deleted = false
@@ -72,7 +80,11 @@ func (r *BrandRepository) BrandsFilter(
 		}
 		switch key {
 		case "name":
-			queryBuilder.WriteString(fmt.Sprintf(" AND name ILIKE $%d", argCounter))
+			// Бренд находится и по альтернативным названиям
+			queryBuilder.WriteString(fmt.Sprintf(
+				" AND (name ILIKE $%[1]d OR EXISTS (SELECT 1 FROM brand_aliases a WHERE a.brand_id = brands.id AND a.alias ILIKE $%[1]d))",
+				argCounter,
+			))
 			args = append(args, "%"+value.(string)+"%")
 		case "origin_country":
 			queryBuilder.WriteString(fmt.Sprintf(" AND origin_country = $%d", argCounter))
@@ -89,6 +101,20 @@ func (r *BrandRepository) BrandsFilter(
 		case "founded_year":
 			queryBuilder.WriteString(fmt.Sprintf(" AND founded_year = $%d", argCounter))
 			args = append(args, value.(int))
+		case "parent_id":
+			// Бренды всех уровней ниже родителя; ветка ниже удаленного бренда не обходится
+			queryBuilder.WriteString(fmt.Sprintf(` AND id IN (
+				WITH RECURSIVE tree AS (
+					SELECT id, 1 AS depth FROM brands WHERE parent_id = $%d AND is_deleted = false
+					UNION ALL
+					SELECT b.id, t.depth + 1
+					FROM tree t
+					JOIN brands b ON b.parent_id = t.id AND b.is_deleted = false
+					WHERE t.depth < %d
+				)
+				SELECT id FROM tree
+			)`, argCounter, MaxHierarchyDepth))
+			args = append(args, value.(uuid.UUID))
 		}
 		argCounter++
 	}
@@ -103,7 +129,7 @@ func (r *BrandRepository) BrandsFilter(
 		queryBuilder.WriteString(" ORDER BY created_at DESC")
 	}
 
-	r.log.Info().Str("query", queryBuilder.String()).Msg("Executing query with sorting")
+	r.log.Info().Ctx(ctx).Str("query", queryBuilder.String()).Msg("Executing query with sorting")
 	query := queryBuilder.String()
 
 	rows, err := r.pool.Query(ctx, query, args...)
@@ -112,7 +138,7 @@ func (r *BrandRepository) BrandsFilter(
 			log.Error(err),
 			log.String("query", query),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "ModelsFilter").
 			Msg("Failed to execute ModelsFilter query")
@@ -127,11 +153,16 @@ func (r *BrandRepository) BrandsFilter(
 			log.Error(err),
 			log.String("event", "collect_rows_error"),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "BrandsFilter").
 			Msg("Failed to collect rows into brands")
 		return nil, fmt.Errorf("error collecting rows: %w", err)
 	}
+	if err = loadRelatedOf(ctx, r.pool, brands); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to load brand aliases and paths")
+		return nil, err
+	}
 	return brands, nil
 }
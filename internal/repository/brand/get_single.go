@@ -19,6 +19,7 @@ func (r *BrandRepository) GetByID(
 	defer span.Finish()
 
 	query := `
+        -- name: BrandRepository.GetByID
         SELECT *
         FROM brands
         WHERE id = $1 and is_deleted = false
@@ -26,7 +27,7 @@ func (r *BrandRepository) GetByID(
 	row, err := r.pool.Query(ctx, query, id)
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().Err(err).Str("brand_id", id.String()).Msg("Failed to fetch brand by ID")
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to fetch brand by ID")
 		return nil, fmt.Errorf("unable to get brand by id: %w", err)
 	}
 
@@ -34,19 +35,24 @@ func (r *BrandRepository) GetByID(
 	brands, err = pgx.CollectRows(row, pgx.RowToStructByName[dto.Brand])
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().Err(err).Str("brand_id", id.String()).Msg("Failed to collect rows")
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to collect rows")
 		return nil, fmt.Errorf("unable to collect rows: %w", err)
 	}
 
 	if len(brands) == 0 {
-		r.log.Warn().Str("brand_id", id.String()).Msg("Brand not found")
+		r.log.Warn().Ctx(ctx).Str("brand_id", id.String()).Msg("Brand not found")
 		return nil, ErrBrandNotFound
 	}
 
 	if len(brands) > 1 {
 		err = fmt.Errorf("multiple brands found with id %s", id.String())
 		span.LogFields(log.Error(err))
-		r.log.Error().Str("brand_id", id.String()).Msg("Multiple brands found with the same ID")
+		r.log.Error().Ctx(ctx).Str("brand_id", id.String()).Msg("Multiple brands found with the same ID")
+		return nil, err
+	}
+	if err = loadRelated(ctx, r.pool, &brands[0]); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to load brand aliases and paths")
 		return nil, err
 	}
 	return &brands[0], nil
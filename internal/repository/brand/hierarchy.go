@@ -0,0 +1,295 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// parentConstraint внешний ключ родительского бренда
+const parentConstraint = "fk_brands_parent_id"
+
+// MaxHierarchyDepth наибольшее число уровней, обходимых рекурсивными
+// запросами иерархии брендов
+const MaxHierarchyDepth = 32
+
+// LoadPaths заполняет цепочки родительских брендов от корня одним запросом.
+// Цепочка строится от родителя из снимка бренда и обрывается на удаленном
+// бренде: пока родитель в корзине, бренд показывается корневым.
+func LoadPaths(ctx context.Context, q Querier, brands ...*dto.Brand) error {
+	parents := make([]uuid.UUID, 0, len(brands))
+	for _, brand := range brands {
+		brand.Path = []dto.BrandRef{}
+		if brand.ParentID != nil {
+			parents = append(parents, *brand.ParentID)
+		}
+	}
+	if len(parents) == 0 {
+		return nil
+	}
+
+	query := `
+		-- name: BrandRepository.LoadPaths
+		WITH RECURSIVE chain AS (
+			SELECT p.id AS start_id, p.id, p.name, p.slug, p.parent_id, 1 AS depth
+			FROM brands p
+			WHERE p.id = ANY(@parents) AND p.is_deleted = false
+			UNION ALL
+			SELECT c.start_id, p.id, p.name, p.slug, p.parent_id, c.depth + 1
+			FROM chain c
+			JOIN brands p ON p.id = c.parent_id AND p.is_deleted = false
+			WHERE c.depth < @max_depth
+		)
+		SELECT start_id, id, name, slug FROM chain ORDER BY start_id, depth DESC
+	`
+	rows, err := q.Query(ctx, query, pgx.NamedArgs{"parents": parents, "max_depth": MaxHierarchyDepth})
+	if err != nil {
+		return errors.Wrap(err, "unable to load brand hierarchy paths")
+	}
+	paths := make(map[uuid.UUID][]dto.BrandRef, len(parents))
+	var startID uuid.UUID
+	var ref dto.BrandRef
+	_, err = pgx.ForEachRow(rows, []any{&startID, &ref.ID, &ref.Name, &ref.Slug}, func() error {
+		paths[startID] = append(paths[startID], ref)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to load brand hierarchy paths")
+	}
+	for _, brand := range brands {
+		if brand.ParentID != nil {
+			brand.Path = append(brand.Path, paths[*brand.ParentID]...)
+		}
+	}
+	return nil
+}
+
+// loadRelated заполняет альтернативные названия и цепочки родителей брендов
+func loadRelated(ctx context.Context, q Querier, brands ...*dto.Brand) error {
+	if err := LoadAliases(ctx, q, brands...); err != nil {
+		return err
+	}
+	return LoadPaths(ctx, q, brands...)
+}
+
+// loadRelatedOf заполняет альтернативные названия и цепочки родителей
+// брендов выборки
+func loadRelatedOf(ctx context.Context, q Querier, brands []dto.Brand) error {
+	ptrs := make([]*dto.Brand, len(brands))
+	for i := range brands {
+		ptrs[i] = &brands[i]
+	}
+	return loadRelated(ctx, q, ptrs...)
+}
+
+// GetChildren получает неудаленные бренды ниже бренда id до depth уровней в
+// порядке обхода дерева: за каждым брендом следуют его потомки. Ветка ниже
+// удаленного бренда не обходится.
+func (r *BrandRepository) GetChildren(ctx context.Context, id uuid.UUID, depth int) ([]dto.BrandNode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.GetChildren")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.GetChildren
+		WITH RECURSIVE tree AS (
+			SELECT b.id, 1 AS depth, ARRAY[b.name || '/' || b.id::text] AS sort_key
+			FROM brands b
+			WHERE b.parent_id = @id AND b.is_deleted = false
+			UNION ALL
+			SELECT b.id, t.depth + 1, t.sort_key || (b.name || '/' || b.id::text)
+			FROM tree t
+			JOIN brands b ON b.parent_id = t.id AND b.is_deleted = false
+			WHERE t.depth < @depth
+		)
+		SELECT b.*, t.depth
+		FROM tree t
+		JOIN brands b ON b.id = t.id
+		ORDER BY t.sort_key
+	`
+	return r.hierarchy(ctx, span, id, query, pgx.NamedArgs{"id": id, "depth": depth}, "children")
+}
+
+// GetAncestors получает родительские бренды бренда id от корня до
+// непосредственного родителя. Цепочка обрывается на удаленном бренде.
+func (r *BrandRepository) GetAncestors(ctx context.Context, id uuid.UUID) ([]dto.BrandNode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.GetAncestors")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.GetAncestors
+		WITH RECURSIVE chain AS (
+			SELECT p.id, p.parent_id, 1 AS depth
+			FROM brands b
+			JOIN brands p ON p.id = b.parent_id AND p.is_deleted = false
+			WHERE b.id = @id AND b.is_deleted = false
+			UNION ALL
+			SELECT p.id, p.parent_id, c.depth + 1
+			FROM chain c
+			JOIN brands p ON p.id = c.parent_id AND p.is_deleted = false
+			WHERE c.depth < @depth
+		)
+		SELECT b.*, c.depth
+		FROM chain c
+		JOIN brands b ON b.id = c.id
+		ORDER BY c.depth DESC
+	`
+	return r.hierarchy(ctx, span, id, query, pgx.NamedArgs{"id": id, "depth": MaxHierarchyDepth}, "ancestors")
+}
+
+// hierarchy выполняет запрос иерархии бренда id. Пустой результат возможен
+// и у бренда без родителей или потомков, и у отсутствующего бренда, поэтому
+// для него проверяется существование бренда.
+func (r *BrandRepository) hierarchy(
+	ctx context.Context,
+	span opentracing.Span,
+	id uuid.UUID,
+	query string,
+	args pgx.NamedArgs,
+	kind string,
+) ([]dto.BrandNode, error) {
+	exists := `-- name: BrandRepository.Exists
+		SELECT EXISTS (SELECT 1 FROM brands WHERE id = @id AND is_deleted = false)`
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msgf("Failed to fetch brand %s", kind)
+		return nil, fmt.Errorf("unable to get brand %s: %w", kind, err)
+	}
+	nodes, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.BrandNode])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msgf("Failed to collect brand %s", kind)
+		return nil, fmt.Errorf("unable to collect brand %s: %w", kind, err)
+	}
+
+	if len(nodes) == 0 {
+		var found bool
+		if err = r.pool.QueryRow(ctx, exists, pgx.NamedArgs{"id": id}).Scan(&found); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to check brand existence")
+			return nil, fmt.Errorf("unable to check brand existence: %w", err)
+		}
+		if !found {
+			r.log.Warn().Ctx(ctx).Str("brand_id", id.String()).Msg("Brand not found")
+			return nil, ErrBrandNotFound
+		}
+		return []dto.BrandNode{}, nil
+	}
+
+	brands := make([]*dto.Brand, len(nodes))
+	for i := range nodes {
+		brands[i] = &nodes[i].Brand
+	}
+	if err = loadRelated(ctx, r.pool, brands...); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to load brand aliases and paths")
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// checkParent проверяет нового родителя бренда в транзакции изменения:
+// родитель не должен быть удален, а сам бренд не должен оказаться среди
+// предков родителя. Изменения родителей сериализуются блокировкой, чтобы
+// встречные переносы не образовали цикл. Неизменный родитель не
+// проверяется, поэтому бренд удаленного родителя можно изменять.
+func checkParent(ctx context.Context, tx pgx.Tx, before, after *dto.Brand) error {
+	if after.ParentID == nil {
+		return nil
+	}
+	if before != nil && before.ParentID != nil && *before.ParentID == *after.ParentID {
+		return nil
+	}
+
+	lock := `-- name: BrandRepository.LockHierarchy
+		SELECT pg_advisory_xact_lock(hashtext('brands.parent_id'))`
+	parent := `-- name: BrandRepository.LockParent
+		SELECT id FROM brands WHERE id = @parent_id AND is_deleted = false FOR SHARE`
+	// Цепочка предков обходится целиком, без ограничения глубины: иначе
+	// перенос под потомка глубже MaxHierarchyDepth замкнул бы цикл. UNION
+	// отбрасывает повторы, поэтому обход конечен и на поврежденных данных.
+	cycle := `
+		-- name: BrandRepository.HierarchyCycle
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM brands WHERE id = @parent_id
+			UNION
+			SELECT b.id, b.parent_id
+			FROM ancestors a
+			JOIN brands b ON b.id = a.parent_id
+			WHERE a.id <> @id
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = @id)
+	`
+	args := pgx.NamedArgs{"id": after.ID, "parent_id": *after.ParentID}
+
+	if _, err := tx.Exec(ctx, lock); err != nil {
+		return errors.Wrap(err, "unable to lock brand hierarchy")
+	}
+	var parentID uuid.UUID
+	err := tx.QueryRow(ctx, parent, args).Scan(&parentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrParentNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "unable to lock parent brand")
+	}
+	// Удаленные бренды тоже проверяются: их восстановление не должно
+	// замыкать цикл
+	var cyclic bool
+	if err = tx.QueryRow(ctx, cycle, args).Scan(&cyclic); err != nil {
+		return errors.Wrap(err, "unable to check brand hierarchy")
+	}
+	if cyclic {
+		return ErrHierarchyCycle
+	}
+	return nil
+}
+
+// cascadeChildren изменяет дочерние бренды вслед за слиянием или
+// безвозвратным удалением родителя, а также бренды, слитые в безвозвратно
+// удаляемый бренд. lock блокирует изменяемые бренды для снимков "до",
+// mutation изменяет их и возвращает через RETURNING *. Новый
+// родитель каждого измененного бренда проверяется, а аудит, версия и
+// событие outbox записываются, как при прямом изменении бренда.
+func cascadeChildren(ctx context.Context, tx pgx.Tx, lock, mutation string, args pgx.NamedArgs) ([]*dto.Brand, error) {
+	rows, err := tx.Query(ctx, lock, args)
+	if err != nil {
+		return nil, err
+	}
+	current, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Brand])
+	if err != nil || len(current) == 0 {
+		return nil, err
+	}
+	before := make(map[uuid.UUID]*dto.Brand, len(current))
+	for _, brand := range current {
+		before[brand.ID] = brand
+	}
+
+	rows, err = tx.Query(ctx, mutation, args)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Brand])
+	if err != nil {
+		return nil, err
+	}
+	// Все новые родители проверяются до записи изменений
+	for _, after := range updated {
+		if err = checkParent(ctx, tx, before[after.ID], after); err != nil {
+			return nil, err
+		}
+	}
+	for _, after := range updated {
+		if err = recordMutation(ctx, tx, audit.OperationUpdate, after.ID, before[after.ID], after); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,319 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// errDryRun откатывает транзакцию предпросмотра слияния
+var errDryRun = errors.New("merge dry run")
+
+// MergeFunc переносит значения полей источника в цель и возвращает имена
+// полей цели, получивших значения источника
+type MergeFunc func(target *dto.Brand, source dto.Brand) []string
+
+// Merge сливает бренд-дубликат sourceID с брендом targetID в одной
+// транзакции: поля цели дополняются по merge, все модели, альтернативные
+// названия и дочерние бренды источника переносятся в цель, название
+// источника становится альтернативным названием цели, источник мягко
+// удаляется с отметкой
+// merged_into, а его слаги становятся перенаправлениями на цель. Модель источника, слаг которой
+// занят неудаленной моделью цели, получает слаг с суффиксом из ID, а ее
+// прежний слаг сохраняется в истории слагов источника: по старому адресу
+// открывается она, а не одноименная модель цели.
+// При dryRun транзакция откатывается, а результат показывает, что было бы
+// сделано; доменные события пишутся только при фиксации, поэтому
+// предпросмотр не берет блокировку outbox. Цель, бывшая дочерним брендом источника, получает его родителя;
+// слияние с другим потомком источника отклоняется с ErrHierarchyCycle.
+func (r *BrandRepository) Merge(
+	ctx context.Context,
+	targetID, sourceID uuid.UUID,
+	merge MergeFunc,
+	dryRun bool,
+) (*dto.BrandMergeResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.Merge")
+	defer span.Finish()
+	span.SetTag("merge.dry_run", dryRun)
+
+	// Оба бренда блокируются в порядке ID, чтобы встречные слияния не
+	// взаимоблокировались
+	lock := `-- name: BrandRepository.LockForMerge
+		SELECT * FROM brands WHERE id = ANY(@ids) ORDER BY id FOR UPDATE`
+	updateTarget := `-- name: BrandRepository.MergeFields
+		UPDATE brands SET
+			link = @link,
+			description = @description,
+			logo_url = @logo_url,
+			cover_image_url = @cover_image_url,
+			founded_year = @founded_year,
+			origin_country = @origin_country,
+			popularity = @popularity,
+			is_premium = @is_premium,
+			is_upcoming = @is_upcoming,
+			updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	// Название, совпадающее по слагу с целью, ей не нужно
+	moveAliases := `-- name: BrandRepository.MoveAliases
+		WITH dropped AS (
+			DELETE FROM brand_aliases WHERE brand_id = @source_id AND slug = @target_slug
+			RETURNING id
+		)
+		UPDATE brand_aliases SET brand_id = @target_id
+		WHERE brand_id = @source_id AND id NOT IN (SELECT id FROM dropped)`
+	aliasSource := `-- name: BrandRepository.AliasSource
+		INSERT INTO brand_aliases (id, brand_id, alias, slug, created_at)
+		VALUES (@alias_id, @target_id, @source_name, @source_slug, NOW())
+		ON CONFLICT (slug) DO NOTHING`
+	lockModels := `-- name: BrandRepository.LockModelsForMerge
+		SELECT * FROM models WHERE brand_id = @source_id ORDER BY id FOR UPDATE`
+	moveModels := `-- name: BrandRepository.MoveModels
+		UPDATE models m
+		SET brand_id = @target_id,
+			slug = CASE
+				WHEN m.is_deleted = false AND EXISTS (
+					SELECT 1 FROM models t
+					WHERE t.brand_id = @target_id AND t.slug = m.slug AND t.is_deleted = false
+				) THEN left(m.slug, 91) || '-' || right(replace(m.id::text, '-', ''), 8)
+				ELSE m.slug
+			END,
+			deleted_with_brand = false,
+			updated_at = NOW()
+		WHERE m.brand_id = @source_id
+		RETURNING *`
+	moveModelSlugs := `-- name: BrandRepository.MoveModelSlugHistory
+		WITH moved AS (
+			UPDATE model_slug_history h
+			SET brand_id = @target_id
+			WHERE h.brand_id = @source_id
+			  AND NOT EXISTS (
+			      SELECT 1 FROM model_slug_history o
+			      WHERE o.brand_id = @target_id AND o.slug = h.slug
+			  )
+			RETURNING h.slug
+		)
+		DELETE FROM model_slug_history
+		WHERE brand_id = @source_id AND slug NOT IN (SELECT slug FROM moved)`
+	renamedModelSlug := `-- name: BrandRepository.RenamedModelSlug
+		INSERT INTO model_slug_history (brand_id, slug, model_id, replaced_at)
+		VALUES (@source_id, @slug, @model_id, NOW())
+		ON CONFLICT (brand_id, slug) DO UPDATE
+		SET model_id = EXCLUDED.model_id, replaced_at = EXCLUDED.replaced_at`
+	lockChildren := `-- name: BrandRepository.LockChildrenForMerge
+		SELECT * FROM brands WHERE parent_id = @source_id ORDER BY id FOR UPDATE`
+	moveChildren := `-- name: BrandRepository.MoveChildren
+		UPDATE brands c
+		SET parent_id = CASE
+				WHEN c.id = @target_id::uuid THEN (
+					SELECT p.id FROM brands p WHERE p.id = @source_parent_id::uuid AND p.is_deleted = false
+				)
+				ELSE @target_id::uuid
+			END,
+			updated_at = NOW()
+		WHERE c.parent_id = @source_id
+		RETURNING *`
+	deleteSource := `-- name: BrandRepository.MergeSource
+		UPDATE brands
+		SET is_deleted = true, deleted_at = NOW(), deleted_by = @actor, merged_into = @target_id, updated_at = NOW()
+		WHERE id = @source_id
+		RETURNING *`
+	moveSlugs := `-- name: BrandRepository.MoveSlugHistory
+		UPDATE brand_slug_history SET brand_id = @target_id WHERE brand_id = @source_id`
+	redirectSlug := `-- name: BrandRepository.RedirectSlug
+		INSERT INTO brand_slug_history (slug, brand_id, replaced_at)
+		VALUES (@source_slug, @target_id, NOW())
+		ON CONFLICT (slug) DO UPDATE
+		SET brand_id = EXCLUDED.brand_id, replaced_at = EXCLUDED.replaced_at`
+
+	args := pgx.NamedArgs{
+		"ids":       []uuid.UUID{targetID, sourceID},
+		"target_id": targetID,
+		"source_id": sourceID,
+		"actor":     audit.ActorFromContext(ctx),
+	}
+	var result *dto.BrandMergeResult
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lock, args)
+		if err != nil {
+			return err
+		}
+		locked, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Brand])
+		if err != nil {
+			return err
+		}
+		var target, source *dto.Brand
+		for _, brand := range locked {
+			if brand.IsDeleted {
+				continue
+			}
+			switch brand.ID {
+			case targetID:
+				target = brand
+			case sourceID:
+				source = brand
+			}
+		}
+		if target == nil {
+			return ErrBrandNotFound
+		}
+		if source == nil {
+			return ErrMergeSourceNotFound
+		}
+		if err = loadRelated(ctx, tx, target, source); err != nil {
+			return err
+		}
+
+		merged := *target
+		result = &dto.BrandMergeResult{
+			DryRun:       dryRun,
+			Target:       *target,
+			MergedFields: merge(&merged, *source),
+			Models:       []dto.MergedModel{},
+			Children:     []dto.BrandRef{},
+		}
+
+		args["target_slug"] = target.Slug
+		moved, err := tx.Exec(ctx, moveAliases, args)
+		if err != nil {
+			return errors.Wrap(err, "unable to move brand aliases")
+		}
+		aliasID, err := uuid.NewV7()
+		if err != nil {
+			return errors.Wrap(err, "unable to generate brand alias id")
+		}
+		args["alias_id"] = aliasID
+		args["source_name"] = source.Name
+		args["source_slug"] = source.Slug
+		added, err := tx.Exec(ctx, aliasSource, args)
+		if err != nil {
+			return errors.Wrap(err, "unable to alias merged brand name")
+		}
+		aliasesChanged := moved.RowsAffected() > 0 || added.RowsAffected() > 0
+
+		if len(result.MergedFields) > 0 || aliasesChanged {
+			rows, err = tx.Query(ctx, updateTarget, pgx.NamedArgs{
+				"id":              merged.ID,
+				"link":            merged.Link,
+				"description":     merged.Description,
+				"logo_url":        merged.LogoURL,
+				"cover_image_url": merged.CoverImageURL,
+				"founded_year":    merged.FoundedYear,
+				"origin_country":  merged.OriginCountry,
+				"popularity":      merged.Popularity,
+				"is_premium":      merged.IsPremium,
+				"is_upcoming":     merged.IsUpcoming,
+			})
+			after, err := collectBrand(rows, err)
+			if err != nil {
+				return err
+			}
+			if err = recordMutation(ctx, tx, audit.OperationUpdate, targetID, target, after); err != nil {
+				return err
+			}
+			result.Target = *after
+		}
+
+		_, models, err := cascadeModels(ctx, tx, audit.OperationUpdate, lockModels, moveModels, args)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, moveModelSlugs, args); err != nil {
+			return errors.Wrap(err, "unable to move model slug history")
+		}
+		for _, change := range models {
+			model := dto.MergedModel{
+				ID:        change.after.ID,
+				Name:      change.after.Name,
+				Slug:      change.after.Slug,
+				IsDeleted: change.after.IsDeleted,
+			}
+			if change.before != nil && change.before.Slug != change.after.Slug {
+				model.PreviousSlug = change.before.Slug
+				_, err = tx.Exec(ctx, renamedModelSlug, pgx.NamedArgs{
+					"source_id": sourceID,
+					"slug":      change.before.Slug,
+					"model_id":  change.after.ID,
+				})
+				if err != nil {
+					return errors.Wrap(err, "unable to record merged model slug")
+				}
+			}
+			result.Models = append(result.Models, model)
+		}
+
+		args["source_parent_id"] = source.ParentID
+		children, err := cascadeChildren(ctx, tx, lockChildren, moveChildren, args)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if child.ID == targetID {
+				result.Target = *child
+				continue
+			}
+			result.Children = append(result.Children, dto.BrandRef{ID: child.ID, Name: child.Name, Slug: child.Slug})
+		}
+
+		rows, err = tx.Query(ctx, deleteSource, args)
+		after, err := collectBrand(rows, err)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, moveSlugs, args); err != nil {
+			return errors.Wrap(err, "unable to move brand slug history")
+		}
+		if _, err = tx.Exec(ctx, redirectSlug, args); err != nil {
+			return errors.Wrap(err, "unable to redirect brand slug")
+		}
+		if err = recordMutation(ctx, tx, audit.OperationMerge, sourceID, source, after); err != nil {
+			return err
+		}
+		result.Source = *after
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		err = nil
+	}
+	if err != nil {
+		span.LogFields(log.Error(err))
+		if errors.Is(err, ErrBrandNotFound) || errors.Is(err, ErrMergeSourceNotFound) || errors.Is(err, ErrHierarchyCycle) {
+			r.log.Warn().Ctx(ctx).
+				Err(err).
+				Str("brand_id", targetID.String()).
+				Str("source_id", sourceID.String()).
+				Msg("Brands cannot be merged")
+			return nil, err
+		}
+		r.log.Error().Ctx(ctx).
+			Err(err).
+			Str("brand_id", targetID.String()).
+			Str("source_id", sourceID.String()).
+			Msg("Failed to merge brands")
+		return nil, fmt.Errorf("unable to merge brands: %w", err)
+	}
+
+	span.SetTag("models.moved", len(result.Models))
+	span.SetTag("children.moved", len(result.Children))
+	if !dryRun {
+		r.log.Info().Ctx(ctx).
+			Str("brand_id", targetID.String()).
+			Str("source_id", sourceID.String()).
+			Strs("fields", result.MergedFields).
+			Int("models", len(result.Models)).
+			Int("children", len(result.Children)).
+			Msg("Brands merged")
+	}
+	return result, nil
+}
@@ -0,0 +1,243 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// Purge безвозвратно удаляет мягко удаленный бренд вместе с его моделями.
+// Бренд, не находящийся в корзине, не удаляется: возвращается ErrBrandNotDeleted.
+// Возвращает число удаленных моделей.
+func (r *BrandRepository) Purge(ctx context.Context, id uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.Purge")
+	defer span.Finish()
+
+	lock := `-- name: BrandRepository.LockForPurge
+		SELECT * FROM brands WHERE id = $1 FOR UPDATE`
+
+	var models int
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lock, id)
+		brand, err := collectBrand(rows, err)
+		if err != nil {
+			return err
+		}
+		if !brand.IsDeleted {
+			return ErrBrandNotDeleted
+		}
+		models, err = purge(ctx, tx, brand)
+		return err
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		if errors.Is(err, ErrBrandNotFound) || errors.Is(err, ErrBrandNotDeleted) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Brand cannot be purged")
+			return 0, err
+		}
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to purge brand")
+		return 0, fmt.Errorf("unable to purge brand: %w", err)
+	}
+	span.SetTag("models.purged", models)
+	r.log.Info().Ctx(ctx).Str("brand_id", id.String()).Int("models", models).Msg("Brand purged")
+	return models, nil
+}
+
+// PurgeExpired безвозвратно удаляет до limit брендов, находящихся в корзине
+// дольше olderThan, вместе с их моделями. Бренды, заблокированные другими
+// транзакциями, пропускаются до следующего запуска. Возвращает число
+// удаленных брендов и моделей.
+func (r *BrandRepository) PurgeExpired(ctx context.Context, olderThan time.Duration, limit int) (brands, models int, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.PurgeExpired")
+	defer span.Finish()
+
+	query := `-- name: BrandRepository.LockExpired
+		SELECT * FROM brands
+		WHERE is_deleted = true AND deleted_at < NOW() - @older_than::interval
+		ORDER BY deleted_at
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED`
+
+	err = outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, pgx.NamedArgs{"older_than": olderThan, "limit": limit})
+		if err != nil {
+			return err
+		}
+		expired, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Brand])
+		if err != nil {
+			return err
+		}
+		brands, models = 0, 0
+		for _, brand := range expired {
+			purged, err := purge(ctx, tx, brand)
+			if err != nil {
+				return err
+			}
+			brands++
+			models += purged
+		}
+		return nil
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to purge expired brands")
+		return 0, 0, fmt.Errorf("unable to purge expired brands: %w", err)
+	}
+	span.SetTag("brands.purged", brands)
+	span.SetTag("models.purged", models)
+	return brands, models, nil
+}
+
+// purge удаляет заблокированный бренд, все его модели с поколениями и
+// комплектациями, их версии и историю слагов. Журнал аудита сохраняется: в него и в outbox пишутся события purge
+// со снимками удаленных записей. Дочерние бренды становятся корневыми, а у
+// слитых в него брендов сбрасывается merged_into, с записью событий изменения. Вызывается в транзакции outbox.BeginFunc:
+// события попадают в outbox после всех удалений.
+func purge(ctx context.Context, tx pgx.Tx, brand *dto.Brand) (int, error) {
+	// Альтернативные названия удаляются каскадно; снимок сохраняет их
+	if err := loadRelated(ctx, tx, brand); err != nil {
+		return 0, err
+	}
+	lockChildren := `-- name: BrandRepository.LockChildrenForPurge
+		SELECT * FROM brands WHERE parent_id = @id ORDER BY id FOR UPDATE`
+	detachChildren := `-- name: BrandRepository.DetachChildren
+		UPDATE brands SET parent_id = NULL, updated_at = NOW() WHERE parent_id = @id RETURNING *`
+	if _, err := cascadeChildren(ctx, tx, lockChildren, detachChildren, pgx.NamedArgs{"id": brand.ID}); err != nil {
+		return 0, err
+	}
+	// Внешнего ключа на merged_into нет: слитые в бренд источники иначе
+	// ссылались бы на удаленную запись
+	lockMerged := `-- name: BrandRepository.LockMergedForPurge
+		SELECT * FROM brands WHERE merged_into = @id ORDER BY id FOR UPDATE`
+	detachMerged := `-- name: BrandRepository.DetachMerged
+		UPDATE brands SET merged_into = NULL, updated_at = NOW() WHERE merged_into = @id RETURNING *`
+	if _, err := cascadeChildren(ctx, tx, lockMerged, detachMerged, pgx.NamedArgs{"id": brand.ID}); err != nil {
+		return 0, err
+	}
+
+	lockModels := `-- name: BrandRepository.LockModelsForPurge
+		SELECT * FROM models WHERE brand_id = $1 ORDER BY id FOR UPDATE`
+	deleteModels := `-- name: BrandRepository.PurgeModels
+		DELETE FROM models WHERE brand_id = $1`
+	rows, err := tx.Query(ctx, lockModels, brand.ID)
+	if err != nil {
+		return 0, err
+	}
+	models, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Model])
+	if err != nil {
+		return 0, err
+	}
+	if err = PurgeVariants(ctx, tx, models); err != nil {
+		return 0, err
+	}
+	if _, err = tx.Exec(ctx, deleteModels, brand.ID); err != nil {
+		return 0, err
+	}
+	modelIDs := make([]uuid.UUID, 0, len(models))
+	for _, model := range models {
+		modelIDs = append(modelIDs, model.ID)
+		if err = audit.Record(ctx, tx, audit.OperationPurge, audit.EntityModel, model.ID, model, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	deleteBrand := `-- name: BrandRepository.Purge
+		WITH model_revisions_deleted AS (
+			DELETE FROM model_revisions WHERE model_id = ANY(@model_ids)
+		), model_slugs_deleted AS (
+			DELETE FROM model_slug_history WHERE model_id = ANY(@model_ids) OR brand_id = @id
+		), brand_revisions_deleted AS (
+			DELETE FROM brand_revisions WHERE brand_id = @id
+		), brand_slugs_deleted AS (
+			DELETE FROM brand_slug_history WHERE brand_id = @id
+		)
+		DELETE FROM brands WHERE id = @id`
+	if _, err = tx.Exec(ctx, deleteBrand, pgx.NamedArgs{"id": brand.ID, "model_ids": modelIDs}); err != nil {
+		return 0, err
+	}
+	if err = audit.Record(ctx, tx, audit.OperationPurge, audit.EntityBrand, brand.ID, brand, nil); err != nil {
+		return 0, err
+	}
+
+	for _, model := range models {
+		if err = outbox.Record(ctx, tx, audit.EntityModel, audit.OperationPurge, model.ID, model); err != nil {
+			return 0, err
+		}
+	}
+	if err = outbox.Record(ctx, tx, audit.EntityBrand, audit.OperationPurge, brand.ID, brand); err != nil {
+		return 0, err
+	}
+	return len(models), nil
+}
+
+// PurgeVariants безвозвратно удаляет поколения и комплектации заблокированных
+// моделей перед удалением самих моделей. Внешние ключи удалили бы их
+// каскадно и молча, поэтому для каждой записи в аудит и outbox пишется
+// событие purge: подписчики ленты изменений не сохранят удаленные варианты.
+func PurgeVariants(ctx context.Context, tx pgx.Tx, models []*dto.Model) error {
+	if len(models) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(models))
+	brandOf := make(map[uuid.UUID]uuid.UUID, len(models))
+	for _, model := range models {
+		ids = append(ids, model.ID)
+		brandOf[model.ID] = model.BrandID
+	}
+
+	deleteTrims := `-- name: BrandRepository.PurgeTrims
+		DELETE FROM model_trims
+		WHERE generation_id IN (SELECT id FROM model_generations WHERE model_id = ANY($1))
+		RETURNING *`
+	deleteGenerations := `-- name: BrandRepository.PurgeGenerations
+		DELETE FROM model_generations WHERE model_id = ANY($1) RETURNING *`
+
+	rows, err := tx.Query(ctx, deleteTrims, ids)
+	if err != nil {
+		return errors.Wrap(err, "unable to purge model trims")
+	}
+	trims, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.ModelTrim])
+	if err != nil {
+		return errors.Wrap(err, "unable to purge model trims")
+	}
+	rows, err = tx.Query(ctx, deleteGenerations, ids)
+	if err != nil {
+		return errors.Wrap(err, "unable to purge model generations")
+	}
+	generations, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.ModelGeneration])
+	if err != nil {
+		return errors.Wrap(err, "unable to purge model generations")
+	}
+
+	generationBrand := make(map[uuid.UUID]uuid.UUID, len(generations))
+	for _, generation := range generations {
+		generation.BrandID = brandOf[generation.ModelID]
+		generationBrand[generation.ID] = generation.BrandID
+	}
+	for _, trim := range trims {
+		trim.BrandID = generationBrand[trim.GenerationID]
+		if err = audit.Record(ctx, tx, audit.OperationPurge, audit.EntityModelTrim, trim.ID, trim, nil); err != nil {
+			return err
+		}
+		if err = outbox.Record(ctx, tx, audit.EntityModelTrim, audit.OperationPurge, trim.ID, trim); err != nil {
+			return err
+		}
+	}
+	for _, generation := range generations {
+		if err = audit.Record(ctx, tx, audit.OperationPurge, audit.EntityModelGeneration, generation.ID, generation, nil); err != nil {
+			return err
+		}
+		if err = outbox.Record(ctx, tx, audit.EntityModelGeneration, audit.OperationPurge, generation.ID, generation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// slugConstraint частичный уникальный индекс слага среди неудаленных брендов
+const slugConstraint = "uq_brands_slug"
+
+// GetBySlug получает неудаленный бренд по актуальному или прежнему слагу либо
+// по слагу альтернативного названия. Бренд, найденный не по актуальному
+// слагу, возвращается с актуальным слагом, что позволяет вызывающему
+// перенаправить клиента.
+func (r *BrandRepository) GetBySlug(ctx context.Context, value string) (*dto.Brand, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.GetBySlug")
+	defer span.Finish()
+
+	query := `
+        -- name: BrandRepository.GetBySlug
+        SELECT b.*
+        FROM brands b
+        JOIN (
+            SELECT id, 0 AS rank FROM brands WHERE slug = $1 AND is_deleted = false
+            UNION ALL
+            SELECT brand_id, 1 FROM brand_slug_history WHERE slug = $1
+            UNION ALL
+            SELECT brand_id, 2 FROM brand_aliases WHERE slug = $1
+        ) found ON found.id = b.id
+        WHERE b.is_deleted = false
+        ORDER BY found.rank
+        LIMIT 1
+    `
+	rows, err := r.pool.Query(ctx, query, value)
+	brand, err := collectBrand(rows, err)
+	if err != nil {
+		if errors.Is(err, ErrBrandNotFound) {
+			r.log.Warn().Ctx(ctx).Str("slug", value).Msg("Brand not found by slug")
+			return nil, ErrBrandNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("slug", value).Msg("Failed to fetch brand by slug")
+		return nil, fmt.Errorf("unable to get brand by slug: %w", err)
+	}
+	if err = loadRelated(ctx, r.pool, brand); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("slug", value).Msg("Failed to load brand aliases and paths")
+		return nil, err
+	}
+	return brand, nil
+}
+
+// recordSlugChange сохраняет прежний слаг бренда для перенаправлений.
+// Запись истории с новым слагом удаляется: актуальный слаг важнее.
+func recordSlugChange(ctx context.Context, tx pgx.Tx, before, after *dto.Brand) error {
+	if before == nil || after == nil || before.Slug == after.Slug {
+		return nil
+	}
+	query := `
+        -- name: BrandRepository.RecordSlugChange
+        WITH forgotten AS (
+            DELETE FROM brand_slug_history WHERE slug = @new_slug
+        )
+        INSERT INTO brand_slug_history (slug, brand_id, replaced_at)
+        VALUES (@old_slug, @brand_id, NOW())
+        ON CONFLICT (slug) DO UPDATE
+        SET brand_id = EXCLUDED.brand_id, replaced_at = EXCLUDED.replaced_at
+    `
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"old_slug": before.Slug,
+		"new_slug": after.Slug,
+		"brand_id": after.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to record brand slug change")
+	}
+	return nil
+}
+
+// slugConflict находит бренд, занявший слаг, после нарушения уникальности.
+// Пустой value означает слаг самой записи id (восстановление).
+func (r *BrandRepository) slugConflict(ctx context.Context, id uuid.UUID, value string, cause error) error {
+	query := `
+        -- name: BrandRepository.SlugConflict
+        SELECT *
+        FROM brands
+        WHERE is_deleted = false
+          AND id <> @id
+          AND slug = COALESCE(NULLIF(@slug, ''), (SELECT slug FROM brands WHERE id = @id))
+        LIMIT 1
+    `
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"id": id, "slug": value})
+	clash, err := collectBrand(rows, err)
+	if err != nil {
+		// Конфликтующая запись успела исчезнуть; сообщаем исходную ошибку
+		r.log.Warn().Ctx(ctx).Err(err).Str("brand_id", id.String()).Msg("Failed to find clashing brand")
+		return cause
+	}
+	return &slug.ConflictError{
+		Entity: "brand",
+		ID:     clash.ID,
+		Name:   clash.Name,
+		Slug:   clash.Slug,
+	}
+}
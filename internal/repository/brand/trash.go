@@ -0,0 +1,72 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetTrash получает страницу мягко удаленных брендов, от недавно удаленных к давним
+func (r *BrandRepository) GetTrash(ctx context.Context, filter dto.TrashFilter) (*dto.BrandTrashPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.GetTrash")
+	defer span.Finish()
+
+	query := `
+		-- name: BrandRepository.GetTrash
+		SELECT *, COUNT(*) OVER () AS total
+		FROM brands
+		WHERE is_deleted = true
+		ORDER BY deleted_at DESC NULLS LAST, id DESC
+		LIMIT @limit OFFSET @offset
+	`
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"limit": filter.Limit, "offset": filter.Offset})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to execute brand trash query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	type trashRow struct {
+		dto.Brand
+		Total int64
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[trashRow])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetTrash").Msg("Failed to collect rows into brands")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+
+	page := &dto.BrandTrashPage{
+		Brands: make([]dto.Brand, 0, len(collected)),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, row := range collected {
+		page.Brands = append(page.Brands, row.Brand)
+		page.Total = row.Total
+	}
+	if err = loadRelatedOf(ctx, r.pool, page.Brands); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to load deleted brand aliases and paths")
+		return nil, err
+	}
+	if len(collected) == 0 && filter.Offset > 0 {
+		// За пределами последней страницы оконная функция ничего не вернет
+		countQuery := `-- name: BrandRepository.CountTrash
+			SELECT COUNT(*) FROM brands WHERE is_deleted = true`
+		if err = r.pool.QueryRow(ctx, countQuery).Scan(&page.Total); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Msg("Failed to count deleted brands")
+			return nil, fmt.Errorf("error counting deleted brands: %w", err)
+		}
+	}
+	return page, nil
+}
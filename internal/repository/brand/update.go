@@ -2,6 +2,7 @@ package brand
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
 	"context"
 	"fmt"
 	"github.com/jackc/pgx/v5"
@@ -14,9 +15,15 @@ func (r *BrandRepository) Update(ctx context.Context, brand *dto.Brand) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandRepository.Update")
 	defer span.Finish()
 
+	lock := `
+        -- name: BrandRepository.LockForUpdate
+        SELECT * FROM brands WHERE id = @id AND is_deleted = false FOR UPDATE
+    `
 	query := `
+        -- name: BrandRepository.Update
         UPDATE brands SET 
             name = @name, 
+            slug = @slug, 
             link = @link, 
             description = @description, 
             logo_url = @logo_url, 
@@ -26,12 +33,15 @@ func (r *BrandRepository) Update(ctx context.Context, brand *dto.Brand) error {
             popularity = @popularity, 
             is_premium = @is_premium, 
             is_upcoming = @is_upcoming, 
+            parent_id = @parent_id, 
             updated_at = NOW()
         WHERE id = @id AND is_deleted = false
+        RETURNING *
     `
 	args := pgx.NamedArgs{
 		"id":              brand.ID,
 		"name":            brand.Name,
+		"slug":            brand.Slug,
 		"link":            brand.Link,
 		"description":     brand.Description,
 		"logo_url":        brand.LogoURL,
@@ -41,28 +51,33 @@ func (r *BrandRepository) Update(ctx context.Context, brand *dto.Brand) error {
 		"popularity":      brand.Popularity,
 		"is_premium":      brand.IsPremium,
 		"is_upcoming":     brand.IsUpcoming,
+		"parent_id":       brand.ParentID,
 	}
 
-	cmdTag, err := r.pool.Exec(ctx, query, args)
-
+	updated, err := r.mutateAudited(ctx, audit.OperationUpdate, brand.ID, lock, query, args, nil)
 	if err != nil {
+		if errors.Is(err, ErrBrandNotFound) {
+			span.LogFields(log.Error(ErrBrandNotFound))
+			r.log.Warn().Ctx(ctx).
+				Interface("brand", brand).
+				Msg("No brand found to update")
+			return ErrBrandNotFound
+		}
+		if errors.Is(err, ErrParentNotFound) || errors.Is(err, ErrHierarchyCycle) {
+			span.LogFields(log.Error(err))
+			r.log.Warn().Ctx(ctx).
+				Err(err).
+				Interface("brand", brand).
+				Msg("Invalid parent brand")
+			return err
+		}
 		span.LogFields(log.Error(err))
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Interface("brand", brand).
 			Msg("Failed to update brand")
-
-		if errors.Is(err, pgx.ErrNoRows) {
-			return ErrBrandNotFound
-		}
 		return fmt.Errorf("unable to update brand: %w", err)
 	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrBrandNotFound))
-		r.log.Warn().
-			Interface("brand", brand).
-			Msg("No brand found to update")
-		return ErrBrandNotFound
-	}
+	*brand = *updated
 	return nil
 }
@@ -0,0 +1,37 @@
+package duplicate
+
+import (
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Dismiss отклоняет открытые пары entity, обе записи которых входят в ids,
+// и возвращает число отклоненных пар
+func (r *DuplicateRepository) Dismiss(ctx context.Context, entity string, ids []uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateRepository.Dismiss")
+	defer span.Finish()
+
+	query := `-- name: DuplicateRepository.Dismiss
+		UPDATE duplicate_candidates
+		SET status = 'dismissed', dismissed_at = NOW(), dismissed_by = @actor
+		WHERE entity = @entity AND status = 'open'
+		  AND left_id = ANY(@ids) AND right_id = ANY(@ids)`
+	tag, err := r.pool.Exec(ctx, query, pgx.NamedArgs{
+		"entity": entity,
+		"ids":    ids,
+		"actor":  audit.ActorFromContext(ctx),
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("entity", entity).Msg("Failed to dismiss duplicate candidates")
+		return 0, fmt.Errorf("unable to dismiss duplicate candidates: %w", err)
+	}
+	dismissed := int(tag.RowsAffected())
+	r.log.Info().Ctx(ctx).Str("entity", entity).Int("pairs", dismissed).Msg("Duplicate candidates dismissed")
+	return dismissed, nil
+}
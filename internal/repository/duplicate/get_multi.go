@@ -0,0 +1,126 @@
+package duplicate
+
+import (
+	"Brands/internal/dto"
+	brandrepo "Brands/internal/repository/brand"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// BrandPairs получает открытые пары неудаленных брендов с оценкой не ниже
+// minScore и сами бренды этих пар
+func (r *DuplicateRepository) BrandPairs(ctx context.Context, minScore float64) ([]dto.DuplicatePair, []dto.Brand, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateRepository.BrandPairs")
+	defer span.Finish()
+
+	query := `
+		-- name: DuplicateRepository.BrandPairs
+		SELECT c.left_id, c.right_id, c.score, c.name_similarity, c.reasons, c.detected_at
+		FROM duplicate_candidates c
+		JOIN brands l ON l.id = c.left_id AND l.is_deleted = false
+		JOIN brands r ON r.id = c.right_id AND r.is_deleted = false
+		WHERE c.entity = 'brand' AND c.status = 'open' AND c.score >= @min_score
+		ORDER BY c.score DESC, c.left_id, c.right_id
+	`
+	members := `
+		-- name: DuplicateRepository.Brands
+		SELECT * FROM brands WHERE id = ANY(@ids)
+	`
+	pairs, ids, err := r.pairs(ctx, query, pgx.NamedArgs{"min_score": minScore})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch brand duplicate candidates")
+		return nil, nil, fmt.Errorf("unable to get brand duplicate candidates: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, members, pgx.NamedArgs{"ids": ids})
+	if err == nil {
+		var brands []dto.Brand
+		if brands, err = pgx.CollectRows(rows, pgx.RowToStructByName[dto.Brand]); err == nil {
+			refs := make([]*dto.Brand, len(brands))
+			for i := range brands {
+				refs[i] = &brands[i]
+			}
+			if err = brandrepo.LoadAliases(ctx, r.pool, refs...); err == nil {
+				err = brandrepo.LoadPaths(ctx, r.pool, refs...)
+			}
+			if err == nil {
+				return pairs, brands, nil
+			}
+		}
+	}
+	span.LogFields(log.Error(err))
+	r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch duplicate brands")
+	return nil, nil, fmt.Errorf("unable to get duplicate brands: %w", err)
+}
+
+// ModelPairs получает открытые пары неудаленных моделей одного бренда с
+// оценкой не ниже minScore, при brandID — только моделей этого бренда, и сами
+// модели этих пар
+func (r *DuplicateRepository) ModelPairs(
+	ctx context.Context,
+	brandID *uuid.UUID,
+	minScore float64,
+) ([]dto.DuplicatePair, []dto.Model, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateRepository.ModelPairs")
+	defer span.Finish()
+
+	// Модель могла быть перенесена в другой бренд после поиска; такие пары
+	// не показываются до следующего запуска
+	query := `
+		-- name: DuplicateRepository.ModelPairs
+		SELECT c.left_id, c.right_id, c.score, c.name_similarity, c.reasons, c.detected_at
+		FROM duplicate_candidates c
+		JOIN models l ON l.id = c.left_id AND l.is_deleted = false
+		JOIN models r ON r.id = c.right_id AND r.is_deleted = false AND r.brand_id = l.brand_id
+		WHERE c.entity = 'model' AND c.status = 'open' AND c.score >= @min_score
+		  AND (@brand_id::uuid IS NULL OR l.brand_id = @brand_id)
+		ORDER BY c.score DESC, c.left_id, c.right_id
+	`
+	members := `
+		-- name: DuplicateRepository.Models
+		SELECT * FROM models WHERE id = ANY(@ids)
+	`
+	pairs, ids, err := r.pairs(ctx, query, pgx.NamedArgs{"min_score": minScore, "brand_id": brandID})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch model duplicate candidates")
+		return nil, nil, fmt.Errorf("unable to get model duplicate candidates: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, members, pgx.NamedArgs{"ids": ids})
+	if err == nil {
+		var models []dto.Model
+		if models, err = pgx.CollectRows(rows, pgx.RowToStructByName[dto.Model]); err == nil {
+			return pairs, models, nil
+		}
+	}
+	span.LogFields(log.Error(err))
+	r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch duplicate models")
+	return nil, nil, fmt.Errorf("unable to get duplicate models: %w", err)
+}
+
+// pairs выполняет запрос пар и возвращает их вместе с ID всех записей пар
+func (r *DuplicateRepository) pairs(ctx context.Context, query string, args pgx.NamedArgs) ([]dto.DuplicatePair, []uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	pairs, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.DuplicatePair])
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := make(map[uuid.UUID]struct{}, len(pairs))
+	ids := make([]uuid.UUID, 0, len(pairs))
+	for _, pair := range pairs {
+		for _, id := range []uuid.UUID{pair.LeftID, pair.RightID} {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	return pairs, ids, nil
+}
@@ -0,0 +1,93 @@
+package duplicate
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"strings"
+)
+
+// pruneDismissed удаляет отклоненные пары, одна из записей которых удалена
+// безвозвратно. Мягкое удаление отклонение не снимает: после восстановления
+// пара не предлагается снова.
+var pruneDismissed = map[string]string{
+	audit.EntityBrand: `-- name: DuplicateRepository.PruneDismissedBrands
+		DELETE FROM duplicate_candidates c
+		WHERE c.entity = 'brand' AND c.status = 'dismissed'
+		  AND (NOT EXISTS (SELECT 1 FROM brands WHERE id = c.left_id)
+		       OR NOT EXISTS (SELECT 1 FROM brands WHERE id = c.right_id))`,
+	audit.EntityModel: `-- name: DuplicateRepository.PruneDismissedModels
+		DELETE FROM duplicate_candidates c
+		WHERE c.entity = 'model' AND c.status = 'dismissed'
+		  AND (NOT EXISTS (SELECT 1 FROM models WHERE id = c.left_id)
+		       OR NOT EXISTS (SELECT 1 FROM models WHERE id = c.right_id))`,
+}
+
+// Replace сохраняет результат поиска дубликатов entity: найденные пары
+// добавляются или обновляются с сохранением статуса, открытые пары, не
+// найденные этим запуском, удаляются. Отклоненные пары остаются отклоненными.
+func (r *DuplicateRepository) Replace(ctx context.Context, entity string, pairs []dto.DuplicatePair) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateRepository.Replace")
+	defer span.Finish()
+	span.SetTag("duplicates.entity", entity)
+	span.SetTag("duplicates.pairs", len(pairs))
+
+	// NOW() постоянно в пределах транзакции, поэтому пары этого запуска
+	// отличаются от прежних по detected_at
+	upsert := `-- name: DuplicateRepository.Upsert
+		INSERT INTO duplicate_candidates (entity, left_id, right_id, score, name_similarity, reasons, detected_at)
+		SELECT @entity, p.left_id, p.right_id, p.score, p.name_similarity, string_to_array(p.reasons, ','), NOW()
+		FROM unnest(@left_ids::uuid[], @right_ids::uuid[], @scores::float8[], @similarities::float8[], @reasons::text[])
+		    AS p(left_id, right_id, score, name_similarity, reasons)
+		ON CONFLICT (entity, left_id, right_id) DO UPDATE
+		SET score = EXCLUDED.score,
+		    name_similarity = EXCLUDED.name_similarity,
+		    reasons = EXCLUDED.reasons,
+		    detected_at = EXCLUDED.detected_at`
+	prune := `-- name: DuplicateRepository.PruneStale
+		DELETE FROM duplicate_candidates
+		WHERE entity = @entity AND status = 'open' AND detected_at < NOW()`
+
+	leftIDs := make([]uuid.UUID, 0, len(pairs))
+	rightIDs := make([]uuid.UUID, 0, len(pairs))
+	scores := make([]float64, 0, len(pairs))
+	similarities := make([]float64, 0, len(pairs))
+	reasons := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		leftIDs = append(leftIDs, pair.LeftID)
+		rightIDs = append(rightIDs, pair.RightID)
+		scores = append(scores, pair.Score)
+		similarities = append(similarities, pair.NameSimilarity)
+		reasons = append(reasons, strings.Join(pair.Reasons, ","))
+	}
+	args := pgx.NamedArgs{
+		"entity":       entity,
+		"left_ids":     leftIDs,
+		"right_ids":    rightIDs,
+		"scores":       scores,
+		"similarities": similarities,
+		"reasons":      reasons,
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, upsert, args); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, prune, args); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, pruneDismissed[entity])
+		return err
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("entity", entity).Msg("Failed to save duplicate candidates")
+		return fmt.Errorf("unable to save duplicate candidates: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package duplicate
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type DuplicateRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*DuplicateRepository, error) {
+	return &DuplicateRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,91 @@
+package idempotency
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// Acquire закрепляет ключ за текущим запросом. Вставка с ON CONFLICT
+// атомарна, поэтому из параллельных запросов с одним ключом выполняться
+// будет только один. Просроченный ключ и ключ, чей запрос не завершился за
+// lockTimeout (например, экземпляр упал), перезахватываются.
+//
+// Если ключ занят, возвращается acquired=false и сохраненная запись.
+func (r *IdempotencyRepository) Acquire(
+	ctx context.Context,
+	scope, key string,
+	fingerprint []byte,
+	ttl, lockTimeout time.Duration,
+) (acquired bool, existing *dto.IdempotencyRecord, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "IdempotencyRepository.Acquire")
+	defer span.Finish()
+
+	query := `
+		-- name: IdempotencyRepository.Acquire
+		INSERT INTO idempotency_keys (scope, key, fingerprint, status, created_at, updated_at, expires_at)
+		VALUES (@scope, @key, @fingerprint, 'processing', NOW(), NOW(), NOW() + @ttl::interval)
+		ON CONFLICT (scope, key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint,
+		    status = 'processing',
+		    response_status = NULL,
+		    content_type = '',
+		    response_body = NULL,
+		    created_at = NOW(),
+		    updated_at = NOW(),
+		    expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < NOW()
+		   OR (idempotency_keys.status = 'processing' AND idempotency_keys.updated_at < NOW() - @lock_timeout::interval)
+		RETURNING true
+	`
+	args := pgx.NamedArgs{
+		"scope":        scope,
+		"key":          key,
+		"fingerprint":  fingerprint,
+		"ttl":          ttl,
+		"lock_timeout": lockTimeout,
+	}
+	// Запись может исчезнуть между вставкой и чтением, если ее удалил
+	// завершившийся с ошибкой запрос; тогда попытка повторяется
+	for attempt := 0; attempt < 3; attempt++ {
+		err = r.pool.QueryRow(ctx, query, args).Scan(&acquired)
+		if err == nil {
+			return true, nil, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Str("idempotency_key", key).Msg("Failed to acquire idempotency key")
+			return false, nil, fmt.Errorf("unable to acquire idempotency key: %w", err)
+		}
+
+		existing, err = r.get(ctx, scope, key)
+		if err == nil {
+			return false, existing, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Str("idempotency_key", key).Msg("Failed to fetch idempotency key")
+			return false, nil, fmt.Errorf("unable to get idempotency key: %w", err)
+		}
+	}
+	return false, nil, fmt.Errorf("unable to acquire idempotency key: too much contention")
+}
+
+func (r *IdempotencyRepository) get(ctx context.Context, scope, key string) (*dto.IdempotencyRecord, error) {
+	query := `
+		-- name: IdempotencyRepository.Get
+		SELECT *
+		FROM idempotency_keys
+		WHERE scope = $1 AND key = $2
+	`
+	rows, err := r.pool.Query(ctx, query, scope, key)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.IdempotencyRecord])
+}
@@ -0,0 +1,57 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Complete сохраняет ответ выполненного запроса для повторов с тем же ключом
+func (r *IdempotencyRepository) Complete(
+	ctx context.Context,
+	scope, key string,
+	fingerprint []byte,
+	status int,
+	contentType string,
+	body []byte,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "IdempotencyRepository.Complete")
+	defer span.Finish()
+
+	query := `
+		-- name: IdempotencyRepository.Complete
+		UPDATE idempotency_keys
+		SET status = 'completed',
+		    response_status = $4,
+		    content_type = $5,
+		    response_body = $6,
+		    updated_at = NOW()
+		WHERE scope = $1 AND key = $2 AND fingerprint = $3 AND status = 'processing'
+	`
+	if _, err := r.pool.Exec(ctx, query, scope, key, fingerprint, status, contentType, body); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("idempotency_key", key).Msg("Failed to store idempotent response")
+		return fmt.Errorf("unable to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release освобождает ключ запроса, завершившегося без сохраняемого ответа,
+// чтобы клиент мог повторить его с тем же ключом
+func (r *IdempotencyRepository) Release(ctx context.Context, scope, key string, fingerprint []byte) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "IdempotencyRepository.Release")
+	defer span.Finish()
+
+	query := `
+		-- name: IdempotencyRepository.Release
+		DELETE FROM idempotency_keys
+		WHERE scope = $1 AND key = $2 AND fingerprint = $3 AND status = 'processing'
+	`
+	if _, err := r.pool.Exec(ctx, query, scope, key, fingerprint); err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("idempotency_key", key).Msg("Failed to release idempotency key")
+		return fmt.Errorf("unable to release idempotency key: %w", err)
+	}
+	return nil
+}
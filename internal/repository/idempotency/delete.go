@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteExpired удаляет ключи с истекшим сроком хранения
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	query := `-- name: IdempotencyRepository.DeleteExpired
+		DELETE FROM idempotency_keys WHERE expires_at < NOW()`
+	cmdTag, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("unable to delete expired idempotency keys: %w", err)
+	}
+	return cmdTag.RowsAffected(), nil
+}
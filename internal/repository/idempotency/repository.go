@@ -0,0 +1,21 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type IdempotencyRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*IdempotencyRepository, error) {
+	return &IdempotencyRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
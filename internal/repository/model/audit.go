@@ -0,0 +1,122 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/pg"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/brand"
+	"Brands/internal/repository/outbox"
+	"Brands/internal/repository/revision"
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// mutateAudited выполняет изменение модели, запись события аудита, новой
+// версии, истории слагов и доменного события в outbox в одной транзакции.
+// guard блокирует бренд модели FOR SHARE и возвращает строку, только если
+// бренд не удален (пустой — без проверки); иначе возвращается
+// brand.ErrBrandNotFound. Бренд блокируется раньше модели, как и при
+// каскадном удалении бренда, поэтому конкурентное удаление бренда ждет
+// фиксации и не оставляет видимых моделей удаленного бренда.
+// Если в args передана ненулевая schema_version, схема категории блокируется
+// FOR SHARE и должна иметь эту версию: характеристики проверены сервисом по
+// ней, а замена схемы ждет фиксации. Иначе возвращается ErrSchemaChanged.
+// lock блокирует текущую версию записи для снимка "до" (пустой при
+// создании), mutation изменяет запись и возвращает ее через RETURNING *.
+// Нарушение уникальности слага возвращается как *slug.ConflictError.
+func (r *ModelRepository) mutateAudited(
+	ctx context.Context,
+	operation string,
+	id uuid.UUID,
+	guard, lock, mutation string,
+	args pgx.NamedArgs,
+) (*dto.Model, error) {
+	var after *dto.Model
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if guard != "" {
+			if err := lockBrand(ctx, tx, guard, args); err != nil {
+				return err
+			}
+		}
+		if version, _ := args["schema_version"].(int); version > 0 {
+			if err := lockSchema(ctx, tx, args, version); err != nil {
+				return err
+			}
+		}
+		var before *dto.Model
+		if lock != "" {
+			rows, err := tx.Query(ctx, lock, args)
+			if before, err = collectModel(rows, err); err != nil {
+				return err
+			}
+		}
+		rows, err := tx.Query(ctx, mutation, args)
+		if after, err = collectModel(rows, err); err != nil {
+			return err
+		}
+		if err = recordSlugChange(ctx, tx, before, after); err != nil {
+			return err
+		}
+		if err = audit.Record(ctx, tx, operation, audit.EntityModel, id, before, after); err != nil {
+			return err
+		}
+		if err = revision.Record(ctx, tx, audit.EntityModel, id, operation, after); err != nil {
+			return err
+		}
+		return outbox.Record(ctx, tx, audit.EntityModel, operation, id, after)
+	})
+	if pg.IsUniqueViolation(err, slugConstraint) {
+		value, _ := args["slug"].(string)
+		var brandID *uuid.UUID
+		if v, ok := args["brand_id"].(uuid.UUID); ok {
+			brandID = &v
+		}
+		return nil, r.slugConflict(ctx, id, value, brandID, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// lockBrand выполняет guard и проверяет, что бренд найден и не удален
+func lockBrand(ctx context.Context, tx pgx.Tx, guard string, args pgx.NamedArgs) error {
+	rows, err := tx.Query(ctx, guard, args)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return brand.ErrBrandNotFound
+	}
+	return nil
+}
+
+// lockSchema блокирует схему категории модели и сверяет ее версию с
+// версией expected, по которой сервис проверил характеристики
+func lockSchema(ctx context.Context, tx pgx.Tx, args pgx.NamedArgs, expected int) error {
+	query := `-- name: ModelRepository.LockSchema
+		SELECT version FROM spec_schemas WHERE category = @category FOR SHARE`
+	var version int
+	err := tx.QueryRow(ctx, query, args).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && version != expected) {
+		return ErrSchemaChanged
+	}
+	return err
+}
+
+func collectModel(rows pgx.Rows, err error) (*dto.Model, error) {
+	if err != nil {
+		return nil, err
+	}
+	model, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.Model])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrModelNotFound
+	}
+	return model, err
+}
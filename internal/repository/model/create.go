@@ -2,64 +2,77 @@ package model
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
 	"Brands/internal/repository/brand"
+	"Brands/pkg/redact"
 	"context"
 	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
 )
 
-// Create создает новую модель
-func (r *ModelRepository) Create(ctx context.Context, model *dto.Model) error {
+// guardBrand блокирует бренд модели на время транзакции; бренд не должен
+// быть удален
+const guardBrand = `-- name: ModelRepository.GuardBrand
+		SELECT 1 FROM brands WHERE id = @brand_id AND is_deleted = false FOR SHARE`
+
+// Create создает новую модель. schemaVersion — версия схемы категории, по
+// которой проверены характеристики; 0 для модели без категории.
+func (r *ModelRepository) Create(ctx context.Context, model *dto.Model, schemaVersion int) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.Create")
 	defer span.Finish()
 
-	exists, err := r.brandExists(ctx, model.BrandID)
-	if err != nil {
-		span.LogFields(
-			log.Error(err),
-			log.Object("model", model),
-		)
-		r.log.Error().Err(err).Interface("model", model).Msg("Failed to check if brand exists")
-		return fmt.Errorf("failed to check brand existence: %w", err)
-	}
-	if !exists {
-		err = fmt.Errorf(
-			"brand with ID %d does not exist: %w",
-			model.BrandID,
-			brand.ErrBrandNotFound,
-		)
-
-		span.LogFields(
-			log.Error(err),
-			log.Object("model", model),
-		)
-		r.log.Warn().Interface("model", model).Msg(err.Error())
-		return err
-	}
-
 	query := `
-		INSERT INTO models (id, brand_id, name, release_date, is_upcoming, is_limited, created_at, updated_at, is_deleted)
-		VALUES (@id, @brand_id, @name, @release_date, @is_upcoming, @is_limited, NOW(), NOW(), false)
+		-- name: ModelRepository.Create
+		INSERT INTO models (id, brand_id, name, slug, release_date, is_upcoming, is_limited, category, specs, created_at, updated_at, is_deleted)
+		VALUES (@id, @brand_id, @name, @slug, @release_date, @is_upcoming, @is_limited, @category, @specs, NOW(), NOW(), false)
+		RETURNING *
 	`
 	args := pgx.NamedArgs{
-		"id":           model.ID,
-		"brand_id":     model.BrandID,
-		"name":         model.Name,
-		"release_date": model.ReleaseDate,
-		"is_upcoming":  model.IsUpcoming,
-		"is_limited":   model.IsLimited,
+		"id":             model.ID,
+		"brand_id":       model.BrandID,
+		"name":           model.Name,
+		"slug":           model.Slug,
+		"release_date":   model.ReleaseDate,
+		"is_upcoming":    model.IsUpcoming,
+		"is_limited":     model.IsLimited,
+		"category":       model.Category,
+		"specs":          specsArg(model.Specs),
+		"schema_version": schemaVersion,
 	}
-	_, err = r.pool.Exec(ctx, query, args)
+	created, err := r.mutateAudited(ctx, audit.OperationCreate, model.ID, guardBrand, "", query, args)
 	if err != nil {
+		if errors.Is(err, brand.ErrBrandNotFound) {
+			err = fmt.Errorf("brand with ID %s does not exist: %w", model.BrandID, brand.ErrBrandNotFound)
+			span.LogFields(
+				log.Error(err),
+				redact.JSONField("model", model),
+			)
+			r.log.Warn().Ctx(ctx).Interface("model", model).Msg(err.Error())
+			return err
+		}
 		span.LogFields(
 			log.Error(err),
-			log.Object("brand", model),
+			redact.JSONField("model", model),
 		)
-		r.log.Error().Interface("model", model).Err(err).Msg("Failed to create model")
+		if errors.Is(err, ErrSchemaChanged) {
+			r.log.Warn().Ctx(ctx).Interface("model", model).Err(err).Msg("Failed to create model")
+			return err
+		}
+		r.log.Error().Ctx(ctx).Interface("model", model).Err(err).Msg("Failed to create model")
 
 		return fmt.Errorf("failed to create model: %w", err)
 	}
+	*model = *created
 	return nil
 }
+
+// specsArg передает пустые характеристики объектом, а не JSON null
+func specsArg(specs dto.Specs) dto.Specs {
+	if specs == nil {
+		return dto.Specs{}
+	}
+	return specs
+}
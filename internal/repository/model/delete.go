@@ -1,6 +1,8 @@
 package model
 
 import (
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/brand"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
@@ -10,54 +12,102 @@ import (
 	"github.com/pkg/errors"
 )
 
-// SoftDelete мягко удаляет модель
+// SoftDelete мягко удаляет модель. Уже удаленная модель не удаляется
+// повторно: возвращается ErrModelSoftDeleted.
 func (r *ModelRepository) SoftDelete(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.SoftDelete")
 	defer span.Finish()
 
-	query := `UPDATE models SET is_deleted = true, updated_at = NOW() WHERE id = $1`
-	cmdTag, err := r.pool.Exec(ctx, query, id)
+	lock := `-- name: ModelRepository.LockForSoftDelete
+		SELECT * FROM models WHERE id = @id AND is_deleted = false FOR UPDATE`
+	query := `-- name: ModelRepository.SoftDelete
+		UPDATE models
+		SET is_deleted = true, deleted_with_brand = false, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	args := pgx.NamedArgs{"id": id, "actor": audit.ActorFromContext(ctx)}
+	_, err := r.mutateAudited(ctx, audit.OperationDelete, id, "", lock, query, args)
+	if errors.Is(err, ErrModelNotFound) {
+		err = r.stateConflict(ctx, id)
+	}
 	if err != nil {
 		span.LogFields(log.Error(err))
-		if errors.Is(err, pgx.ErrNoRows) {
-			r.log.Warn().Str("model_id", id.String()).Msg("Model not found")
-			return ErrModelNotFound
+		if errors.Is(err, ErrModelNotFound) || errors.Is(err, ErrModelSoftDeleted) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Model cannot be soft deleted")
+			return err
 		}
-		r.log.Error().Err(err).Str("model_id", id.String()).Msg("Failed to soft delete model")
-		return fmt.Errorf("failed to soft delete model with id %d: %w", id, err)
-	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrModelNotFound))
-		r.log.Warn().
-			Interface("model_id", id.String()).
-			Msg("No model found to update")
-		return ErrModelNotFound
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Failed to soft delete model")
+		return fmt.Errorf("failed to soft delete model with id %s: %w", id, err)
 	}
 	return nil
 }
 
-// Restore восстанавливает мягко удалённую модель
+// Restore восстанавливает мягко удалённую модель. Модель удаленного бренда
+// не восстанавливается: возвращается ErrBrandDeleted, неудаленная модель —
+// ErrModelNotInTrash.
 func (r *ModelRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.Restore")
 	defer span.Finish()
 
-	query := `UPDATE models SET is_deleted = false, updated_at = NOW() WHERE id = $1`
-	cmdTag, err := r.pool.Exec(ctx, query, id)
+	guard := `-- name: ModelRepository.GuardBrandForRestore
+		SELECT 1 FROM brands
+		WHERE id = (SELECT brand_id FROM models WHERE id = @id) AND is_deleted = false
+		FOR SHARE`
+	lock := `-- name: ModelRepository.LockForRestore
+		SELECT * FROM models WHERE id = @id AND is_deleted = true FOR UPDATE`
+	query := `-- name: ModelRepository.Restore
+		UPDATE models
+		SET is_deleted = false, deleted_with_brand = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	_, err := r.mutateAudited(ctx, audit.OperationRestore, id, guard, lock, query, pgx.NamedArgs{"id": id})
+	if errors.Is(err, brand.ErrBrandNotFound) {
+		// guard не различает отсутствие модели и удаленный бренд
+		err = r.restoreBlocked(ctx, id)
+	} else if errors.Is(err, ErrModelNotFound) {
+		err = r.stateConflict(ctx, id)
+	}
 	if err != nil {
 		span.LogFields(log.Error(err))
-		if errors.Is(err, pgx.ErrNoRows) {
-			r.log.Warn().Str("model_id", id.String()).Msg("Model not found")
-			return ErrModelNotFound
+		if errors.Is(err, ErrBrandDeleted) {
+			r.log.Warn().Ctx(ctx).Str("model_id", id.String()).Msg("Refused to restore model of deleted brand")
+			return ErrBrandDeleted
+		}
+		if errors.Is(err, ErrModelNotFound) || errors.Is(err, ErrModelNotInTrash) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Model cannot be restored")
+			return err
 		}
-		r.log.Error().Err(err).Str("model_id", id.String()).Msg("Failed to restore model")
-		return fmt.Errorf("failed to restore model with id %d: %w", id, err)
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Failed to restore model")
+		return fmt.Errorf("failed to restore model with id %s: %w", id, err)
+	}
+	return nil
+}
+
+// restoreBlocked объясняет отказ guard при восстановлении: ErrModelNotFound
+// или ErrModelNotInTrash, если удаленной модели нет, иначе ErrBrandDeleted
+func (r *ModelRepository) restoreBlocked(ctx context.Context, id uuid.UUID) error {
+	if err := r.stateConflict(ctx, id); !errors.Is(err, ErrModelSoftDeleted) {
+		return err
 	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrModelNotFound))
-		r.log.Warn().
-			Interface("model_id", id.String()).
-			Msg("No model found to update")
+	return ErrBrandDeleted
+}
+
+// stateConflict объясняет, почему удаление или восстановление не нашло
+// модель в нужном состоянии: ErrModelNotFound, если модели нет,
+// ErrModelSoftDeleted, если она уже удалена, иначе ErrModelNotInTrash
+func (r *ModelRepository) stateConflict(ctx context.Context, id uuid.UUID) error {
+	var deleted bool
+	query := `-- name: ModelRepository.GetDeletedState
+		SELECT is_deleted FROM models WHERE id = $1`
+	err := r.pool.QueryRow(ctx, query, id).Scan(&deleted)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
 		return ErrModelNotFound
+	case err != nil:
+		return fmt.Errorf("unable to check model (%s) state: %w", id, err)
+	case deleted:
+		return ErrModelSoftDeleted
+	default:
+		return ErrModelNotInTrash
 	}
-	return nil
 }
@@ -4,4 +4,12 @@ import "github.com/pkg/errors"
 
 var (
 	ErrModelNotFound = errors.New("model not found")
+	ErrBrandDeleted  = errors.New("brand of the model is deleted, restore the brand first")
+	// ErrModelSoftDeleted удаляемая модель уже удалена
+	ErrModelSoftDeleted = errors.New("model has been soft-deleted")
+	// ErrModelNotInTrash восстанавливаемая модель не удалена
+	ErrModelNotInTrash = errors.New("model is not deleted, nothing to restore")
+	// ErrSchemaChanged схема категории заменена или удалена после проверки
+	// характеристик; запрос можно повторить
+	ErrSchemaChanged = errors.New("specs schema of the category changed during the request, retry")
 )
@@ -2,6 +2,7 @@ package model
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/specs"
 	"context"
 	"fmt"
 	"github.com/jackc/pgx/v5"
@@ -15,6 +16,7 @@ func (r *ModelRepository) GetAll(ctx context.Context) ([]dto.Model, error) {
 	defer span.Finish()
 
 	query := `
+        -- name: ModelRepository.GetAll
         SELECT * 
         FROM models 
         WHERE is_deleted = false
@@ -23,7 +25,7 @@ func (r *ModelRepository) GetAll(ctx context.Context) ([]dto.Model, error) {
 	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Msg("Failed to execute GetAll query")
 		return nil, fmt.Errorf("error executing query: %w", err)
@@ -37,7 +39,7 @@ func (r *ModelRepository) GetAll(ctx context.Context) ([]dto.Model, error) {
 			log.Error(err),
 			log.String("event", "collect_rows_error"),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "GetAll").
 			Msg("Failed to collect rows into models")
@@ -59,6 +61,7 @@ func (r *ModelRepository) ModelsFilter(
 	argCounter := 1
 
 	queryBuilder.WriteString(`
+        -- name: ModelRepository.ModelsFilter
         SELECT * 
         FROM models 
         WHERE is_deleted = false
@@ -78,6 +81,31 @@ func (r *ModelRepository) ModelsFilter(
 		case "is_limited":
 			queryBuilder.WriteString(fmt.Sprintf(" AND is_limited = $%d", argCounter))
 			args = append(args, value.(bool))
+		case "generation_years":
+			// Оба условия проверяются на одном поколении: модель подходит, если
+			// одно ее неудаленное поколение выпускалось во всем диапазоне лет
+			years := value.(dto.YearRange)
+			queryBuilder.WriteString(fmt.Sprintf(`
+				AND EXISTS (
+					SELECT 1 FROM model_generations g
+					WHERE g.model_id = models.id AND g.is_deleted = false
+						AND ($%[1]d::int = 0 OR COALESCE(g.end_year, 9999) >= $%[1]d::int)
+						AND ($%[2]d::int = 0 OR g.start_year <= $%[2]d::int)
+				)`, argCounter, argCounter+1))
+			args = append(args, years.From, years.To)
+			argCounter++
+		case "category":
+			queryBuilder.WriteString(fmt.Sprintf(" AND category = $%d", argCounter))
+			args = append(args, value.(string))
+		case "specs":
+			// Каждое условие — отдельный jsonpath: GIN-индекс по specs отбирает
+			// модели с характеристикой, а равенство проверяет по индексу целиком
+			for _, condition := range value.([]specs.Condition) {
+				queryBuilder.WriteString(fmt.Sprintf(" AND specs @? $%d::jsonpath", argCounter))
+				args = append(args, condition.JSONPath())
+				argCounter++
+			}
+			continue
 		}
 		argCounter++
 	}
@@ -100,7 +128,7 @@ func (r *ModelRepository) ModelsFilter(
 			log.Error(err),
 			log.String("query", query),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "ModelsFilter").
 			Msg("Failed to execute ModelsFilter query")
@@ -115,7 +143,7 @@ func (r *ModelRepository) ModelsFilter(
 			log.Error(err),
 			log.String("event", "collect_rows_error"),
 		)
-		r.log.Error().
+		r.log.Error().Ctx(ctx).
 			Err(err).
 			Str("operation", "ModelsFilter").
 			Msg("Failed to collect rows into models")
@@ -19,6 +19,7 @@ func (r *ModelRepository) GetByID(
 	defer span.Finish()
 
 	query := `
+		-- name: ModelRepository.GetByID
 		SELECT *
 		FROM models 
 		WHERE id = $1 AND is_deleted = false
@@ -26,7 +27,7 @@ func (r *ModelRepository) GetByID(
 	row, err := r.pool.Query(ctx, query, id)
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().Err(err).Str("model_id", id.String()).Msg("Failed to fetch model by ID")
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Failed to fetch model by ID")
 		return nil, fmt.Errorf("unable to get model by id: %w", err)
 	}
 
@@ -34,19 +35,19 @@ func (r *ModelRepository) GetByID(
 	models, err = pgx.CollectRows(row, pgx.RowToStructByName[dto.Model])
 	if err != nil {
 		span.LogFields(log.Error(err))
-		r.log.Error().Err(err).Str("model_id", id.String()).Msg("Failed to collect rows")
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Failed to collect rows")
 		return nil, fmt.Errorf("unable to collect rows: %w", err)
 	}
 
 	if len(models) == 0 {
-		r.log.Warn().Str("brand_id", id.String()).Msg("Brand not found")
+		r.log.Warn().Ctx(ctx).Str("brand_id", id.String()).Msg("Brand not found")
 		return nil, ErrModelNotFound
 	}
 
 	if len(models) > 1 {
 		err = fmt.Errorf("multiple brands found with id %s", id.String())
 		span.LogFields(log.Error(err))
-		r.log.Error().Str("brand_id", id.String()).Msg("Multiple brands found with the same ID")
+		r.log.Error().Ctx(ctx).Str("brand_id", id.String()).Msg("Multiple brands found with the same ID")
 		return nil, err
 	}
 
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/brand"
+	"Brands/internal/repository/outbox"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"time"
+)
+
+// PurgeExpired безвозвратно удаляет до limit моделей, находящихся в корзине
+// дольше olderThan, вместе с их поколениями, комплектациями, версиями и
+// историей слагов. Журнал аудита
+// сохраняется: в него и в outbox пишутся события purge со снимками
+// удаленных моделей. Модели, заблокированные другими транзакциями,
+// пропускаются до следующего запуска. Возвращает число удаленных моделей.
+func (r *ModelRepository) PurgeExpired(ctx context.Context, olderThan time.Duration, limit int) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.PurgeExpired")
+	defer span.Finish()
+
+	query := `-- name: ModelRepository.LockExpired
+		SELECT * FROM models
+		WHERE is_deleted = true AND deleted_at < NOW() - @older_than::interval
+		ORDER BY deleted_at
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED`
+	purge := `-- name: ModelRepository.PurgeExpired
+		DELETE FROM models WHERE id = ANY($1)`
+	cleanup := `-- name: ModelRepository.PurgeHistory
+		WITH revisions_deleted AS (
+			DELETE FROM model_revisions WHERE model_id = ANY($1)
+		)
+		DELETE FROM model_slug_history WHERE model_id = ANY($1)`
+
+	var purged int
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, pgx.NamedArgs{"older_than": olderThan, "limit": limit})
+		if err != nil {
+			return err
+		}
+		models, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.Model])
+		if err != nil || len(models) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(models))
+		for _, model := range models {
+			ids = append(ids, model.ID)
+			if err = audit.Record(ctx, tx, audit.OperationPurge, audit.EntityModel, model.ID, model, nil); err != nil {
+				return err
+			}
+		}
+		if err = brand.PurgeVariants(ctx, tx, models); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, purge, ids); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, cleanup, ids); err != nil {
+			return err
+		}
+		for _, model := range models {
+			if err = outbox.Record(ctx, tx, audit.EntityModel, audit.OperationPurge, model.ID, model); err != nil {
+				return err
+			}
+		}
+		purged = len(models)
+		return nil
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to purge expired models")
+		return 0, fmt.Errorf("unable to purge expired models: %w", err)
+	}
+	span.SetTag("models.purged", purged)
+	return purged, nil
+}
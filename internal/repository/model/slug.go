@@ -0,0 +1,156 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// slugConstraint частичный уникальный индекс слага модели в пределах бренда
+const slugConstraint = "uq_models_brand_slug"
+
+// modelWithBrandSlug модель вместе с актуальным слагом ее бренда
+type modelWithBrandSlug struct {
+	dto.Model
+	BrandSlug string `db:"brand_slug"`
+}
+
+// GetBySlug получает неудаленную модель по слагу бренда и слагу модели.
+// Оба слага могут быть прежними, а вместо слага бренда может быть передан
+// слаг его альтернативного названия: модель возвращается вместе с актуальным
+// слагом бренда, что позволяет вызывающему перенаправить клиента. Прежний
+// слаг бренда, слитого с найденным, сначала ищется в истории слагов моделей
+// слитого бренда: модель, переименованная при слиянии, важнее одноименной
+// модели цели.
+func (r *ModelRepository) GetBySlug(ctx context.Context, brandSlug, modelSlug string) (*dto.Model, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.GetBySlug")
+	defer span.Finish()
+
+	query := `
+		-- name: ModelRepository.GetBySlug
+		WITH brand AS (
+			SELECT b.id, found.rank
+			FROM brands b
+			JOIN (
+				SELECT id, 0 AS rank FROM brands WHERE slug = @brand_slug AND is_deleted = false
+				UNION ALL
+				SELECT brand_id, 1 FROM brand_slug_history WHERE slug = @brand_slug
+				UNION ALL
+				SELECT brand_id, 2 FROM brand_aliases WHERE slug = @brand_slug
+			) found ON found.id = b.id
+			WHERE b.is_deleted = false
+			ORDER BY found.rank
+			LIMIT 1
+		), merged AS (
+			SELECT s.id
+			FROM brands s
+			JOIN brand ON brand.id = s.merged_into
+			WHERE s.slug = @brand_slug AND brand.rank > 0
+		), matched AS (
+			SELECT h.model_id AS id, 0 AS rank
+			FROM model_slug_history h
+			JOIN merged ON merged.id = h.brand_id
+			WHERE h.slug = @model_slug
+			UNION ALL
+			SELECT m.id, 1
+			FROM models m
+			JOIN brand ON brand.id = m.brand_id
+			WHERE m.slug = @model_slug AND m.is_deleted = false
+			UNION ALL
+			SELECT h.model_id, 2
+			FROM model_slug_history h
+			JOIN brand ON brand.id = h.brand_id
+			WHERE h.slug = @model_slug
+		)
+		SELECT m.*, b.slug AS brand_slug
+		FROM models m
+		JOIN matched ON matched.id = m.id
+		JOIN brands b ON b.id = m.brand_id
+		WHERE m.is_deleted = false AND b.is_deleted = false
+		ORDER BY matched.rank
+		LIMIT 1
+	`
+	args := pgx.NamedArgs{"brand_slug": brandSlug, "model_slug": modelSlug}
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Interface("slugs", args).Msg("Failed to fetch model by slug")
+		return nil, "", fmt.Errorf("unable to get model by slug: %w", err)
+	}
+	found, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[modelWithBrandSlug])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.log.Warn().Ctx(ctx).Interface("slugs", args).Msg("Model not found by slug")
+			return nil, "", ErrModelNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Interface("slugs", args).Msg("Failed to collect rows")
+		return nil, "", fmt.Errorf("unable to collect rows: %w", err)
+	}
+	return &found.Model, found.BrandSlug, nil
+}
+
+// recordSlugChange сохраняет прежний слаг модели в пределах прежнего бренда
+// для перенаправлений. Запись истории с новым адресом удаляется: актуальный
+// слаг важнее.
+func recordSlugChange(ctx context.Context, tx pgx.Tx, before, after *dto.Model) error {
+	if before == nil || after == nil || (before.Slug == after.Slug && before.BrandID == after.BrandID) {
+		return nil
+	}
+	query := `
+		-- name: ModelRepository.RecordSlugChange
+		WITH forgotten AS (
+			DELETE FROM model_slug_history WHERE brand_id = @new_brand_id AND slug = @new_slug
+		)
+		INSERT INTO model_slug_history (brand_id, slug, model_id, replaced_at)
+		VALUES (@old_brand_id, @old_slug, @model_id, NOW())
+		ON CONFLICT (brand_id, slug) DO UPDATE
+		SET model_id = EXCLUDED.model_id, replaced_at = EXCLUDED.replaced_at
+	`
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"old_brand_id": before.BrandID,
+		"old_slug":     before.Slug,
+		"new_brand_id": after.BrandID,
+		"new_slug":     after.Slug,
+		"model_id":     after.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to record model slug change")
+	}
+	return nil
+}
+
+// slugConflict находит модель, занявшую слаг в пределах бренда, после
+// нарушения уникальности. Пустой value означает слаг и бренд самой записи
+// id (восстановление).
+func (r *ModelRepository) slugConflict(ctx context.Context, id uuid.UUID, value string, brandID *uuid.UUID, cause error) error {
+	query := `
+		-- name: ModelRepository.SlugConflict
+		SELECT *
+		FROM models
+		WHERE is_deleted = false
+		  AND id <> @id
+		  AND brand_id = COALESCE(@brand_id::uuid, (SELECT brand_id FROM models WHERE id = @id))
+		  AND slug = COALESCE(NULLIF(@slug, ''), (SELECT slug FROM models WHERE id = @id))
+		LIMIT 1
+	`
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"id": id, "slug": value, "brand_id": brandID})
+	clash, err := collectModel(rows, err)
+	if err != nil {
+		// Конфликтующая запись успела исчезнуть; сообщаем исходную ошибку
+		r.log.Warn().Ctx(ctx).Err(err).Str("model_id", id.String()).Msg("Failed to find clashing model")
+		return cause
+	}
+	return &slug.ConflictError{
+		Entity: "model",
+		ID:     clash.ID,
+		Name:   clash.Name,
+		Slug:   clash.Slug,
+	}
+}
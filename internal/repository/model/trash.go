@@ -0,0 +1,80 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetTrash получает страницу мягко удаленных моделей, от недавно удаленных к давним
+func (r *ModelRepository) GetTrash(ctx context.Context, filter dto.TrashFilter) (*dto.ModelTrashPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.GetTrash")
+	defer span.Finish()
+
+	where := "WHERE is_deleted = true"
+	args := pgx.NamedArgs{
+		"limit":  filter.Limit,
+		"offset": filter.Offset,
+	}
+	if filter.BrandID != nil {
+		where += " AND brand_id = @brand_id"
+		args["brand_id"] = *filter.BrandID
+	}
+
+	query := fmt.Sprintf(`
+		-- name: ModelRepository.GetTrash
+		SELECT *, COUNT(*) OVER () AS total
+		FROM models
+		%s
+		ORDER BY deleted_at DESC NULLS LAST, id DESC
+		LIMIT @limit OFFSET @offset
+	`, where)
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to execute model trash query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	type trashRow struct {
+		dto.Model
+		Total int64
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[trashRow])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetTrash").Msg("Failed to collect rows into models")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+
+	page := &dto.ModelTrashPage{
+		Models: make([]dto.Model, 0, len(collected)),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, row := range collected {
+		page.Models = append(page.Models, row.Model)
+		page.Total = row.Total
+	}
+	if len(collected) == 0 && filter.Offset > 0 {
+		// За пределами последней страницы оконная функция ничего не вернет
+		countQuery := fmt.Sprintf(`-- name: ModelRepository.CountTrash
+			SELECT COUNT(*) FROM models %s`, where)
+		delete(args, "limit")
+		delete(args, "offset")
+		if err = r.pool.QueryRow(ctx, countQuery, args).Scan(&page.Total); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Msg("Failed to count deleted models")
+			return nil, fmt.Errorf("error counting deleted models: %w", err)
+		}
+	}
+	return page, nil
+}
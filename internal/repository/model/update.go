@@ -2,68 +2,78 @@ package model
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
 	"Brands/internal/repository/brand"
 	"context"
 	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
 )
 
-// Update обновляет данные модели
-func (r *ModelRepository) Update(ctx context.Context, model *dto.Model) error {
+// Update обновляет данные модели. schemaVersion — версия схемы категории,
+// по которой проверены характеристики; 0 для модели без категории.
+func (r *ModelRepository) Update(ctx context.Context, model *dto.Model, schemaVersion int) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelRepository.Update")
 	defer span.Finish()
 
-	brandExists, err := r.brandExists(ctx, model.BrandID)
-	if err != nil {
-		span.LogFields(log.Error(err))
-		r.log.Warn().Interface("model", model).Msgf("Failed to check if brand brandExists: %s", err.Error())
-		return err
-	}
-
-	if !brandExists {
-		err = fmt.Errorf(
-			"brand with ID %d does not exist: %w",
-			model.BrandID,
-			brand.ErrBrandNotFound,
-		)
-		span.LogFields(log.Error(err))
-		r.log.Warn().Interface("model", model).Msg(err.Error())
-		return err
-	}
-
+	lock := `
+		-- name: ModelRepository.LockForUpdate
+		SELECT * FROM models WHERE id = @id AND is_deleted = false FOR UPDATE
+	`
 	query := `
+		-- name: ModelRepository.Update
 		UPDATE models 
 		SET brand_id = @brand_id, 
 		    name = @name, 
+		    slug = @slug, 
 		    release_date = @release_date, 
 		    is_upcoming = @is_upcoming, 
 		    is_limited = @is_limited, 
+		    category = @category, 
+		    specs = @specs, 
 		    updated_at = NOW()
 		WHERE id = @id AND is_deleted = false
+		RETURNING *
 	`
 
 	args := pgx.NamedArgs{
-		"id":           model.ID,
-		"brand_id":     model.BrandID,
-		"name":         model.Name,
-		"release_date": model.ReleaseDate,
-		"is_upcoming":  model.IsUpcoming,
-		"is_limited":   model.IsLimited,
+		"id":             model.ID,
+		"brand_id":       model.BrandID,
+		"name":           model.Name,
+		"slug":           model.Slug,
+		"release_date":   model.ReleaseDate,
+		"is_upcoming":    model.IsUpcoming,
+		"is_limited":     model.IsLimited,
+		"category":       model.Category,
+		"specs":          specsArg(model.Specs),
+		"schema_version": schemaVersion,
 	}
-	cmdTag, err := r.pool.Exec(ctx, query, args)
+	updated, err := r.mutateAudited(ctx, audit.OperationUpdate, model.ID, guardBrand, lock, query, args)
 	if err != nil {
+		if errors.Is(err, brand.ErrBrandNotFound) {
+			err = fmt.Errorf("brand with ID %s does not exist: %w", model.BrandID, brand.ErrBrandNotFound)
+			span.LogFields(log.Error(err))
+			r.log.Warn().Ctx(ctx).Interface("model", model).Msg(err.Error())
+			return err
+		}
+		if errors.Is(err, ErrSchemaChanged) {
+			span.LogFields(log.Error(err))
+			r.log.Warn().Ctx(ctx).Err(err).Interface("model", model).Msg("Failed to update model")
+			return err
+		}
+		if errors.Is(err, ErrModelNotFound) {
+			span.LogFields(log.Error(ErrModelNotFound))
+			r.log.Warn().Ctx(ctx).
+				Interface("model", model).
+				Msg("No model found to update")
+			return ErrModelNotFound
+		}
 		span.LogFields(log.Error(err))
-		r.log.Error().Err(err).Interface("model", model).Msg("Failed to update model")
+		r.log.Error().Ctx(ctx).Err(err).Interface("model", model).Msg("Failed to update model")
 		return err
 	}
-	if cmdTag.RowsAffected() == 0 {
-		span.LogFields(log.Error(ErrModelNotFound))
-		r.log.Warn().
-			Interface("model", model).
-			Msg("No model found to update")
-		return ErrModelNotFound
-	}
+	*model = *updated
 	return nil
 }
@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetSince получает до limit событий с sequence больше after в порядке
+// sequence, независимо от того, опубликованы ли они релеем
+func (r *OutboxRepository) GetSince(ctx context.Context, after int64, limit int) ([]dto.OutboxEvent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OutboxRepository.GetSince")
+	defer span.Finish()
+
+	query := `
+		-- name: OutboxRepository.GetSince
+		SELECT *
+		FROM outbox_events
+		WHERE sequence > $1
+		ORDER BY sequence
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, after, limit)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Int64("after", after).Msg("Failed to execute outbox GetSince query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	events, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.OutboxEvent])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetSince").Msg("Failed to collect rows into outbox events")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+	return events, nil
+}
+
+// LastSequence возвращает номер последнего зафиксированного события или 0
+func (r *OutboxRepository) LastSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	query := `-- name: OutboxRepository.LastSequence
+		SELECT COALESCE(MAX(sequence), 0) FROM outbox_events`
+	if err := r.pool.QueryRow(ctx, query).Scan(&sequence); err != nil {
+		return 0, fmt.Errorf("unable to get last outbox sequence: %w", err)
+	}
+	return sequence, nil
+}
@@ -0,0 +1,191 @@
+package outbox
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"time"
+)
+
+// maxErrorLength ограничивает размер сохраняемой ошибки публикации
+const maxErrorLength = 1024
+
+// PublishFunc публикует события по порядку и возвращает число успешно
+// опубликованных с начала списка; при ошибке остальные события не публикуются
+type PublishFunc func(ctx context.Context, events []dto.OutboxEvent) (int, error)
+
+// ProcessBatch публикует до limit неопубликованных событий в порядке
+// sequence в три шага. Короткая транзакция захватывает пачку арендой на
+// lease; publish вызывается вне транзакции, поэтому медленный получатель не
+// держит блокировки строк; затем успешно отправленные события отмечаются
+// опубликованными, а аренда остальных снимается. Пачка захватывается только
+// с начала очереди и только если ее начало не арендовано другим релеем:
+// так параллельные релеи не нарушают порядок публикации. Публикация
+// ограничена сроком аренды; событие с истекшей арендой может быть
+// опубликовано повторно, доставка остается не менее чем однократной.
+func (r *OutboxRepository) ProcessBatch(ctx context.Context, limit int, lease time.Duration, publish PublishFunc) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OutboxRepository.ProcessBatch")
+	defer span.Finish()
+
+	events, claimedUntil, err := r.claim(ctx, limit, lease)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to claim outbox batch")
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, lease)
+	published, publishErr := publish(publishCtx, events)
+	cancel()
+
+	if err = r.finish(ctx, events, published, publishErr, claimedUntil); err != nil {
+		span.SetTag("error", true)
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to record outbox batch result")
+		return 0, err
+	}
+	span.SetTag("outbox.published", published)
+	return published, publishErr
+}
+
+// claim арендует начало очереди неопубликованных событий. Блокировка без
+// SKIP LOCKED сериализует захват параллельными релеями; если первое событие
+// еще арендовано, захватывать нечего.
+func (r *OutboxRepository) claim(ctx context.Context, limit int, lease time.Duration) ([]dto.OutboxEvent, time.Time, error) {
+	lock := `
+		-- name: OutboxRepository.LockPending
+		SELECT *
+		FROM outbox_events
+		WHERE published_at IS NULL
+		ORDER BY sequence
+		LIMIT $1
+		FOR UPDATE
+	`
+	claim := `
+		-- name: OutboxRepository.Claim
+		UPDATE outbox_events SET claimed_until = NOW() + $2::interval
+		WHERE sequence = ANY($1)
+		RETURNING claimed_until
+	`
+	var events []dto.OutboxEvent
+	var claimedUntil time.Time
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lock, limit)
+		if err != nil {
+			return fmt.Errorf("unable to lock outbox events: %w", err)
+		}
+		events, err = pgx.CollectRows(rows, pgx.RowToStructByName[dto.OutboxEvent])
+		if err != nil {
+			return fmt.Errorf("unable to collect outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		var now time.Time
+		if err = tx.QueryRow(ctx, `-- name: OutboxRepository.Now
+			SELECT NOW()::timestamp`).Scan(&now); err != nil {
+			return fmt.Errorf("unable to read database time: %w", err)
+		}
+		if first := events[0].ClaimedUntil; first != nil && first.After(now) {
+			events = nil
+			return nil
+		}
+		// Арендуется только непрерывное начало очереди до первого события,
+		// арендованного другим релеем
+		sequences := make([]int64, 0, len(events))
+		for i, event := range events {
+			if event.ClaimedUntil != nil && event.ClaimedUntil.After(now) {
+				events = events[:i]
+				break
+			}
+			sequences = append(sequences, event.Sequence)
+		}
+		rows, err = tx.Query(ctx, claim, sequences, lease)
+		if err != nil {
+			return fmt.Errorf("unable to claim outbox events: %w", err)
+		}
+		claimed, err := pgx.CollectRows(rows, pgx.RowTo[time.Time])
+		if err != nil {
+			return fmt.Errorf("unable to claim outbox events: %w", err)
+		}
+		claimedUntil = claimed[0]
+		return nil
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return events, claimedUntil, nil
+}
+
+// finish отмечает опубликованными первые published событий пачки и снимает
+// аренду с остальных, записывая ошибку публикации первого из них. Изменяются
+// только события, аренда которых не перехвачена: результат релея, чья
+// аренда истекла, не перезаписывает результат нового.
+func (r *OutboxRepository) finish(
+	ctx context.Context,
+	events []dto.OutboxEvent,
+	published int,
+	publishErr error,
+	claimedUntil time.Time,
+) error {
+	markPublished := `
+		-- name: OutboxRepository.MarkPublished
+		UPDATE outbox_events SET published_at = NOW(), claimed_until = NULL
+		WHERE sequence = ANY($1) AND claimed_until = $2
+	`
+	release := `
+		-- name: OutboxRepository.Release
+		UPDATE outbox_events SET claimed_until = NULL
+		WHERE sequence = ANY($1) AND claimed_until = $2
+	`
+	markFailed := `
+		-- name: OutboxRepository.MarkFailed
+		UPDATE outbox_events SET attempts = attempts + 1, last_error = $2
+		WHERE sequence = $1
+	`
+	sequences := make([]int64, 0, len(events))
+	for _, event := range events {
+		sequences = append(sequences, event.Sequence)
+	}
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if published > 0 {
+			if _, err := tx.Exec(ctx, markPublished, sequences[:published], claimedUntil); err != nil {
+				return fmt.Errorf("unable to mark outbox events published: %w", err)
+			}
+		}
+		if published == len(events) {
+			return nil
+		}
+		if _, err := tx.Exec(ctx, release, sequences[published:], claimedUntil); err != nil {
+			return fmt.Errorf("unable to release outbox events: %w", err)
+		}
+		if publishErr != nil {
+			message := publishErr.Error()
+			if len(message) > maxErrorLength {
+				message = message[:maxErrorLength]
+			}
+			if _, err := tx.Exec(ctx, markFailed, sequences[published], message); err != nil {
+				return fmt.Errorf("unable to record outbox failure: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Pending возвращает число неопубликованных событий
+func (r *OutboxRepository) Pending(ctx context.Context) (int64, error) {
+	var count int64
+	query := `-- name: OutboxRepository.Pending
+		SELECT COUNT(*) FROM outbox_events WHERE published_at IS NULL`
+	if err := r.pool.QueryRow(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("unable to count pending outbox events: %w", err)
+	}
+	return count, nil
+}
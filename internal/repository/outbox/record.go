@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"Brands/internal/repository/audit"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// eventSuffixes переводит операцию аудита в суффикс типа доменного события
+var eventSuffixes = map[string]string{
+	audit.OperationCreate:  "created",
+	audit.OperationUpdate:  "updated",
+	audit.OperationDelete:  "deleted",
+	audit.OperationRestore: "restored",
+	audit.OperationPurge:   "purged",
+	audit.OperationMerge:   "merged",
+}
+
+// EventType возвращает тип доменного события, например brand.created
+func EventType(aggregateType, operation string) string {
+	suffix, ok := eventSuffixes[operation]
+	if !ok {
+		suffix = operation
+	}
+	return aggregateType + "." + suffix
+}
+
+// KnownEventType сообщает, может ли outbox породить событие такого типа
+func KnownEventType(eventType string) bool {
+	aggregateTypes := []string{
+		audit.EntityBrand,
+		audit.EntityModel,
+		audit.EntityBrandTranslation,
+		audit.EntityModelTranslation,
+		audit.EntityModelGeneration,
+		audit.EntityModelTrim,
+	}
+	for _, aggregateType := range aggregateTypes {
+		for operation := range eventSuffixes {
+			if EventType(aggregateType, operation) == eventType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sequenceLockKey ключ advisory-блокировки, сериализующей выдачу sequence
+const sequenceLockKey int64 = 0x6f7574626f78 // "outbox"
+
+// deferredTx транзакция BeginFunc: Record копит в ней доменные события, а
+// BeginFunc пишет их в outbox после всех остальных шагов изменения
+type deferredTx struct {
+	pgx.Tx
+	events []pgx.NamedArgs
+}
+
+// BeginFunc выполняет fn в транзакции, как pgx.BeginFunc, но доменные
+// события, записанные Record внутри fn, вставляются в outbox одним шагом
+// после fn, последним действием перед COMMIT. Так блокировка sequence
+// берется после всех блокировок строк и держится только на время вставки и
+// фиксации. Если fn вернула ошибку (в том числе для отката предпросмотра),
+// события не пишутся и блокировка не берется.
+func BeginFunc(
+	ctx context.Context,
+	db interface {
+		Begin(ctx context.Context) (pgx.Tx, error)
+	},
+	fn func(tx pgx.Tx) error,
+) error {
+	return pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		deferred := &deferredTx{Tx: tx}
+		if err := fn(deferred); err != nil {
+			return err
+		}
+		return write(ctx, tx, deferred.events)
+	})
+}
+
+// Record пишет доменное событие в outbox в транзакции изменения записи;
+// релей опубликует его только после фиксации транзакции. Снимок payload
+// кодируется сразу. В транзакции BeginFunc событие откладывается до конца
+// транзакции, в остальных — вставляется немедленно.
+func Record(
+	ctx context.Context,
+	tx pgx.Tx,
+	aggregateType, operation string,
+	aggregateID uuid.UUID,
+	payload any,
+) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("unable to generate outbox event id: %w", err)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("unable to encode outbox payload: %w", err)
+	}
+	event := pgx.NamedArgs{
+		"id":             id,
+		"event_type":     EventType(aggregateType, operation),
+		"aggregate_type": aggregateType,
+		"aggregate_id":   aggregateID,
+		"actor":          audit.ActorFromContext(ctx),
+		"request_id":     audit.RequestIDFromContext(ctx),
+		"payload":        data,
+	}
+	if deferred, ok := tx.(*deferredTx); ok {
+		deferred.events = append(deferred.events, event)
+		return nil
+	}
+	return write(ctx, tx, []pgx.NamedArgs{event})
+}
+
+// write вставляет события в outbox под транзакционной advisory-блокировкой,
+// которая держится до фиксации: транзакции получают sequence в порядке
+// фиксации, и событие с меньшим номером не может стать видимым после события
+// с большим. На этом держится возобновление ленты изменений по Last-Event-ID.
+// Поэтому запись в outbox должна быть последним шагом изменения.
+func write(ctx context.Context, tx pgx.Tx, events []pgx.NamedArgs) error {
+	if len(events) == 0 {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "outbox.Record")
+	defer span.Finish()
+	span.SetTag("events", len(events))
+
+	lock := `-- name: outbox.LockSequence
+		SELECT pg_advisory_xact_lock($1)`
+	if _, err := tx.Exec(ctx, lock, sequenceLockKey); err != nil {
+		span.LogFields(log.Error(err))
+		return fmt.Errorf("unable to lock outbox sequence: %w", err)
+	}
+
+	query := `
+		-- name: outbox.Record
+		INSERT INTO outbox_events (id, event_type, aggregate_type, aggregate_id, actor, request_id, payload, occurred_at)
+		VALUES (@id, @event_type, @aggregate_type, @aggregate_id, @actor, @request_id, @payload, NOW())
+	`
+	for _, event := range events {
+		if _, err := tx.Exec(ctx, query, event); err != nil {
+			span.LogFields(log.Error(err))
+			return fmt.Errorf("unable to write outbox event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package outbox
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type OutboxRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*OutboxRepository, error) {
+	return &OutboxRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,7 @@
+package revision
+
+import "github.com/pkg/errors"
+
+var (
+	ErrRevisionNotFound = errors.New("revision not found")
+)
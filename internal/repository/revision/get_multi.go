@@ -0,0 +1,59 @@
+package revision
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetAll получает версии записи от новых к старым
+func (r *RevisionRepository) GetAll(
+	ctx context.Context,
+	entityType string,
+	entityID uuid.UUID,
+	limit, offset int,
+) ([]dto.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RevisionRepository.GetAll")
+	defer span.Finish()
+
+	table, err := tableFor(entityType)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		-- name: RevisionRepository.GetAll
+		SELECT %[2]s AS entity_id, revision, operation, actor, request_id, valid_from, data
+		FROM %[1]s
+		WHERE %[2]s = @entity_id
+		ORDER BY revision DESC
+		LIMIT @limit OFFSET @offset
+	`, table.name, table.idColumn)
+	args := pgx.NamedArgs{
+		"entity_id": entityID,
+		"limit":     limit,
+		"offset":    offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("entity_id", entityID.String()).Msg("Failed to fetch revisions")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	revisions, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Revision])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetAll").Msg("Failed to collect rows into revisions")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+	return revisions, nil
+}
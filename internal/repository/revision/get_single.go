@@ -0,0 +1,86 @@
+package revision
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// GetByNumber получает версию записи по номеру
+func (r *RevisionRepository) GetByNumber(
+	ctx context.Context,
+	entityType string,
+	entityID uuid.UUID,
+	revision int,
+) (*dto.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RevisionRepository.GetByNumber")
+	defer span.Finish()
+
+	table, err := tableFor(entityType)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		-- name: RevisionRepository.GetByNumber
+		SELECT %[2]s AS entity_id, revision, operation, actor, request_id, valid_from, data
+		FROM %[1]s
+		WHERE %[2]s = @entity_id AND revision = @revision
+	`, table.name, table.idColumn)
+	return r.getOne(ctx, span, query, pgx.NamedArgs{"entity_id": entityID, "revision": revision})
+}
+
+// GetAsOf получает версию записи, действовавшую в момент asOf
+func (r *RevisionRepository) GetAsOf(
+	ctx context.Context,
+	entityType string,
+	entityID uuid.UUID,
+	asOf time.Time,
+) (*dto.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RevisionRepository.GetAsOf")
+	defer span.Finish()
+
+	table, err := tableFor(entityType)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		-- name: RevisionRepository.GetAsOf
+		SELECT %[2]s AS entity_id, revision, operation, actor, request_id, valid_from, data
+		FROM %[1]s
+		WHERE %[2]s = @entity_id AND valid_from <= @as_of
+		ORDER BY revision DESC
+		LIMIT 1
+	`, table.name, table.idColumn)
+	return r.getOne(ctx, span, query, pgx.NamedArgs{"entity_id": entityID, "as_of": asOf})
+}
+
+func (r *RevisionRepository) getOne(
+	ctx context.Context,
+	span opentracing.Span,
+	query string,
+	args pgx.NamedArgs,
+) (*dto.Revision, error) {
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch revision")
+		return nil, fmt.Errorf("unable to get revision: %w", err)
+	}
+
+	revision, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.Revision])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRevisionNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect revision row")
+		return nil, fmt.Errorf("unable to collect rows: %w", err)
+	}
+	return revision, nil
+}
@@ -0,0 +1,56 @@
+package revision
+
+import (
+	"Brands/internal/repository/audit"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Record сохраняет новую версию записи в транзакции ее изменения.
+// Запись должна быть заблокирована в транзакции (или только что создана),
+// иначе параллельные изменения получат одинаковый номер версии.
+func Record(
+	ctx context.Context,
+	tx pgx.Tx,
+	entityType string,
+	entityID uuid.UUID,
+	operation string,
+	snapshot any,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "revision.Record")
+	defer span.Finish()
+
+	table, err := tableFor(entityType)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("unable to encode revision: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		-- name: revision.Record
+		INSERT INTO %[1]s (%[2]s, revision, operation, actor, request_id, valid_from, data)
+		SELECT @entity_id, COALESCE(MAX(revision), 0) + 1, @operation, @actor, @request_id, NOW(), @data
+		FROM %[1]s
+		WHERE %[2]s = @entity_id
+	`, table.name, table.idColumn)
+	args := pgx.NamedArgs{
+		"entity_id":  entityID,
+		"operation":  operation,
+		"actor":      audit.ActorFromContext(ctx),
+		"request_id": audit.RequestIDFromContext(ctx),
+		"data":       data,
+	}
+	if _, err = tx.Exec(ctx, query, args); err != nil {
+		span.LogFields(log.Error(err))
+		return fmt.Errorf("unable to write revision: %w", err)
+	}
+	return nil
+}
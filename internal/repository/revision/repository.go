@@ -0,0 +1,43 @@
+package revision
+
+import (
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// RevisionRepository читает версии брендов и моделей
+type RevisionRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*RevisionRepository, error) {
+	return &RevisionRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
+
+// historyTable таблица версий сущности и колонка с ID записи
+type historyTable struct {
+	name     string
+	idColumn string
+}
+
+var tables = map[string]historyTable{
+	audit.EntityBrand: {name: "brand_revisions", idColumn: "brand_id"},
+	audit.EntityModel: {name: "model_revisions", idColumn: "model_id"},
+}
+
+func tableFor(entityType string) (historyTable, error) {
+	table, ok := tables[entityType]
+	if !ok {
+		return historyTable{}, fmt.Errorf("no revision history for entity type %q", entityType)
+	}
+	return table, nil
+}
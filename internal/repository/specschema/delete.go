@@ -0,0 +1,45 @@
+package specschema
+
+import (
+	"Brands/internal/pg"
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// categoryConstraint внешний ключ категории модели
+const categoryConstraint = "fk_models_category"
+
+// Delete удаляет схему характеристик категории, у которой нет моделей
+func (r *SpecSchemaRepository) Delete(ctx context.Context, category string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaRepository.Delete")
+	defer span.Finish()
+
+	query := `-- name: SpecSchemaRepository.Delete
+		DELETE FROM spec_schemas WHERE category = @category RETURNING *`
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, pgx.NamedArgs{"category": category})
+		before, err := collectSchema(rows, err)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx, audit.OperationDelete, audit.EntitySpecSchema, before.ID, before, nil)
+	})
+	if pg.IsForeignKeyViolation(err, categoryConstraint) {
+		err = ErrSchemaInUse
+	}
+	if err != nil {
+		span.LogFields(log.Error(err))
+		if errors.Is(err, ErrSchemaNotFound) || errors.Is(err, ErrSchemaInUse) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("category", category).Msg("Failed to delete specs schema")
+			return err
+		}
+		r.log.Error().Ctx(ctx).Err(err).Str("category", category).Msg("Failed to delete specs schema")
+		return fmt.Errorf("unable to delete specs schema: %w", err)
+	}
+	return nil
+}
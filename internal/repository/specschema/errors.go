@@ -0,0 +1,30 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSchemaNotFound = errors.New("specs schema not found")
+	// ErrSchemaInUse схему нельзя удалить, пока у категории есть модели
+	ErrSchemaInUse = errors.New("specs schema is used by models of the category")
+	// ErrIncompatibleModels характеристики моделей категории не проходят новую схему
+	ErrIncompatibleModels = errors.New("specs of existing models do not match the new schema")
+)
+
+// IncompatibleError новой схеме не соответствуют характеристики моделей
+// категории, включая удаленные: их можно восстановить без повторной проверки
+type IncompatibleError struct {
+	Total  int                     // Сколько моделей не проходят схему
+	Models []dto.IncompatibleModel // Первые из них по ID
+}
+
+func (e *IncompatibleError) Error() string {
+	return fmt.Sprintf("%s: %d model(s)", ErrIncompatibleModels, e.Total)
+}
+
+func (e *IncompatibleError) Is(target error) bool {
+	return target == ErrIncompatibleModels
+}
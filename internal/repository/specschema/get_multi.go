@@ -0,0 +1,37 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetAll получает схемы характеристик всех категорий
+func (r *SpecSchemaRepository) GetAll(ctx context.Context) ([]dto.SpecSchema, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaRepository.GetAll")
+	defer span.Finish()
+
+	query := `
+		-- name: SpecSchemaRepository.GetAll
+		SELECT * FROM spec_schemas ORDER BY category
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to fetch specs schemas")
+		return nil, fmt.Errorf("unable to get specs schemas: %w", err)
+	}
+	schemas, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.SpecSchema])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect specs schemas")
+		return nil, fmt.Errorf("unable to collect specs schemas: %w", err)
+	}
+	if schemas == nil {
+		schemas = []dto.SpecSchema{}
+	}
+	return schemas, nil
+}
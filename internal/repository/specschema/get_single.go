@@ -0,0 +1,45 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// GetByCategory получает схему характеристик категории
+func (r *SpecSchemaRepository) GetByCategory(ctx context.Context, category string) (*dto.SpecSchema, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaRepository.GetByCategory")
+	defer span.Finish()
+
+	query := `
+		-- name: SpecSchemaRepository.GetByCategory
+		SELECT * FROM spec_schemas WHERE category = @category
+	`
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"category": category})
+	schema, err := collectSchema(rows, err)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		if errors.Is(err, ErrSchemaNotFound) {
+			r.log.Warn().Ctx(ctx).Str("category", category).Msg("Specs schema not found")
+			return nil, ErrSchemaNotFound
+		}
+		r.log.Error().Ctx(ctx).Err(err).Str("category", category).Msg("Failed to fetch specs schema")
+		return nil, fmt.Errorf("unable to get specs schema: %w", err)
+	}
+	return schema, nil
+}
+
+func collectSchema(rows pgx.Rows, err error) (*dto.SpecSchema, error) {
+	if err != nil {
+		return nil, err
+	}
+	schema, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.SpecSchema])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSchemaNotFound
+	}
+	return schema, err
+}
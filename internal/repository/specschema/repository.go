@@ -0,0 +1,21 @@
+package specschema
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type SpecSchemaRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*SpecSchemaRepository, error) {
+	return &SpecSchemaRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
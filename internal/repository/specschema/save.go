@@ -0,0 +1,110 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/specs"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// maxIncompatible сколько несовместимых моделей перечисляется в ошибке
+const maxIncompatible = 20
+
+// Save создает или заменяет схему характеристик категории. Замена
+// проверяет характеристики всех моделей категории, включая удаленные:
+// если какая-то не проходит новую схему, схема не меняется и возвращается
+// *IncompatibleError. Схема блокируется до конца транзакции, поэтому модели,
+// проверенные по прежней версии, не будут записаны после замены.
+func (r *SpecSchemaRepository) Save(ctx context.Context, schema *dto.SpecSchema, compiled *specs.Schema) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaRepository.Save")
+	defer span.Finish()
+
+	lock := `-- name: SpecSchemaRepository.LockForSave
+		SELECT * FROM spec_schemas WHERE category = @category FOR UPDATE`
+	upsert := `
+		-- name: SpecSchemaRepository.Save
+		INSERT INTO spec_schemas (id, category, schema, version, created_at, updated_at)
+		VALUES (@id, @category, @schema, 1, NOW(), NOW())
+		ON CONFLICT (category) DO UPDATE
+		SET schema = EXCLUDED.schema, version = spec_schemas.version + 1, updated_at = EXCLUDED.updated_at
+		RETURNING *
+	`
+	models := `
+		-- name: SpecSchemaRepository.ModelSpecs
+		SELECT id, specs FROM models WHERE category = @category ORDER BY id
+	`
+	args := pgx.NamedArgs{"id": schema.ID, "category": schema.Category, "schema": schema.Schema}
+
+	var saved *dto.SpecSchema
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lock, args)
+		before, err := collectSchema(rows, err)
+		if err != nil && !errors.Is(err, ErrSchemaNotFound) {
+			return err
+		}
+		rows, err = tx.Query(ctx, upsert, args)
+		if saved, err = collectSchema(rows, err); err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(ctx, models, args)
+		if err != nil {
+			return err
+		}
+		incompatible := &IncompatibleError{}
+		var modelID uuid.UUID
+		var modelSpecs map[string]any
+		_, err = pgx.ForEachRow(rows, []any{&modelID, &modelSpecs}, func() error {
+			var invalid *specs.ValidationError
+			if !errors.As(compiled.Validate(modelSpecs), &invalid) {
+				return nil
+			}
+			incompatible.Total++
+			if len(incompatible.Models) < maxIncompatible {
+				incompatible.Models = append(incompatible.Models, dto.IncompatibleModel{
+					ModelID:    modelID,
+					Violations: Violations(invalid),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if incompatible.Total > 0 {
+			return incompatible
+		}
+
+		operation := audit.OperationUpdate
+		if before == nil {
+			operation = audit.OperationCreate
+		}
+		return audit.Record(ctx, tx, operation, audit.EntitySpecSchema, saved.ID, before, saved)
+	})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		if errors.Is(err, ErrIncompatibleModels) {
+			r.log.Warn().Ctx(ctx).Err(err).Str("category", schema.Category).Msg("Refused to save incompatible specs schema")
+			return err
+		}
+		r.log.Error().Ctx(ctx).Err(err).Str("category", schema.Category).Msg("Failed to save specs schema")
+		return fmt.Errorf("unable to save specs schema: %w", err)
+	}
+	*schema = *saved
+	return nil
+}
+
+// Violations переводит нарушения схемы в формат ответа API
+func Violations(err *specs.ValidationError) []dto.SpecViolation {
+	violations := make([]dto.SpecViolation, len(err.Violations))
+	for i, v := range err.Violations {
+		violations[i] = dto.SpecViolation{Path: v.Path, Message: v.Message}
+	}
+	return violations
+}
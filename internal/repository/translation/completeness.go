@@ -0,0 +1,195 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Completeness считает для каждого языка неудаленные бренды и модели без
+// переводов по полям. Описание учитывается только у брендов, у которых оно
+// заполнено на основном языке.
+func (r *TranslationRepository) Completeness(ctx context.Context, locales []string) ([]dto.LocaleCompleteness, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.Completeness")
+	defer span.Finish()
+
+	query := `
+		-- name: TranslationRepository.Completeness
+		WITH locales AS (
+			SELECT locale FROM unnest(@locales::text[]) AS l(locale)
+		), brand_stats AS (
+			SELECT l.locale,
+				COUNT(b.id) AS brands,
+				COUNT(b.id) FILTER (WHERE COALESCE(b.description, '') <> '') AS described,
+				COUNT(b.id) FILTER (WHERE t.name IS NULL) AS brand_names,
+				COUNT(b.id) FILTER (WHERE COALESCE(b.description, '') <> '' AND t.description IS NULL) AS descriptions
+			FROM locales l
+			LEFT JOIN brands b ON b.is_deleted = false
+			LEFT JOIN brand_translations t ON t.brand_id = b.id AND t.locale = l.locale
+			GROUP BY l.locale
+		), model_stats AS (
+			SELECT l.locale,
+				COUNT(m.id) AS models,
+				COUNT(m.id) FILTER (WHERE t.name IS NULL) AS model_names
+			FROM locales l
+			LEFT JOIN models m ON m.is_deleted = false
+			LEFT JOIN model_translations t ON t.model_id = m.id AND t.locale = l.locale
+			GROUP BY l.locale
+		)
+		SELECT b.locale, b.brands, b.described, b.brand_names, b.descriptions, m.models, m.model_names
+		FROM brand_stats b
+		JOIN model_stats m ON m.locale = b.locale
+	`
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"locales": locales})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Strs("locales", locales).Msg("Failed to count missing translations")
+		return nil, fmt.Errorf("unable to count missing translations: %w", err)
+	}
+	type statsRow struct {
+		Locale       string
+		Brands       int64
+		Described    int64
+		BrandNames   int64
+		Descriptions int64
+		Models       int64
+		ModelNames   int64
+	}
+	stats, err := pgx.CollectRows(rows, pgx.RowToStructByName[statsRow])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect translation stats")
+		return nil, fmt.Errorf("unable to collect translation stats: %w", err)
+	}
+
+	byLocale := make(map[string]statsRow, len(stats))
+	for _, row := range stats {
+		byLocale[row.Locale] = row
+	}
+	report := make([]dto.LocaleCompleteness, 0, len(locales))
+	for _, locale := range locales {
+		row := byLocale[locale]
+		report = append(report, dto.LocaleCompleteness{
+			Locale: locale,
+			Brands: dto.TranslationCoverage{
+				Total:   row.Brands,
+				Missing: map[string]int64{"name": row.BrandNames, "description": row.Descriptions},
+				Percent: percent(row.Brands+row.Described, row.BrandNames+row.Descriptions),
+			},
+			Models: dto.TranslationCoverage{
+				Total:   row.Models,
+				Missing: map[string]int64{"name": row.ModelNames},
+				Percent: percent(row.Models, row.ModelNames),
+			},
+		})
+	}
+	return report, nil
+}
+
+// Missing получает страницу неудаленных записей с непереведенными на язык
+// полями, по сущности и названию
+func (r *TranslationRepository) Missing(ctx context.Context, filter dto.MissingTranslationFilter) (*dto.MissingTranslationPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.Missing")
+	defer span.Finish()
+
+	query := `
+		-- name: TranslationRepository.Missing
+		WITH missing AS (
+			SELECT 'brand' AS entity, b.id, NULL::uuid AS brand_id, b.name,
+				array_remove(ARRAY[
+					CASE WHEN t.name IS NULL THEN 'name' END,
+					CASE WHEN COALESCE(b.description, '') <> '' AND t.description IS NULL THEN 'description' END
+				], NULL) AS fields
+			FROM brands b
+			LEFT JOIN brand_translations t ON t.brand_id = b.id AND t.locale = @locale
+			WHERE b.is_deleted = false AND @entity IN ('', 'brand')
+			UNION ALL
+			SELECT 'model', m.id, m.brand_id, m.name, ARRAY['name']
+			FROM models m
+			WHERE m.is_deleted = false AND @entity IN ('', 'model')
+			  AND NOT EXISTS (SELECT 1 FROM model_translations t WHERE t.model_id = m.id AND t.locale = @locale)
+		)
+		SELECT *, COUNT(*) OVER () AS total
+		FROM missing
+		WHERE cardinality(fields) > 0
+		ORDER BY entity, name, id
+		LIMIT @limit OFFSET @offset
+	`
+	countQuery := `
+		-- name: TranslationRepository.CountMissing
+		SELECT
+			(SELECT COUNT(*) FROM brands b
+			 LEFT JOIN brand_translations t ON t.brand_id = b.id AND t.locale = @locale
+			 WHERE b.is_deleted = false AND @entity IN ('', 'brand')
+			   AND (t.name IS NULL OR (COALESCE(b.description, '') <> '' AND t.description IS NULL)))
+			+
+			(SELECT COUNT(*) FROM models m
+			 WHERE m.is_deleted = false AND @entity IN ('', 'model')
+			   AND NOT EXISTS (SELECT 1 FROM model_translations t WHERE t.model_id = m.id AND t.locale = @locale))
+	`
+	args := pgx.NamedArgs{
+		"locale": filter.Locale,
+		"entity": filter.Entity,
+		"limit":  filter.Limit,
+		"offset": filter.Offset,
+	}
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("locale", filter.Locale).Msg("Failed to fetch missing translations")
+		return nil, fmt.Errorf("unable to get missing translations: %w", err)
+	}
+	type missingRow struct {
+		Entity  string
+		ID      uuid.UUID
+		BrandID *uuid.UUID
+		Name    string
+		Fields  []string
+		Total   int64
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[missingRow])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect missing translations")
+		return nil, fmt.Errorf("unable to collect missing translations: %w", err)
+	}
+
+	page := &dto.MissingTranslationPage{
+		Locale: filter.Locale,
+		Items:  make([]dto.MissingTranslation, 0, len(collected)),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, row := range collected {
+		page.Items = append(page.Items, dto.MissingTranslation{
+			Entity:  row.Entity,
+			ID:      row.ID,
+			BrandID: row.BrandID,
+			Name:    row.Name,
+			Fields:  row.Fields,
+		})
+		page.Total = row.Total
+	}
+	if len(collected) == 0 && filter.Offset > 0 {
+		// За пределами последней страницы оконная функция ничего не вернет
+		if err = r.pool.QueryRow(ctx, countQuery, args).Scan(&page.Total); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Msg("Failed to count missing translations")
+			return nil, fmt.Errorf("error counting missing translations: %w", err)
+		}
+	}
+	return page, nil
+}
+
+// percent возвращает долю переведенных полей из total с точностью до десятых;
+// без полей для перевода перевод считается полным
+func percent(total, missing int64) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64((total-missing)*1000/total) / 10
+}
@@ -0,0 +1,5 @@
+package translation
+
+import "github.com/pkg/errors"
+
+var ErrTranslationNotFound = errors.New("translation not found")
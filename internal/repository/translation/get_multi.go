@@ -0,0 +1,99 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	brandrepo "Brands/internal/repository/brand"
+	modelrepo "Brands/internal/repository/model"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// BrandTranslations получает переводы неудаленного бренда по языкам
+func (r *TranslationRepository) BrandTranslations(ctx context.Context, brandID uuid.UUID) ([]dto.BrandTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.BrandTranslations")
+	defer span.Finish()
+
+	exists := `-- name: TranslationRepository.BrandExists
+		SELECT id FROM brands WHERE id = @id AND is_deleted = false`
+	query := `
+		-- name: TranslationRepository.BrandTranslations
+		SELECT * FROM brand_translations WHERE brand_id = @id ORDER BY locale
+	`
+	args := pgx.NamedArgs{"id": brandID}
+	var found uuid.UUID
+	if err := r.pool.QueryRow(ctx, exists, args).Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.log.Warn().Ctx(ctx).Str("brand_id", brandID.String()).Msg("Brand not found")
+			return nil, brandrepo.ErrBrandNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to fetch brand")
+		return nil, fmt.Errorf("unable to get brand: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to fetch brand translations")
+		return nil, fmt.Errorf("unable to get brand translations: %w", err)
+	}
+	translations, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.BrandTranslation])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("brand_id", brandID.String()).Msg("Failed to collect brand translations")
+		return nil, fmt.Errorf("unable to collect brand translations: %w", err)
+	}
+	if translations == nil {
+		translations = []dto.BrandTranslation{}
+	}
+	return translations, nil
+}
+
+// ModelTranslations получает переводы названия неудаленной модели по языкам
+func (r *TranslationRepository) ModelTranslations(ctx context.Context, modelID uuid.UUID) ([]dto.ModelTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.ModelTranslations")
+	defer span.Finish()
+
+	exists := `-- name: TranslationRepository.ModelBrand
+		SELECT brand_id FROM models WHERE id = @id AND is_deleted = false`
+	query := `
+		-- name: TranslationRepository.ModelTranslations
+		SELECT * FROM model_translations WHERE model_id = @id ORDER BY locale
+	`
+	args := pgx.NamedArgs{"id": modelID}
+	var brandID uuid.UUID
+	if err := r.pool.QueryRow(ctx, exists, args).Scan(&brandID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.log.Warn().Ctx(ctx).Str("model_id", modelID.String()).Msg("Model not found")
+			return nil, modelrepo.ErrModelNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msg("Failed to fetch model")
+		return nil, fmt.Errorf("unable to get model: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msg("Failed to fetch model translations")
+		return nil, fmt.Errorf("unable to get model translations: %w", err)
+	}
+	translations, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.ModelTranslation])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msg("Failed to collect model translations")
+		return nil, fmt.Errorf("unable to collect model translations: %w", err)
+	}
+	if translations == nil {
+		translations = []dto.ModelTranslation{}
+	}
+	for i := range translations {
+		translations[i].BrandID = brandID
+	}
+	return translations, nil
+}
@@ -0,0 +1,141 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// LocalizeBrands подставляет в бренды переводы названия и описания: для
+// каждого поля берется первый язык chain, на который оно переведено. Поле
+// без переводов сохраняет значение на основном языке. Названия брендов
+// цепочки родителей переводятся так же. Бренд с переведенным названием или
+// описанием получает Locale — язык первого подставленного перевода.
+func (r *TranslationRepository) LocalizeBrands(ctx context.Context, chain []string, brands ...*dto.Brand) error {
+	if len(chain) == 0 || len(brands) == 0 {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.LocalizeBrands")
+	defer span.Finish()
+
+	query := `
+		-- name: TranslationRepository.LocalizeBrands
+		SELECT * FROM brand_translations WHERE brand_id = ANY(@ids) AND locale = ANY(@locales)
+	`
+	ids := make([]uuid.UUID, 0, len(brands))
+	for _, brand := range brands {
+		ids = append(ids, brand.ID)
+		for _, parent := range brand.Path {
+			ids = append(ids, parent.ID)
+		}
+	}
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"ids": ids, "locales": chain})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Strs("locales", chain).Msg("Failed to fetch brand translations")
+		return fmt.Errorf("unable to get brand translations: %w", err)
+	}
+	translations, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.BrandTranslation])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect brand translations")
+		return fmt.Errorf("unable to collect brand translations: %w", err)
+	}
+
+	byKey := make(map[translationKey]dto.BrandTranslation, len(translations))
+	for _, t := range translations {
+		byKey[translationKey{t.BrandID, t.Locale}] = t
+	}
+	for _, brand := range brands {
+		if name, locale := firstTranslated(chain, func(locale string) *string {
+			return byKey[translationKey{brand.ID, locale}].Name
+		}); name != nil {
+			brand.Name = *name
+			brand.Locale = &locale
+		}
+		for i := range brand.Path {
+			parent := &brand.Path[i]
+			if name, _ := firstTranslated(chain, func(locale string) *string {
+				return byKey[translationKey{parent.ID, locale}].Name
+			}); name != nil {
+				parent.Name = *name
+			}
+		}
+		if description, locale := firstTranslated(chain, func(locale string) *string {
+			return byKey[translationKey{brand.ID, locale}].Description
+		}); description != nil {
+			brand.Description = *description
+			if brand.Locale == nil {
+				brand.Locale = &locale
+			}
+		}
+	}
+	return nil
+}
+
+// LocalizeModels подставляет в модели перевод названия на первый язык chain,
+// на который оно переведено; модель с переводом получает Locale
+func (r *TranslationRepository) LocalizeModels(ctx context.Context, chain []string, models ...*dto.Model) error {
+	if len(chain) == 0 || len(models) == 0 {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.LocalizeModels")
+	defer span.Finish()
+
+	query := `
+		-- name: TranslationRepository.LocalizeModels
+		SELECT * FROM model_translations WHERE model_id = ANY(@ids) AND locale = ANY(@locales)
+	`
+	ids := make([]uuid.UUID, 0, len(models))
+	for _, model := range models {
+		ids = append(ids, model.ID)
+	}
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"ids": ids, "locales": chain})
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Strs("locales", chain).Msg("Failed to fetch model translations")
+		return fmt.Errorf("unable to get model translations: %w", err)
+	}
+	translations, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.ModelTranslation])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect model translations")
+		return fmt.Errorf("unable to collect model translations: %w", err)
+	}
+
+	byKey := make(map[translationKey]string, len(translations))
+	for _, t := range translations {
+		byKey[translationKey{t.ModelID, t.Locale}] = t.Name
+	}
+	for _, model := range models {
+		for _, locale := range chain {
+			if name, ok := byKey[translationKey{model.ID, locale}]; ok {
+				model.Name = name
+				model.Locale = &locale
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// translationKey перевод записи на язык
+type translationKey struct {
+	id     uuid.UUID
+	locale string
+}
+
+// firstTranslated возвращает значение поля на первом языке chain, на который
+// оно переведено, и этот язык
+func firstTranslated(chain []string, field func(locale string) *string) (*string, string) {
+	for _, locale := range chain {
+		if value := field(locale); value != nil {
+			return value, locale
+		}
+	}
+	return nil, ""
+}
@@ -0,0 +1,92 @@
+package translation
+
+import (
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/outbox"
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// mutation изменение перевода записи одного типа
+type mutation[T any] struct {
+	entity   string                        // Сущность аудита и outbox
+	notFound error                         // Ошибка отсутствия переведенной записи
+	lock     string                        // Блокирует запись и возвращает ID ее бренда
+	current  string                        // Текущий перевод для снимка "до"
+	change   string                        // Изменение; кроме удаления возвращает перевод через RETURNING *
+	delete   bool                          // Изменение удаляет перевод
+	setBrand func(t *T, brandID uuid.UUID) // Дополняет перевод ID бренда для фильтров ленты изменений
+	args     pgx.NamedArgs                 // Аргументы всех запросов
+	id       uuid.UUID                     // ID переведенной записи
+}
+
+// run выполняет изменение перевода в транзакции, блокирующей переведенную
+// запись: удаленную запись перевести нельзя. Событие аудита и доменное
+// событие в outbox пишутся в той же транзакции; операция — create, update
+// или delete перевода.
+func (m mutation[T]) run(ctx context.Context, r *TranslationRepository) (*T, error) {
+	var result *T
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var brandID uuid.UUID
+		err := tx.QueryRow(ctx, m.lock, m.args).Scan(&brandID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return m.notFound
+		}
+		if err != nil {
+			return err
+		}
+
+		before, err := m.collect(tx.Query(ctx, m.current, m.args))
+		if err != nil {
+			return err
+		}
+		var after *T
+		operation := audit.OperationUpdate
+		switch {
+		case m.delete:
+			if before == nil {
+				return ErrTranslationNotFound
+			}
+			if _, err = tx.Exec(ctx, m.change, m.args); err != nil {
+				return err
+			}
+			operation = audit.OperationDelete
+		default:
+			if after, err = m.collect(tx.Query(ctx, m.change, m.args)); err != nil {
+				return err
+			}
+			if before == nil {
+				operation = audit.OperationCreate
+			}
+		}
+
+		for _, t := range []*T{before, after} {
+			if t != nil && m.setBrand != nil {
+				m.setBrand(t, brandID)
+			}
+		}
+		if err = audit.Record(ctx, tx, operation, m.entity, m.id, before, after); err != nil {
+			return err
+		}
+		result = after
+		if m.delete {
+			result = before
+		}
+		return outbox.Record(ctx, tx, m.entity, operation, m.id, result)
+	})
+	return result, err
+}
+
+// collect читает не более одного перевода; nil означает, что перевода нет
+func (m mutation[T]) collect(rows pgx.Rows, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	t, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[T])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
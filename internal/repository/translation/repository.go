@@ -0,0 +1,21 @@
+package translation
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type TranslationRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*TranslationRepository, error) {
+	return &TranslationRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,149 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	brandrepo "Brands/internal/repository/brand"
+	modelrepo "Brands/internal/repository/model"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// UpsertBrand создает или заменяет перевод неудаленного бренда на язык
+func (r *TranslationRepository) UpsertBrand(ctx context.Context, t *dto.BrandTranslation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.UpsertBrand")
+	defer span.Finish()
+
+	m := brandMutation(t.BrandID, t.Locale)
+	m.change = `-- name: TranslationRepository.UpsertBrand
+		INSERT INTO brand_translations (brand_id, locale, name, description, updated_at)
+		VALUES (@id, @locale, @name, @description, NOW())
+		ON CONFLICT (brand_id, locale) DO UPDATE
+		SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = EXCLUDED.updated_at
+		RETURNING *`
+	m.args["name"] = t.Name
+	m.args["description"] = t.Description
+
+	saved, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, t.BrandID, t.Locale, err, "Failed to save brand translation")
+	}
+	*t = *saved
+	return nil
+}
+
+// UpsertModel создает или заменяет перевод названия неудаленной модели на язык
+func (r *TranslationRepository) UpsertModel(ctx context.Context, t *dto.ModelTranslation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.UpsertModel")
+	defer span.Finish()
+
+	m := modelMutation(t.ModelID, t.Locale)
+	m.change = `-- name: TranslationRepository.UpsertModel
+		INSERT INTO model_translations (model_id, locale, name, updated_at)
+		VALUES (@id, @locale, @name, NOW())
+		ON CONFLICT (model_id, locale) DO UPDATE
+		SET name = EXCLUDED.name, updated_at = EXCLUDED.updated_at
+		RETURNING *`
+	m.args["name"] = t.Name
+
+	saved, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, t.ModelID, t.Locale, err, "Failed to save model translation")
+	}
+	*t = *saved
+	return nil
+}
+
+// DeleteBrand удаляет перевод бренда на язык
+func (r *TranslationRepository) DeleteBrand(ctx context.Context, brandID uuid.UUID, locale string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.DeleteBrand")
+	defer span.Finish()
+
+	m := brandMutation(brandID, locale)
+	m.change = `-- name: TranslationRepository.DeleteBrand
+		DELETE FROM brand_translations WHERE brand_id = @id AND locale = @locale`
+	m.delete = true
+	if _, err := m.run(ctx, r); err != nil {
+		return r.mutationError(ctx, span, m.entity, brandID, locale, err, "Failed to delete brand translation")
+	}
+	return nil
+}
+
+// DeleteModel удаляет перевод названия модели на язык
+func (r *TranslationRepository) DeleteModel(ctx context.Context, modelID uuid.UUID, locale string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationRepository.DeleteModel")
+	defer span.Finish()
+
+	m := modelMutation(modelID, locale)
+	m.change = `-- name: TranslationRepository.DeleteModel
+		DELETE FROM model_translations WHERE model_id = @id AND locale = @locale`
+	m.delete = true
+	if _, err := m.run(ctx, r); err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, locale, err, "Failed to delete model translation")
+	}
+	return nil
+}
+
+func brandMutation(brandID uuid.UUID, locale string) mutation[dto.BrandTranslation] {
+	return mutation[dto.BrandTranslation]{
+		entity:   audit.EntityBrandTranslation,
+		notFound: brandrepo.ErrBrandNotFound,
+		lock: `-- name: TranslationRepository.LockBrand
+			SELECT id FROM brands WHERE id = @id AND is_deleted = false FOR UPDATE`,
+		current: `-- name: TranslationRepository.CurrentBrand
+			SELECT * FROM brand_translations WHERE brand_id = @id AND locale = @locale`,
+		args: pgx.NamedArgs{"id": brandID, "locale": locale},
+		id:   brandID,
+	}
+}
+
+func modelMutation(modelID uuid.UUID, locale string) mutation[dto.ModelTranslation] {
+	return mutation[dto.ModelTranslation]{
+		entity:   audit.EntityModelTranslation,
+		notFound: modelrepo.ErrModelNotFound,
+		lock: `-- name: TranslationRepository.LockModel
+			SELECT brand_id FROM models WHERE id = @id AND is_deleted = false FOR UPDATE`,
+		current: `-- name: TranslationRepository.CurrentModel
+			SELECT * FROM model_translations WHERE model_id = @id AND locale = @locale`,
+		setBrand: func(t *dto.ModelTranslation, brandID uuid.UUID) { t.BrandID = brandID },
+		args:     pgx.NamedArgs{"id": modelID, "locale": locale},
+		id:       modelID,
+	}
+}
+
+// mutationError логирует ошибку изменения перевода; ожидаемые ошибки
+// возвращаются как есть, чтобы вызывающие могли их различить
+func (r *TranslationRepository) mutationError(
+	ctx context.Context,
+	span opentracing.Span,
+	entity string,
+	id uuid.UUID,
+	locale string,
+	err error,
+	msg string,
+) error {
+	span.LogFields(log.Error(err))
+	if errors.Is(err, brandrepo.ErrBrandNotFound) ||
+		errors.Is(err, modelrepo.ErrModelNotFound) ||
+		errors.Is(err, ErrTranslationNotFound) {
+		r.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("entity", entity).
+			Str("entity_id", id.String()).
+			Str("locale", locale).
+			Msg(msg)
+		return err
+	}
+	r.log.Error().Ctx(ctx).
+		Err(err).
+		Str("entity", entity).
+		Str("entity_id", id.String()).
+		Str("locale", locale).
+		Msg(msg)
+	return fmt.Errorf("unable to change translation: %w", err)
+}
@@ -0,0 +1,13 @@
+package variant
+
+import "github.com/pkg/errors"
+
+var (
+	ErrGenerationNotFound = errors.New("model generation not found")
+	ErrTrimNotFound       = errors.New("model trim not found")
+	// ErrGenerationDeleted комплектацию удаленного поколения нельзя восстановить
+	ErrGenerationDeleted = errors.New("generation of the trim is deleted, restore the generation first")
+	// ErrNameTaken название занято неудаленным поколением модели или
+	// комплектацией поколения
+	ErrNameTaken = errors.New("name is already taken")
+)
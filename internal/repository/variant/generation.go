@@ -0,0 +1,194 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/repository/outbox"
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+)
+
+// modelGuard блокирует неудаленную модель на время изменения ее поколений
+var modelGuard = guard{
+	query: `-- name: VariantRepository.GuardModel
+		SELECT brand_id FROM models WHERE id = @model_id AND is_deleted = false FOR SHARE`,
+	notFound: modelrepo.ErrModelNotFound,
+}
+
+// CreateGeneration добавляет поколение неудаленной модели
+func (r *VariantRepository) CreateGeneration(ctx context.Context, g *dto.ModelGeneration) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.CreateGeneration")
+	defer span.Finish()
+
+	m := generationMutation(audit.OperationCreate, g.ModelID, g.ID)
+	m.change = `-- name: VariantRepository.CreateGeneration
+		INSERT INTO model_generations (id, model_id, name, start_year, end_year, created_at, updated_at)
+		VALUES (@id, @model_id, @name, @start_year, @end_year, NOW(), NOW())
+		RETURNING *`
+	setGenerationArgs(m.args, g)
+
+	created, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, g.ModelID, g.ID, err, "Failed to create model generation")
+	}
+	*g = *created
+	return nil
+}
+
+// UpdateGeneration изменяет неудаленное поколение модели
+func (r *VariantRepository) UpdateGeneration(ctx context.Context, g *dto.ModelGeneration) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.UpdateGeneration")
+	defer span.Finish()
+
+	m := generationMutation(audit.OperationUpdate, g.ModelID, g.ID)
+	m.lock = `-- name: VariantRepository.LockGenerationForUpdate
+		SELECT * FROM model_generations
+		WHERE id = @id AND model_id = @model_id AND is_deleted = false
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.UpdateGeneration
+		UPDATE model_generations
+		SET name = @name, start_year = @start_year, end_year = @end_year, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	setGenerationArgs(m.args, g)
+
+	updated, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, g.ModelID, g.ID, err, "Failed to update model generation")
+	}
+	*g = *updated
+	return nil
+}
+
+// DeleteGeneration мягко удаляет поколение модели вместе с его неудаленными
+// комплектациями; комплектации помечаются удаленными вместе с поколением
+func (r *VariantRepository) DeleteGeneration(ctx context.Context, modelID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.DeleteGeneration")
+	defer span.Finish()
+
+	m := generationMutation(audit.OperationDelete, modelID, id)
+	m.lock = `-- name: VariantRepository.LockGenerationForDelete
+		SELECT * FROM model_generations
+		WHERE id = @id AND model_id = @model_id AND is_deleted = false
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.DeleteGeneration
+		UPDATE model_generations
+		SET is_deleted = true, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	m.cascade = cascadeTrims(audit.OperationDelete,
+		`-- name: VariantRepository.LockTrimsForDelete
+		SELECT * FROM model_trims WHERE generation_id = @id AND is_deleted = false ORDER BY id FOR UPDATE`,
+		`-- name: VariantRepository.DeleteGenerationTrims
+		UPDATE model_trims
+		SET is_deleted = true, deleted_with_generation = true, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE generation_id = @id AND is_deleted = false
+		RETURNING *`,
+		m.args,
+	)
+	m.args["actor"] = audit.ActorFromContext(ctx)
+
+	if _, err := m.run(ctx, r); err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, id, err, "Failed to delete model generation")
+	}
+	return nil
+}
+
+// RestoreGeneration восстанавливает удаленное поколение неудаленной модели и
+// комплектации, удаленные вместе с ним. Комплектации, удаленные отдельно,
+// остаются в корзине.
+func (r *VariantRepository) RestoreGeneration(ctx context.Context, modelID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.RestoreGeneration")
+	defer span.Finish()
+
+	m := generationMutation(audit.OperationRestore, modelID, id)
+	m.lock = `-- name: VariantRepository.LockGenerationForRestore
+		SELECT * FROM model_generations
+		WHERE id = @id AND model_id = @model_id AND is_deleted = true
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.RestoreGeneration
+		UPDATE model_generations
+		SET is_deleted = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	m.cascade = cascadeTrims(audit.OperationRestore,
+		`-- name: VariantRepository.LockTrimsForRestore
+		SELECT * FROM model_trims
+		WHERE generation_id = @id AND is_deleted = true AND deleted_with_generation = true
+		ORDER BY id
+		FOR UPDATE`,
+		`-- name: VariantRepository.RestoreGenerationTrims
+		UPDATE model_trims
+		SET is_deleted = false, deleted_with_generation = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE generation_id = @id AND is_deleted = true AND deleted_with_generation = true
+		RETURNING *`,
+		m.args,
+	)
+
+	if _, err := m.run(ctx, r); err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, id, err, "Failed to restore model generation")
+	}
+	return nil
+}
+
+func generationMutation(operation string, modelID, id uuid.UUID) mutation[dto.ModelGeneration] {
+	return mutation[dto.ModelGeneration]{
+		entity:    audit.EntityModelGeneration,
+		operation: operation,
+		guards:    []guard{modelGuard},
+		notFound:  ErrGenerationNotFound,
+		setBrand:  func(g *dto.ModelGeneration, brandID uuid.UUID) { g.BrandID = brandID },
+		args:      pgx.NamedArgs{"id": id, "model_id": modelID},
+		id:        id,
+	}
+}
+
+func setGenerationArgs(args pgx.NamedArgs, g *dto.ModelGeneration) {
+	args["name"] = g.Name
+	args["start_year"] = g.StartYear
+	args["end_year"] = g.EndYear
+}
+
+// cascadeTrims изменяет комплектации поколения вслед за его удалением или
+// восстановлением. lock блокирует комплектации для снимков "до", change
+// изменяет их и возвращает через RETURNING *; аудит и событие outbox
+// пишутся для каждой комплектации, как при прямом изменении.
+func cascadeTrims(operation, lock, change string, args pgx.NamedArgs) cascadeFunc {
+	return func(ctx context.Context, tx pgx.Tx, brandID uuid.UUID) error {
+		rows, err := tx.Query(ctx, lock, args)
+		if err != nil {
+			return err
+		}
+		current, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.ModelTrim])
+		if err != nil || len(current) == 0 {
+			return err
+		}
+		before := make(map[uuid.UUID]*dto.ModelTrim, len(current))
+		for _, trim := range current {
+			trim.BrandID = brandID
+			before[trim.ID] = trim
+		}
+
+		rows, err = tx.Query(ctx, change, args)
+		if err != nil {
+			return err
+		}
+		changed, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dto.ModelTrim])
+		if err != nil {
+			return err
+		}
+		for _, after := range changed {
+			after.BrandID = brandID
+			if err = audit.Record(ctx, tx, operation, audit.EntityModelTrim, after.ID, before[after.ID], after); err != nil {
+				return err
+			}
+			if err = outbox.Record(ctx, tx, audit.EntityModelTrim, operation, after.ID, after); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	modelrepo "Brands/internal/repository/model"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// GetVariants получает неудаленные поколения неудаленной модели по годам
+// выпуска вместе с их неудаленными комплектациями
+func (r *VariantRepository) GetVariants(ctx context.Context, modelID uuid.UUID) ([]dto.ModelVariant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.GetVariants")
+	defer span.Finish()
+
+	generations := `
+		-- name: VariantRepository.GetGenerations
+		SELECT * FROM model_generations
+		WHERE model_id = @model_id AND is_deleted = false
+		ORDER BY start_year, name, id
+	`
+	trims := `
+		-- name: VariantRepository.GetTrims
+		SELECT t.*
+		FROM model_trims t
+		JOIN model_generations g ON g.id = t.generation_id
+		WHERE g.model_id = @model_id AND g.is_deleted = false AND t.is_deleted = false
+		ORDER BY t.name, t.id
+	`
+	return r.variants(ctx, span, modelID, generations, trims, "variants")
+}
+
+// GetTrash получает удаленные поколения неудаленной модели и поколения с
+// удаленными комплектациями; у каждого поколения перечислены только
+// удаленные комплектации
+func (r *VariantRepository) GetTrash(ctx context.Context, modelID uuid.UUID) ([]dto.ModelVariant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.GetTrash")
+	defer span.Finish()
+
+	generations := `
+		-- name: VariantRepository.GetTrashGenerations
+		SELECT * FROM model_generations g
+		WHERE g.model_id = @model_id
+			AND (g.is_deleted = true
+				OR EXISTS (SELECT 1 FROM model_trims t WHERE t.generation_id = g.id AND t.is_deleted = true))
+		ORDER BY g.deleted_at DESC NULLS LAST, g.start_year, g.name, g.id
+	`
+	trims := `
+		-- name: VariantRepository.GetTrashTrims
+		SELECT t.*
+		FROM model_trims t
+		JOIN model_generations g ON g.id = t.generation_id
+		WHERE g.model_id = @model_id AND t.is_deleted = true
+		ORDER BY t.deleted_at DESC NULLS LAST, t.id
+	`
+	return r.variants(ctx, span, modelID, generations, trims, "variant trash")
+}
+
+// variants выполняет запросы поколений и комплектаций неудаленной модели и
+// раскладывает комплектации по поколениям
+func (r *VariantRepository) variants(
+	ctx context.Context,
+	span opentracing.Span,
+	modelID uuid.UUID,
+	generations, trims string,
+	kind string,
+) ([]dto.ModelVariant, error) {
+	exists := `-- name: VariantRepository.ModelBrand
+		SELECT brand_id FROM models WHERE id = @model_id AND is_deleted = false`
+
+	args := pgx.NamedArgs{"model_id": modelID}
+	var brandID uuid.UUID
+	if err := r.pool.QueryRow(ctx, exists, args).Scan(&brandID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.log.Warn().Ctx(ctx).Str("model_id", modelID.String()).Msg("Model not found")
+			return nil, modelrepo.ErrModelNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msg("Failed to fetch model")
+		return nil, fmt.Errorf("unable to get model: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, generations, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msgf("Failed to fetch model %s", kind)
+		return nil, fmt.Errorf("unable to get model %s: %w", kind, err)
+	}
+	variants, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.ModelGeneration])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msgf("Failed to collect model %s", kind)
+		return nil, fmt.Errorf("unable to collect model %s: %w", kind, err)
+	}
+
+	rows, err = r.pool.Query(ctx, trims, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msgf("Failed to fetch model %s trims", kind)
+		return nil, fmt.Errorf("unable to get model %s trims: %w", kind, err)
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.ModelTrim])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("model_id", modelID.String()).Msgf("Failed to collect model %s trims", kind)
+		return nil, fmt.Errorf("unable to collect model %s trims: %w", kind, err)
+	}
+	byGeneration := make(map[uuid.UUID][]dto.ModelTrim, len(variants))
+	for _, trim := range collected {
+		trim.BrandID = brandID
+		byGeneration[trim.GenerationID] = append(byGeneration[trim.GenerationID], trim)
+	}
+
+	result := make([]dto.ModelVariant, 0, len(variants))
+	for _, generation := range variants {
+		generation.BrandID = brandID
+		generationTrims := byGeneration[generation.ID]
+		if generationTrims == nil {
+			generationTrims = []dto.ModelTrim{}
+		}
+		result = append(result, dto.ModelVariant{ModelGeneration: generation, Trims: generationTrims})
+	}
+	return result, nil
+}
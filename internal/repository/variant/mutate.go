@@ -0,0 +1,146 @@
+package variant
+
+import (
+	"Brands/internal/pg"
+	"Brands/internal/repository/audit"
+	modelrepo "Brands/internal/repository/model"
+	"Brands/internal/repository/outbox"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// Уникальные индексы названий неудаленных поколений и комплектаций
+const (
+	generationNameConstraint = "uq_model_generations_name"
+	trimNameConstraint       = "uq_model_trims_name"
+)
+
+// guard блокирует родительскую запись FOR SHARE и возвращает ID бренда модели
+type guard struct {
+	query    string
+	notFound error // Ошибка, если родительская запись не найдена или удалена
+}
+
+// cascadeFunc выполняет каскадные изменения в транзакции изменения записи
+type cascadeFunc func(ctx context.Context, tx pgx.Tx, brandID uuid.UUID) error
+
+// mutation изменение поколения или комплектации модели
+type mutation[T any] struct {
+	entity    string                        // Сущность аудита и outbox
+	operation string                        // Операция аудита и outbox
+	guards    []guard                       // Блокируют модель и поколение до изменения
+	lock      string                        // Блокирует запись для снимка "до"; пустой при создании
+	notFound  error                         // Ошибка, если lock не нашел запись
+	change    string                        // Изменяет запись и возвращает ее через RETURNING *
+	cascade   cascadeFunc                   // Каскадные изменения, если заданы
+	setBrand  func(t *T, brandID uuid.UUID) // Дополняет запись ID бренда для фильтров ленты изменений
+	args      pgx.NamedArgs                 // Аргументы всех запросов
+	id        uuid.UUID                     // ID изменяемой записи
+}
+
+// run выполняет изменение в одной транзакции: родительские записи
+// блокируются раньше изменяемой, как и при удалении модели, поэтому
+// конкурентное удаление модели ждет фиксации. Событие аудита и доменное
+// событие в outbox пишутся в той же транзакции; события, в том числе
+// каскадные, попадают в outbox ее последним шагом (см. outbox.BeginFunc). Нарушение уникальности
+// названия возвращается как ErrNameTaken.
+func (m mutation[T]) run(ctx context.Context, r *VariantRepository) (*T, error) {
+	var after *T
+	err := outbox.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var brandID uuid.UUID
+		for _, g := range m.guards {
+			err := tx.QueryRow(ctx, g.query, m.args).Scan(&brandID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return g.notFound
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		var before *T
+		if m.lock != "" {
+			rows, err := tx.Query(ctx, m.lock, m.args)
+			if before, err = collect[T](rows, err, m.notFound); err != nil {
+				return err
+			}
+		}
+		rows, err := tx.Query(ctx, m.change, m.args)
+		if after, err = collect[T](rows, err, m.notFound); err != nil {
+			return err
+		}
+
+		for _, t := range []*T{before, after} {
+			if t != nil {
+				m.setBrand(t, brandID)
+			}
+		}
+		if err = audit.Record(ctx, tx, m.operation, m.entity, m.id, before, after); err != nil {
+			return err
+		}
+		if err = outbox.Record(ctx, tx, m.entity, m.operation, m.id, after); err != nil {
+			return err
+		}
+		if m.cascade != nil {
+			return m.cascade(ctx, tx, brandID)
+		}
+		return nil
+	})
+	if pg.IsUniqueViolation(err, generationNameConstraint) || pg.IsUniqueViolation(err, trimNameConstraint) {
+		return nil, ErrNameTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// collect читает ровно одну запись; ее отсутствие возвращается как notFound
+func collect[T any](rows pgx.Rows, err error, notFound error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	t, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[T])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notFound
+	}
+	return t, err
+}
+
+// mutationError логирует ошибку изменения поколения или комплектации;
+// ожидаемые ошибки возвращаются как есть, чтобы вызывающие могли их различить
+func (r *VariantRepository) mutationError(
+	ctx context.Context,
+	span opentracing.Span,
+	entity string,
+	modelID, id uuid.UUID,
+	err error,
+	msg string,
+) error {
+	span.LogFields(log.Error(err))
+	if errors.Is(err, modelrepo.ErrModelNotFound) ||
+		errors.Is(err, ErrGenerationNotFound) ||
+		errors.Is(err, ErrTrimNotFound) ||
+		errors.Is(err, ErrGenerationDeleted) ||
+		errors.Is(err, ErrNameTaken) {
+		r.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("entity", entity).
+			Str("model_id", modelID.String()).
+			Str("entity_id", id.String()).
+			Msg(msg)
+		return err
+	}
+	r.log.Error().Ctx(ctx).
+		Err(err).
+		Str("entity", entity).
+		Str("model_id", modelID.String()).
+		Str("entity_id", id.String()).
+		Msg(msg)
+	return fmt.Errorf("unable to change model variant: %w", err)
+}
@@ -0,0 +1,21 @@
+package variant
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type VariantRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*VariantRepository, error) {
+	return &VariantRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,155 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+// generationGuard блокирует неудаленное поколение модели на время изменения
+// его комплектаций
+var generationGuard = guard{
+	query: `-- name: VariantRepository.GuardGeneration
+		SELECT m.brand_id
+		FROM model_generations g
+		JOIN models m ON m.id = g.model_id
+		WHERE g.id = @generation_id AND g.model_id = @model_id AND g.is_deleted = false
+		FOR SHARE OF g`,
+	notFound: ErrGenerationNotFound,
+}
+
+// CreateTrim добавляет комплектацию неудаленного поколения
+func (r *VariantRepository) CreateTrim(ctx context.Context, modelID uuid.UUID, t *dto.ModelTrim) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.CreateTrim")
+	defer span.Finish()
+
+	m := trimMutation(audit.OperationCreate, modelID, t.GenerationID, t.ID)
+	m.change = `-- name: VariantRepository.CreateTrim
+		INSERT INTO model_trims (id, generation_id, name, is_upcoming, is_limited, created_at, updated_at)
+		VALUES (@id, @generation_id, @name, @is_upcoming, @is_limited, NOW(), NOW())
+		RETURNING *`
+	setTrimArgs(m.args, t)
+
+	created, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, t.ID, err, "Failed to create model trim")
+	}
+	*t = *created
+	return nil
+}
+
+// UpdateTrim изменяет неудаленную комплектацию поколения
+func (r *VariantRepository) UpdateTrim(ctx context.Context, modelID uuid.UUID, t *dto.ModelTrim) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.UpdateTrim")
+	defer span.Finish()
+
+	m := trimMutation(audit.OperationUpdate, modelID, t.GenerationID, t.ID)
+	m.lock = `-- name: VariantRepository.LockTrimForUpdate
+		SELECT * FROM model_trims
+		WHERE id = @id AND generation_id = @generation_id AND is_deleted = false
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.UpdateTrim
+		UPDATE model_trims
+		SET name = @name, is_upcoming = @is_upcoming, is_limited = @is_limited, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	setTrimArgs(m.args, t)
+
+	updated, err := m.run(ctx, r)
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, t.ID, err, "Failed to update model trim")
+	}
+	*t = *updated
+	return nil
+}
+
+// DeleteTrim мягко удаляет комплектацию поколения
+func (r *VariantRepository) DeleteTrim(ctx context.Context, modelID, generationID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.DeleteTrim")
+	defer span.Finish()
+
+	m := trimMutation(audit.OperationDelete, modelID, generationID, id)
+	m.lock = `-- name: VariantRepository.LockTrimForDelete
+		SELECT * FROM model_trims
+		WHERE id = @id AND generation_id = @generation_id AND is_deleted = false
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.DeleteTrim
+		UPDATE model_trims
+		SET is_deleted = true, deleted_with_generation = false, deleted_at = NOW(), deleted_by = @actor, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+	m.args["actor"] = audit.ActorFromContext(ctx)
+
+	if _, err := m.run(ctx, r); err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, id, err, "Failed to delete model trim")
+	}
+	return nil
+}
+
+// RestoreTrim восстанавливает удаленную комплектацию. Комплектация
+// удаленного поколения не восстанавливается: возвращается
+// ErrGenerationDeleted.
+func (r *VariantRepository) RestoreTrim(ctx context.Context, modelID, generationID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantRepository.RestoreTrim")
+	defer span.Finish()
+
+	m := trimMutation(audit.OperationRestore, modelID, generationID, id)
+	m.lock = `-- name: VariantRepository.LockTrimForRestore
+		SELECT * FROM model_trims
+		WHERE id = @id AND generation_id = @generation_id AND is_deleted = true
+		FOR UPDATE`
+	m.change = `-- name: VariantRepository.RestoreTrim
+		UPDATE model_trims
+		SET is_deleted = false, deleted_with_generation = false, deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE id = @id
+		RETURNING *`
+
+	_, err := m.run(ctx, r)
+	if errors.Is(err, ErrGenerationNotFound) {
+		// generationGuard не различает отсутствие комплектации и удаленное поколение
+		err = r.restoreBlocked(ctx, generationID, id)
+	}
+	if err != nil {
+		return r.mutationError(ctx, span, m.entity, modelID, id, err, "Failed to restore model trim")
+	}
+	return nil
+}
+
+// restoreBlocked объясняет отказ generationGuard при восстановлении
+// комплектации: ErrTrimNotFound, если удаленной комплектации нет, иначе
+// ErrGenerationDeleted
+func (r *VariantRepository) restoreBlocked(ctx context.Context, generationID, id uuid.UUID) error {
+	var exists bool
+	query := `-- name: VariantRepository.DeletedTrimExists
+		SELECT EXISTS(SELECT 1 FROM model_trims WHERE id = $1 AND generation_id = $2 AND is_deleted = true)`
+	if err := r.pool.QueryRow(ctx, query, id, generationID).Scan(&exists); err != nil {
+		return fmt.Errorf("unable to check model trim (%s) existence: %w", id, err)
+	}
+	if !exists {
+		return ErrTrimNotFound
+	}
+	return ErrGenerationDeleted
+}
+
+func trimMutation(operation string, modelID, generationID, id uuid.UUID) mutation[dto.ModelTrim] {
+	return mutation[dto.ModelTrim]{
+		entity:    audit.EntityModelTrim,
+		operation: operation,
+		guards:    []guard{modelGuard, generationGuard},
+		notFound:  ErrTrimNotFound,
+		setBrand:  func(t *dto.ModelTrim, brandID uuid.UUID) { t.BrandID = brandID },
+		args:      pgx.NamedArgs{"id": id, "model_id": modelID, "generation_id": generationID},
+		id:        id,
+	}
+}
+
+func setTrimArgs(args pgx.NamedArgs, t *dto.ModelTrim) {
+	args["name"] = t.Name
+	args["is_upcoming"] = t.IsUpcoming
+	args["is_limited"] = t.IsLimited
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Create сохраняет новую подписку
+func (r *WebhookRepository) Create(ctx context.Context, sub *dto.WebhookSubscription) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.Create")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.Create
+		INSERT INTO webhook_subscriptions (id, url, event_types, secret, description, is_active, created_by, created_at, updated_at)
+		VALUES (@id, @url, @event_types, @secret, @description, @is_active, @created_by, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+	args := pgx.NamedArgs{
+		"id":          sub.ID,
+		"url":         sub.URL,
+		"event_types": sub.EventTypes,
+		"secret":      sub.Secret,
+		"description": sub.Description,
+		"is_active":   sub.IsActive,
+		"created_by":  sub.CreatedBy,
+	}
+	err := r.pool.QueryRow(ctx, query, args).Scan(&sub.CreatedAt, &sub.UpdatedAt)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("webhook_url", sub.URL).Msg("Failed to create webhook subscription")
+		return fmt.Errorf("unable to create webhook subscription: %w", err)
+	}
+	return nil
+}
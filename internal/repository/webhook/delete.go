@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Delete удаляет подписку вместе с журналом ее доставок
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.Delete")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.Delete
+		DELETE FROM webhook_subscriptions WHERE id = $1
+	`
+	cmdTag, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("webhook_id", id.String()).Msg("Failed to delete webhook subscription")
+		return fmt.Errorf("unable to delete webhook subscription: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		span.LogFields(log.Error(ErrSubscriptionNotFound))
+		r.log.Warn().Ctx(ctx).Str("webhook_id", id.String()).Msg("No webhook subscription found to delete")
+		return ErrSubscriptionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"time"
+)
+
+// ClaimedDelivery доставка вместе с параметрами подписки, нужными для отправки
+type ClaimedDelivery struct {
+	dto.WebhookDelivery
+	URL    string
+	Secret string
+}
+
+// ClaimDue захватывает до limit доставок, срок отправки которых наступил.
+// Захват сдвигает next_attempt_at на lease: если экземпляр упадет, не
+// записав результат, доставка вернется в очередь по истечении аренды.
+// Доставки приостановленных подписок не захватываются.
+func (r *WebhookRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]ClaimedDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.ClaimDue")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.ClaimDue
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_subscriptions s ON s.id = d.subscription_id AND s.is_active
+			WHERE d.status = 'pending' AND d.next_attempt_at <= NOW()
+			ORDER BY d.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + $2::interval, updated_at = NOW()
+		FROM due, webhook_subscriptions s
+		WHERE d.id = due.id AND s.id = d.subscription_id
+		RETURNING d.*, s.url, s.secret
+	`
+	rows, err := r.pool.Query(ctx, query, limit, lease)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to claim webhook deliveries")
+		return nil, fmt.Errorf("unable to claim webhook deliveries: %w", err)
+	}
+	deliveries, err := pgx.CollectRows(rows, pgx.RowToStructByName[ClaimedDelivery])
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect claimed webhook deliveries")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// Enqueue создает доставки события подпискам. Повторная публикация того же
+// события не создает дублей благодаря уникальному индексу (subscription_id, event_id).
+func (r *WebhookRepository) Enqueue(
+	ctx context.Context,
+	eventID uuid.UUID,
+	eventType string,
+	payload []byte,
+	subscriptionIDs []uuid.UUID,
+) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.Enqueue")
+	defer span.Finish()
+
+	ids := make([]uuid.UUID, 0, len(subscriptionIDs))
+	for range subscriptionIDs {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return 0, fmt.Errorf("unable to generate webhook delivery id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	query := `
+		-- name: WebhookRepository.Enqueue
+		INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, next_attempt_at, created_at, updated_at)
+		SELECT d.id, d.subscription_id, $3, $4, $5, NOW(), NOW(), NOW()
+		FROM unnest($1::uuid[], $2::uuid[]) AS d (id, subscription_id)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+	cmdTag, err := r.pool.Exec(ctx, query, ids, subscriptionIDs, eventID, eventType, payload)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("event_id", eventID.String()).Msg("Failed to enqueue webhook deliveries")
+		return 0, fmt.Errorf("unable to enqueue webhook deliveries: %w", err)
+	}
+	span.SetTag("webhook.enqueued", cmdTag.RowsAffected())
+	return cmdTag.RowsAffected(), nil
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetDeliveries получает страницу журнала доставок подписки, от новых к старым
+func (r *WebhookRepository) GetDeliveries(ctx context.Context, filter dto.WebhookDeliveryFilter) (*dto.WebhookDeliveryPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.GetDeliveries")
+	defer span.Finish()
+
+	where := "WHERE subscription_id = @subscription_id"
+	args := pgx.NamedArgs{
+		"subscription_id": filter.SubscriptionID,
+		"limit":           filter.Limit,
+		"offset":          filter.Offset,
+	}
+	if filter.Status != "" {
+		where += " AND status = @status"
+		args["status"] = filter.Status
+	}
+
+	query := fmt.Sprintf(`
+		-- name: WebhookRepository.GetDeliveries
+		SELECT *, COUNT(*) OVER () AS total
+		FROM webhook_deliveries
+		%s
+		ORDER BY created_at DESC, id DESC
+		LIMIT @limit OFFSET @offset
+	`, where)
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to execute webhook deliveries query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	type deliveryRow struct {
+		dto.WebhookDelivery
+		Total int64
+	}
+	collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[deliveryRow])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", "GetDeliveries").Msg("Failed to collect rows into webhook deliveries")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+
+	page := &dto.WebhookDeliveryPage{
+		Deliveries: make([]dto.WebhookDelivery, 0, len(collected)),
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+	for _, row := range collected {
+		page.Deliveries = append(page.Deliveries, row.WebhookDelivery)
+		page.Total = row.Total
+	}
+	if len(collected) == 0 && filter.Offset > 0 {
+		// За пределами последней страницы оконная функция ничего не вернет
+		countQuery := fmt.Sprintf(`-- name: WebhookRepository.CountDeliveries
+			SELECT COUNT(*) FROM webhook_deliveries %s`, where)
+		delete(args, "limit")
+		delete(args, "offset")
+		if err = r.pool.QueryRow(ctx, countQuery, args).Scan(&page.Total); err != nil {
+			span.LogFields(log.Error(err))
+			r.log.Error().Ctx(ctx).Err(err).Msg("Failed to count webhook deliveries")
+			return nil, fmt.Errorf("error counting webhook deliveries: %w", err)
+		}
+	}
+	return page, nil
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// maxErrorLength ограничивает размер сохраняемой ошибки доставки
+const maxErrorLength = 1024
+
+// MarkDelivered фиксирует успешную попытку доставки. leasedUntil — срок
+// аренды из захвата: результат записывается, только пока доставка в очереди
+// и не захвачена заново или переотправлена, иначе возвращается ErrLeaseLost.
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id uuid.UUID, leasedUntil time.Time, statusCode int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.MarkDelivered")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.MarkDelivered
+		UPDATE webhook_deliveries
+		SET status = 'delivered',
+		    attempts = attempts + 1,
+		    last_status_code = $2,
+		    last_error = NULL,
+		    delivered_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1 AND status = 'pending' AND next_attempt_at = $3
+	`
+	tag, err := r.pool.Exec(ctx, query, id, statusCode, leasedUntil)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("delivery_id", id.String()).Msg("Failed to mark webhook delivery delivered")
+		return fmt.Errorf("unable to mark webhook delivery delivered: %w", err)
+	}
+	return r.leaseResult(ctx, id, tag.RowsAffected())
+}
+
+// MarkFailed фиксирует неудачную попытку и откладывает следующую на retryIn.
+// Если retryIn равен nil, попытки исчерпаны и доставка переводится в статус dead.
+// Как и MarkDelivered, записывает результат только в пределах аренды leasedUntil.
+func (r *WebhookRepository) MarkFailed(
+	ctx context.Context,
+	id uuid.UUID,
+	leasedUntil time.Time,
+	statusCode *int,
+	message string,
+	retryIn *time.Duration,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.MarkFailed")
+	defer span.Finish()
+
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+	query := `
+		-- name: WebhookRepository.MarkFailed
+		UPDATE webhook_deliveries
+		SET status = CASE WHEN @retry_in::interval IS NULL THEN 'dead' ELSE 'pending' END,
+		    attempts = attempts + 1,
+		    next_attempt_at = COALESCE(NOW() + @retry_in::interval, next_attempt_at),
+		    last_status_code = @status_code,
+		    last_error = @error,
+		    updated_at = NOW()
+		WHERE id = @id AND status = 'pending' AND next_attempt_at = @leased_until
+	`
+	args := pgx.NamedArgs{
+		"id":           id,
+		"leased_until": leasedUntil,
+		"status_code":  statusCode,
+		"error":        message,
+		"retry_in":     retryIn,
+	}
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("delivery_id", id.String()).Msg("Failed to record webhook delivery failure")
+		return fmt.Errorf("unable to record webhook delivery failure: %w", err)
+	}
+	return r.leaseResult(ctx, id, tag.RowsAffected())
+}
+
+// leaseResult сообщает об устаревшем результате попытки: пока она шла,
+// аренда истекла и доставку захватил другой отправитель, или ее
+// переотправили вручную. Новый исход важнее, поэтому запись пропускается.
+func (r *WebhookRepository) leaseResult(ctx context.Context, id uuid.UUID, updated int64) error {
+	if updated > 0 {
+		return nil
+	}
+	r.log.Warn().Ctx(ctx).Str("delivery_id", id.String()).Msg("Webhook delivery result discarded, lease lost")
+	return ErrLeaseLost
+}
+
+// Redeliver возвращает доставку подписки в очередь с обнуленным счетчиком попыток
+func (r *WebhookRepository) Redeliver(ctx context.Context, subscriptionID, id uuid.UUID) (*dto.WebhookDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.Redeliver")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.Redeliver
+		UPDATE webhook_deliveries
+		SET status = 'pending',
+		    attempts = 0,
+		    next_attempt_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1 AND subscription_id = $2
+		RETURNING *
+	`
+	rows, err := r.pool.Query(ctx, query, id, subscriptionID)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("delivery_id", id.String()).Msg("Failed to redeliver webhook")
+		return nil, fmt.Errorf("unable to redeliver webhook: %w", err)
+	}
+	delivery, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.WebhookDelivery])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogFields(log.Error(ErrDeliveryNotFound))
+			r.log.Warn().Ctx(ctx).Str("delivery_id", id.String()).Msg("No webhook delivery found to redeliver")
+			return nil, ErrDeliveryNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("delivery_id", id.String()).Msg("Failed to collect redelivered webhook")
+		return nil, fmt.Errorf("unable to redeliver webhook: %w", err)
+	}
+	return delivery, nil
+}
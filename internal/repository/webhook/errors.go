@@ -0,0 +1,9 @@
+package webhook
+
+import "github.com/pkg/errors"
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrLeaseLost            = errors.New("webhook delivery lease expired or delivery changed")
+)
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// GetAll получает все подписки
+func (r *WebhookRepository) GetAll(ctx context.Context) ([]dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.GetAll")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.GetAll
+		SELECT *
+		FROM webhook_subscriptions
+		ORDER BY created_at DESC
+	`
+	return r.getMany(ctx, span, "GetAll", query)
+}
+
+// GetMatching получает активные подписки на тип события
+func (r *WebhookRepository) GetMatching(ctx context.Context, eventType string) ([]dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.GetMatching")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.GetMatching
+		SELECT *
+		FROM webhook_subscriptions
+		WHERE is_active AND event_types && ARRAY[$1, '*']::TEXT[]
+	`
+	return r.getMany(ctx, span, "GetMatching", query, eventType)
+}
+
+func (r *WebhookRepository) getMany(
+	ctx context.Context,
+	span opentracing.Span,
+	operation, query string,
+	args ...any,
+) ([]dto.WebhookSubscription, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", operation).Msg("Failed to execute webhook subscriptions query")
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	defer rows.Close()
+
+	subs, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.WebhookSubscription])
+	if err != nil {
+		span.LogFields(
+			log.Error(err),
+			log.String("event", "collect_rows_error"),
+		)
+		r.log.Error().Ctx(ctx).Err(err).Str("operation", operation).Msg("Failed to collect rows into webhook subscriptions")
+		return nil, fmt.Errorf("error collecting rows: %w", err)
+	}
+	return subs, nil
+}
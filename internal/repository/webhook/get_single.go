@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// GetByID получает подписку по ID
+func (r *WebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.GetByID")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.GetByID
+		SELECT *
+		FROM webhook_subscriptions
+		WHERE id = $1
+	`
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("webhook_id", id.String()).Msg("Failed to fetch webhook subscription")
+		return nil, fmt.Errorf("unable to get webhook subscription: %w", err)
+	}
+
+	sub, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[dto.WebhookSubscription])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Msg("Failed to collect webhook subscription row")
+		return nil, fmt.Errorf("unable to collect rows: %w", err)
+	}
+	return sub, nil
+}
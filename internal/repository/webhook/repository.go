@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type WebhookRepository struct {
+	ctx  context.Context
+	pool *pgxpool.Pool
+	log  zerolog.Logger
+}
+
+func New(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger zerolog.Logger,
+) (*WebhookRepository, error) {
+	return &WebhookRepository{ctx: ctx, pool: pool, log: logger}, nil
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// Update заменяет параметры подписки; пустой секрет оставляет прежний
+func (r *WebhookRepository) Update(ctx context.Context, sub *dto.WebhookSubscription) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookRepository.Update")
+	defer span.Finish()
+
+	query := `
+		-- name: WebhookRepository.Update
+		UPDATE webhook_subscriptions
+		SET url = @url,
+		    event_types = @event_types,
+		    secret = COALESCE(NULLIF(@secret, ''), secret),
+		    description = @description,
+		    is_active = @is_active,
+		    updated_at = NOW()
+		WHERE id = @id
+		RETURNING *
+	`
+	args := pgx.NamedArgs{
+		"id":          sub.ID,
+		"url":         sub.URL,
+		"event_types": sub.EventTypes,
+		"secret":      sub.Secret,
+		"description": sub.Description,
+		"is_active":   sub.IsActive,
+	}
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("webhook_id", sub.ID.String()).Msg("Failed to update webhook subscription")
+		return fmt.Errorf("unable to update webhook subscription: %w", err)
+	}
+	updated, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.WebhookSubscription])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogFields(log.Error(ErrSubscriptionNotFound))
+			r.log.Warn().Ctx(ctx).Str("webhook_id", sub.ID.String()).Msg("No webhook subscription found to update")
+			return ErrSubscriptionNotFound
+		}
+		span.LogFields(log.Error(err))
+		r.log.Error().Ctx(ctx).Err(err).Str("webhook_id", sub.ID.String()).Msg("Failed to collect updated webhook subscription")
+		return fmt.Errorf("unable to update webhook subscription: %w", err)
+	}
+	*sub = updated
+	return nil
+}
@@ -0,0 +1,30 @@
+package retention
+
+import "time"
+
+const (
+	defaultPeriod    = 30 * 24 * time.Hour
+	defaultInterval  = time.Hour
+	defaultBatchSize = 100
+)
+
+// Config настройки безвозвратного удаления записей из корзины
+type Config struct {
+	Enabled   bool          `yaml:"enabled"`    // Удалять записи с истекшим сроком хранения
+	Period    time.Duration `yaml:"period"`     // Сколько запись хранится в корзине
+	Interval  time.Duration `yaml:"interval"`   // Период запуска очистки
+	BatchSize int           `yaml:"batch_size"` // Максимум записей за одну транзакцию
+}
+
+func (c Config) withDefaults() Config {
+	if c.Period <= 0 {
+		c.Period = defaultPeriod
+	}
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	return c
+}
@@ -0,0 +1,107 @@
+package retention
+
+import (
+	"Brands/internal/auth"
+	"Brands/internal/metrics"
+	"Brands/internal/repository/audit"
+	brandrepo "Brands/internal/repository/brand"
+	modelrepo "Brands/internal/repository/model"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// actor автор безвозвратных удалений в журнале аудита
+const actor = "system:retention"
+
+// Job периодически безвозвратно удаляет бренды и модели, находящиеся в
+// корзине дольше срока хранения
+type Job struct {
+	cfg    Config
+	brands *brandrepo.BrandRepository
+	models *modelrepo.ModelRepository
+	log    zerolog.Logger
+}
+
+// New создает задачу очистки корзины
+func New(cfg Config, brands *brandrepo.BrandRepository, models *modelrepo.ModelRepository, logger zerolog.Logger) *Job {
+	return &Job{
+		cfg:    cfg.withDefaults(),
+		brands: brands,
+		models: models,
+		log:    logger,
+	}
+}
+
+// Run очищает корзину при запуске и далее с периодом Interval до отмены ctx
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge удаляет пачки, пока записи с истекшим сроком не закончатся.
+// Сначала удаляются отдельно удаленные модели, затем бренды вместе с
+// оставшимися моделями.
+func (j *Job) purge(ctx context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RetentionJob.Purge")
+	defer span.Finish()
+	ctx = context.WithValue(ctx, auth.ActorKey, actor)
+	span.SetTag("retention.period", j.cfg.Period.String())
+
+	var models, brands int
+	for ctx.Err() == nil {
+		purged, err := j.models.PurgeExpired(ctx, j.cfg.Period, j.cfg.BatchSize)
+		if err != nil {
+			j.fail(span, err)
+			return
+		}
+		models += purged
+		if purged < j.cfg.BatchSize {
+			break
+		}
+	}
+	for ctx.Err() == nil {
+		purged, cascaded, err := j.brands.PurgeExpired(ctx, j.cfg.Period, j.cfg.BatchSize)
+		if err != nil {
+			j.fail(span, err)
+			return
+		}
+		brands += purged
+		models += cascaded
+		if purged < j.cfg.BatchSize {
+			break
+		}
+	}
+
+	metrics.RetentionPurged.WithLabelValues(audit.EntityBrand).Add(float64(brands))
+	metrics.RetentionPurged.WithLabelValues(audit.EntityModel).Add(float64(models))
+	span.SetTag("brands.purged", brands)
+	span.SetTag("models.purged", models)
+	if brands > 0 || models > 0 {
+		j.log.Info().Ctx(ctx).
+			Int("brands", brands).
+			Int("models", models).
+			Dur("period", j.cfg.Period).
+			Msg("Expired records purged from trash")
+	}
+}
+
+func (j *Job) fail(span opentracing.Span, err error) {
+	span.SetTag("error", true)
+	span.LogFields(
+		log.String("event", "purge_error"),
+		log.Error(err),
+	)
+	j.log.Error().Err(err).Msg("Failed to purge expired records, will retry")
+}
@@ -0,0 +1,71 @@
+package apikey
+
+import (
+	"Brands/internal/auth"
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+// Issue выпускает новый API-ключ; секрет возвращается только в ответе
+func (s *APIKeyService) Issue(ctx context.Context, req *dto.APIKeyRequest) (*dto.IssuedAPIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyService.Issue")
+	defer span.Finish()
+
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	secret, prefix, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := dto.APIKey{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    prefix,
+		KeyHash:   hashKey(secret),
+		Scopes:    req.Scopes,
+		Roles:     req.Roles,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		key.CreatedBy = principal.Subject
+	}
+
+	if err = s.repo.Create(ctx, &key); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("api_key_id", key.ID.String()).
+		Str("api_key_name", key.Name).
+		Strs("scopes", key.Scopes).
+		Strs("roles", key.Roles).
+		Msg("API key issued")
+	return &dto.IssuedAPIKey{APIKey: key, Secret: secret}, nil
+}
+
+func validateRequest(req *dto.APIKeyRequest) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return ErrEmptyName
+	}
+	if len(req.Scopes) == 0 {
+		return ErrEmptyScopes
+	}
+	for _, scope := range req.Scopes {
+		if !auth.KnownScope(scope) {
+			return errors.Wrap(ErrUnknownScope, scope)
+		}
+	}
+	if req.Roles == nil {
+		req.Roles = []string{}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return ErrAlreadyExpired
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package apikey
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Revoke отзывает API-ключ
+func (s *APIKeyService) Revoke(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyService.Revoke")
+	defer span.Finish()
+
+	if err := s.repo.Revoke(ctx, id); err != nil {
+		return err
+	}
+	s.log.Info().Ctx(ctx).Str("api_key_id", id.String()).Msg("API key revoked")
+	return nil
+}
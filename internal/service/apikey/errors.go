@@ -0,0 +1,10 @@
+package apikey
+
+import "github.com/pkg/errors"
+
+var (
+	ErrEmptyName      = errors.New("api key name is required")
+	ErrEmptyScopes    = errors.New("at least one scope is required")
+	ErrUnknownScope   = errors.New("unknown scope")
+	ErrAlreadyExpired = errors.New("expires_at must be in the future")
+)
@@ -0,0 +1,15 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetAll получает все API-ключи без секретов
+func (s *APIKeyService) GetAll(ctx context.Context) ([]dto.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyService.GetAll")
+	defer span.Finish()
+
+	return s.repo.GetAll(ctx)
+}
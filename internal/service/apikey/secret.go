@@ -0,0 +1,43 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// keyPrefix отличает ключи сервиса от прочих секретов, в том числе при сканировании репозиториев
+	keyPrefix   = "bk"
+	prefixBytes = 6
+	secretBytes = 32
+)
+
+// generateKey создает ключ вида bk_<prefix>_<secret> и возвращает его открытый префикс
+func generateKey() (key, prefix string, err error) {
+	buf := make([]byte, prefixBytes+secretBytes)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("unable to generate api key: %w", err)
+	}
+	prefix = hex.EncodeToString(buf[:prefixBytes])
+	secret := base64.RawURLEncoding.EncodeToString(buf[prefixBytes:])
+	return fmt.Sprintf("%s_%s_%s", keyPrefix, prefix, secret), prefix, nil
+}
+
+// parsePrefix извлекает открытый префикс из ключа
+func parsePrefix(key string) (string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != keyPrefix || len(parts[1]) != hex.EncodedLen(prefixBytes) || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// hashKey возвращает SHA-256 от полного ключа; сам ключ не сохраняется
+func hashKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"Brands/internal/repository/apikey"
+	"github.com/rs/zerolog"
+)
+
+// APIKeyService представляет слой сервиса для работы с API-ключами
+type APIKeyService struct {
+	repo *apikey.APIKeyRepository
+	log  zerolog.Logger
+}
+
+// New создает новый экземпляр APIKeyService
+func New(
+	repo *apikey.APIKeyRepository,
+	logger zerolog.Logger,
+) *APIKeyService {
+	return &APIKeyService{
+		repo: repo,
+		log:  logger,
+	}
+}
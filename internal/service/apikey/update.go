@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Rotate выпускает новый секрет для существующего ключа; старый секрет
+// перестает действовать сразу, имя, scope и срок действия сохраняются
+func (s *APIKeyService) Rotate(ctx context.Context, id uuid.UUID) (*dto.IssuedAPIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyService.Rotate")
+	defer span.Finish()
+
+	key, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, prefix, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	key.Prefix = prefix
+	key.KeyHash = hashKey(secret)
+	key.LastUsedAt = nil
+
+	if err = s.repo.Rotate(ctx, key); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("api_key_id", key.ID.String()).
+		Str("api_key_name", key.Name).
+		Msg("API key rotated")
+	return &dto.IssuedAPIKey{APIKey: *key, Secret: secret}, nil
+}
@@ -0,0 +1,60 @@
+package apikey
+
+import (
+	"Brands/internal/auth"
+	"context"
+	"crypto/subtle"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"time"
+
+	apikeyrepo "Brands/internal/repository/apikey"
+)
+
+var _ auth.APIKeyVerifier = (*APIKeyService)(nil)
+
+// VerifyAPIKey проверяет ключ из заголовка X-API-Key и возвращает его субъекта
+func (s *APIKeyService) VerifyAPIKey(ctx context.Context, secret string) (*auth.Principal, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "APIKeyService.VerifyAPIKey")
+	defer span.Finish()
+
+	prefix, ok := parsePrefix(secret)
+	if !ok {
+		return nil, errors.Wrap(auth.ErrInvalidAPIKey, "malformed key")
+	}
+
+	key, err := s.repo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, apikeyrepo.ErrAPIKeyNotFound) {
+			return nil, errors.Wrap(auth.ErrInvalidAPIKey, "unknown key")
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(key.KeyHash, hashKey(secret)) != 1 {
+		return nil, errors.Wrap(auth.ErrInvalidAPIKey, "unknown key")
+	}
+	if key.RevokedAt != nil {
+		return nil, errors.Wrap(auth.ErrInvalidAPIKey, "key revoked")
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return nil, errors.Wrap(auth.ErrInvalidAPIKey, "key expired")
+	}
+
+	span.SetTag("api_key.id", key.ID.String())
+	if err = s.repo.TouchLastUsed(ctx, key.ID); err != nil {
+		// Учет использования не должен блокировать запрос
+		s.log.Warn().Ctx(ctx).Err(err).Str("api_key_id", key.ID.String()).Msg("Failed to record api key usage")
+	}
+
+	principal := &auth.Principal{
+		Kind:    auth.PrincipalAPIKey,
+		Subject: key.ID.String(),
+		Name:    key.Name,
+		Scopes:  make(map[string]struct{}, len(key.Scopes)),
+		Roles:   key.Roles,
+	}
+	for _, scope := range key.Scopes {
+		principal.Scopes[scope] = struct{}{}
+	}
+	return principal, nil
+}
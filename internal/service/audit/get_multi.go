@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetAll получает страницу журнала изменений по фильтру
+func (s *AuditService) GetAll(ctx context.Context, filter dto.AuditFilter) (*dto.AuditPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "AuditService.GetAll")
+	defer span.Finish()
+
+	page, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
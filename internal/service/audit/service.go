@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"Brands/internal/repository/audit"
+	"github.com/rs/zerolog"
+)
+
+// AuditService представляет слой сервиса для чтения журнала изменений
+type AuditService struct {
+	repo *audit.AuditRepository
+	log  zerolog.Logger
+}
+
+// New создает новый экземпляр AuditService
+func New(
+	repo *audit.AuditRepository,
+	logger zerolog.Logger,
+) *AuditService {
+	return &AuditService{
+		repo: repo,
+		log:  logger,
+	}
+}
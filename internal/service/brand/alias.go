@@ -0,0 +1,80 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"strings"
+	"unicode/utf8"
+)
+
+// aliasMaxLength максимальная длина альтернативного названия в символах
+const aliasMaxLength = 255
+
+// aliasFields поле бренда, право на запись которого требуется для изменения
+// альтернативных названий
+var aliasFields = []string{"aliases"}
+
+// Aliases получает альтернативные названия бренда
+func (s *BrandService) Aliases(ctx context.Context, brandID uuid.UUID) ([]dto.BrandAlias, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Aliases")
+	defer span.Finish()
+	return s.repo.GetAliases(ctx, brandID)
+}
+
+// CreateAlias добавляет бренду альтернативное название
+func (s *BrandService) CreateAlias(ctx context.Context, alias *dto.BrandAlias) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.CreateAlias")
+	defer span.Finish()
+	if err := normalizeAlias(alias); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.ActionUpdate, aliasFields); err != nil {
+		return err
+	}
+	return s.repo.CreateAlias(ctx, alias)
+}
+
+// UpdateAlias изменяет альтернативное название бренда
+func (s *BrandService) UpdateAlias(ctx context.Context, alias *dto.BrandAlias) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.UpdateAlias")
+	defer span.Finish()
+	if err := normalizeAlias(alias); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.ActionUpdate, aliasFields); err != nil {
+		return err
+	}
+	return s.repo.UpdateAlias(ctx, alias)
+}
+
+// DeleteAlias удаляет альтернативное название бренда
+func (s *BrandService) DeleteAlias(ctx context.Context, brandID, aliasID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.DeleteAlias")
+	defer span.Finish()
+	if err := s.authorize(ctx, rbac.ActionUpdate, aliasFields); err != nil {
+		return err
+	}
+	return s.repo.DeleteAlias(ctx, brandID, aliasID)
+}
+
+// normalizeAlias обрезает пробелы названия и строит его слаг: по слагу
+// названия сравниваются между собой и с адресами брендов
+func normalizeAlias(alias *dto.BrandAlias) error {
+	alias.Alias = strings.TrimSpace(alias.Alias)
+	if alias.Alias == "" {
+		return ErrAliasRequired
+	}
+	if utf8.RuneCountInString(alias.Alias) > aliasMaxLength {
+		return ErrAliasTooLong
+	}
+	alias.Slug = slug.Make(alias.Alias)
+	if alias.Slug == "" {
+		return fmt.Errorf("%w: unable to derive slug from alias %q", slug.ErrInvalid, alias.Alias)
+	}
+	return nil
+}
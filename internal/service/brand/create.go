@@ -2,6 +2,8 @@ package brand
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
 	"context"
 	"github.com/opentracing/opentracing-go"
 )
@@ -10,7 +12,24 @@ import (
 func (s *BrandService) Create(ctx context.Context, brand *dto.Brand) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Create")
 	defer span.Finish()
-	err := s.repo.Create(ctx, brand)
+	slugValue, derived, err := slug.Resolve(brand.Slug, brand.Name)
+	if err != nil {
+		return err
+	}
+	brand.Slug = slugValue
+	if brand.ParentID != nil && *brand.ParentID == brand.ID {
+		return ErrOwnParent
+	}
+	// Альтернативные названия добавляются отдельными запросами, а цепочка
+	// родителей строится по parent_id
+	brand.Aliases = nil
+	brand.Path = nil
+	fields := writableFields(rbac.ChangedFields(dto.Brand{}, *brand), derived)
+	if err = s.authorize(ctx, rbac.ActionCreate, fields); err != nil {
+		return err
+	}
+
+	err = s.repo.Create(ctx, brand)
 
 	if err != nil {
 
@@ -0,0 +1,15 @@
+package brand
+
+import "github.com/pkg/errors"
+
+var (
+	ErrMergeSourceRequired  = errors.New("source_id is required")
+	ErrMergeIntoSelf        = errors.New("brand cannot be merged into itself")
+	ErrUnknownMergeStrategy = errors.New("unknown merge strategy")
+	ErrUnknownMergeField    = errors.New("field cannot be merged")
+	ErrAliasRequired        = errors.New("alias is required")
+	ErrAliasTooLong         = errors.New("alias must be at most 255 characters")
+	ErrOwnParent            = errors.New("brand cannot be its own parent")
+	ErrInvalidDepth         = errors.New("depth is out of range")
+	ErrLocalized            = errors.New("brand contains translated values, fetch it without lang to update")
+)
@@ -15,7 +15,10 @@ func (s *BrandService) GetAll(ctx context.Context) ([]dto.Brand, error) {
 	if err != nil {
 		return nil, err
 	}
-	s.log.Info().
+	if err = s.localizeAll(ctx, brands); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
 		Int("brands_count", len(brands)).
 		Msg("Successfully fetched brands in BrandService.GetAll")
 	return brands, nil
@@ -29,7 +32,7 @@ func (s *BrandService) BrandsFilter(
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.GetAll")
 	defer span.Finish()
 
-	s.log.Info().
+	s.log.Info().Ctx(ctx).
 		Interface("filter", filter).
 		Str("sortBy", sortBy).
 		Msg("Fetching all brands with filters and sorting")
@@ -39,7 +42,10 @@ func (s *BrandService) BrandsFilter(
 	if err != nil {
 		return nil, err
 	}
-	s.log.Info().
+	if err = s.localizeAll(ctx, brands); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
 		Int("brands_count", len(brands)).
 		Msg("Successfully fetched brands in BrandService.BrandsFilter")
 	return brands, nil
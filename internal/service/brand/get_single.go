@@ -15,5 +15,8 @@ func (s *BrandService) GetByID(ctx context.Context, id uuid.UUID) (*dto.Brand, e
 	if err != nil {
 		return nil, err
 	}
+	if err = s.localize(ctx, brand); err != nil {
+		return nil, err
+	}
 	return brand, nil
 }
@@ -0,0 +1,55 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+
+	brandrepo "Brands/internal/repository/brand"
+)
+
+// Children получает бренды ниже бренда id до depth уровней (1 — только
+// дочерние) в порядке обхода дерева
+func (s *BrandService) Children(ctx context.Context, id uuid.UUID, depth int) ([]dto.BrandNode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Children")
+	defer span.Finish()
+	span.SetTag("depth", depth)
+
+	if depth < 1 || depth > brandrepo.MaxHierarchyDepth {
+		return nil, ErrInvalidDepth
+	}
+	nodes, err := s.repo.GetChildren(ctx, id, depth)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.localizeNodes(ctx, nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// Ancestors получает родительские бренды бренда id от корня до
+// непосредственного родителя
+func (s *BrandService) Ancestors(ctx context.Context, id uuid.UUID) ([]dto.BrandNode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Ancestors")
+	defer span.Finish()
+
+	nodes, err := s.repo.GetAncestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.localizeNodes(ctx, nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// localizeNodes подставляет переводы в бренды иерархии
+func (s *BrandService) localizeNodes(ctx context.Context, nodes []dto.BrandNode) error {
+	refs := make([]*dto.Brand, len(nodes))
+	for i := range nodes {
+		refs[i] = &nodes[i].Brand
+	}
+	return s.localize(ctx, refs...)
+}
@@ -0,0 +1,26 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/i18n"
+	"context"
+)
+
+// localize подставляет в бренды переводы на язык запроса; без выбранного
+// языка или для основного языка бренды не меняются
+func (s *BrandService) localize(ctx context.Context, brands ...*dto.Brand) error {
+	locale, ok := i18n.LocaleFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return s.translations.LocalizeBrands(ctx, locale.Chain, brands...)
+}
+
+// localizeAll подставляет переводы в бренды выборки
+func (s *BrandService) localizeAll(ctx context.Context, brands []dto.Brand) error {
+	refs := make([]*dto.Brand, len(brands))
+	for i := range brands {
+		refs[i] = &brands[i]
+	}
+	return s.localize(ctx, refs...)
+}
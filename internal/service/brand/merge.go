@@ -0,0 +1,116 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+// mergeableFields поля бренда, которые слияние может заполнить значениями
+// источника, в порядке их вывода. Название и слаг цели не меняются: слаг
+// источника становится перенаправлением на цель.
+var mergeableFields = []struct {
+	name  string
+	merge func(target *dto.Brand, source dto.Brand, preferSource bool) bool
+}{
+	{"link", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.Link, s.Link, p) }},
+	{"description", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.Description, s.Description, p) }},
+	{"logo_url", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.LogoURL, s.LogoURL, p) }},
+	{"cover_image_url", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.CoverImageURL, s.CoverImageURL, p) }},
+	{"founded_year", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.FoundedYear, s.FoundedYear, p) }},
+	{"origin_country", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.OriginCountry, s.OriginCountry, p) }},
+	{"popularity", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeValue(&t.Popularity, s.Popularity, p) }},
+	{"is_premium", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeFlag(&t.IsPremium, s.IsPremium, p) }},
+	{"is_upcoming", func(t *dto.Brand, s dto.Brand, p bool) bool { return mergeFlag(&t.IsUpcoming, s.IsUpcoming, p) }},
+}
+
+// mergeValue переносит непустое значение источника в цель: при preferSource
+// всегда, иначе только если значение цели пустое
+func mergeValue[T comparable](target *T, source T, preferSource bool) bool {
+	var empty T
+	if source == empty || *target == source {
+		return false
+	}
+	if *target != empty && !preferSource {
+		return false
+	}
+	*target = source
+	return true
+}
+
+// mergeFlag переносит флаг источника в цель только при preferSource: у
+// флага нет пустого значения, и false цели — такое же значение, как true
+func mergeFlag(target *bool, source bool, preferSource bool) bool {
+	if !preferSource || *target == source {
+		return false
+	}
+	*target = source
+	return true
+}
+
+// Merge сливает бренд-дубликат req.SourceID с брендом id. При req.DryRun
+// возвращает предпросмотр, ничего не изменяя.
+func (s *BrandService) Merge(ctx context.Context, id uuid.UUID, req dto.BrandMergeRequest) (*dto.BrandMergeResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Merge")
+	defer span.Finish()
+
+	if err := validateMergeRequest(id, &req); err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, rbac.ActionMerge, nil); err != nil {
+		return nil, err
+	}
+	return s.repo.Merge(ctx, id, req.SourceID, func(target *dto.Brand, source dto.Brand) []string {
+		merged := []string{}
+		for _, field := range mergeableFields {
+			strategy := req.Strategy
+			if override, ok := req.Fields[field.name]; ok {
+				strategy = override
+			}
+			if field.merge(target, source, strategy == dto.MergePreferSource) {
+				merged = append(merged, field.name)
+			}
+		}
+		return merged
+	}, req.DryRun)
+}
+
+func validateMergeRequest(id uuid.UUID, req *dto.BrandMergeRequest) error {
+	if req.SourceID == uuid.Nil {
+		return ErrMergeSourceRequired
+	}
+	if req.SourceID == id {
+		return ErrMergeIntoSelf
+	}
+	if req.Strategy == "" {
+		req.Strategy = dto.MergeKeepTarget
+	}
+	if !knownMergeStrategy(req.Strategy) {
+		return errors.Wrap(ErrUnknownMergeStrategy, req.Strategy)
+	}
+	for field, strategy := range req.Fields {
+		if !mergeableField(field) {
+			return errors.Wrap(ErrUnknownMergeField, field)
+		}
+		if !knownMergeStrategy(strategy) {
+			return errors.Wrap(ErrUnknownMergeStrategy, strategy)
+		}
+	}
+	return nil
+}
+
+func knownMergeStrategy(strategy string) bool {
+	return strategy == dto.MergeKeepTarget || strategy == dto.MergePreferSource
+}
+
+func mergeableField(name string) bool {
+	for _, field := range mergeableFields {
+		if field.name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package brand
+
+import (
+	"Brands/internal/rbac"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// authorize проверяет по политике RBAC право на действие с бренда
+// и на запись перечисленных полей
+func (s *BrandService) authorize(ctx context.Context, action string, fields []string) error {
+	err := s.policy.AuthorizeFields(ctx, rbac.EntityBrand, action, fields)
+	if err != nil {
+		if span := opentracing.SpanFromContext(ctx); span != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "rbac_forbidden"),
+				log.Error(err),
+			)
+		}
+		s.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("action", action).
+			Strs("fields", fields).
+			Msg("Brand action forbidden")
+	}
+	return err
+}
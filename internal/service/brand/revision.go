@@ -0,0 +1,98 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/revision"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"time"
+
+	brandrepo "Brands/internal/repository/brand"
+)
+
+// Revisions получает версии бренда от новых к старым
+func (s *BrandService) Revisions(ctx context.Context, id uuid.UUID, limit, offset int) ([]dto.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Revisions")
+	defer span.Finish()
+
+	revisions, err := s.revisions.GetAll(ctx, audit.EntityBrand, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 && offset == 0 {
+		return nil, brandrepo.ErrBrandNotFound
+	}
+	return revisions, nil
+}
+
+// GetAsOf получает бренд в состоянии на момент asOf; удаленный на тот момент
+// бренд считается ненайденным. Переводы не версионируются, поэтому бренд
+// возвращается на основном языке.
+func (s *BrandService) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*dto.Brand, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.GetAsOf")
+	defer span.Finish()
+
+	rev, err := s.revisions.GetAsOf(ctx, audit.EntityBrand, id, asOf)
+	if err != nil {
+		if errors.Is(err, revision.ErrRevisionNotFound) {
+			return nil, brandrepo.ErrBrandNotFound
+		}
+		return nil, err
+	}
+	brand, err := decodeBrand(rev)
+	if err != nil {
+		return nil, err
+	}
+	if brand.IsDeleted {
+		return nil, brandrepo.ErrBrandNotFound
+	}
+	if brand.Aliases == nil {
+		// Версии, записанные до появления альтернативных названий
+		brand.Aliases = []string{}
+	}
+	if brand.Path == nil {
+		// Версии, записанные до появления иерархии брендов
+		brand.Path = []dto.BrandRef{}
+	}
+	return brand, nil
+}
+
+// Revert возвращает бренд к версии rev через обычный путь обновления:
+// с проверкой прав, записью аудита и созданием новой версии
+func (s *BrandService) Revert(ctx context.Context, id uuid.UUID, rev int) (*dto.Brand, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Revert")
+	defer span.Finish()
+	span.SetTag("revision", rev)
+
+	target, err := s.revisions.GetByNumber(ctx, audit.EntityBrand, id, rev)
+	if err != nil {
+		return nil, err
+	}
+	brand, err := decodeBrand(target)
+	if err != nil {
+		return nil, err
+	}
+	brand.ID = id
+
+	if err = s.Update(ctx, brand); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("brand_id", id.String()).
+		Int("revision", rev).
+		Msg("Brand reverted to revision")
+	return brand, nil
+}
+
+func decodeBrand(rev *dto.Revision) (*dto.Brand, error) {
+	var brand dto.Brand
+	if err := json.Unmarshal(rev.Data, &brand); err != nil {
+		return nil, fmt.Errorf("unable to decode revision %d: %w", rev.Revision, err)
+	}
+	return &brand, nil
+}
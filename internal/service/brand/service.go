@@ -1,23 +1,35 @@
 package brand
 
 import (
+	"Brands/internal/rbac"
 	"Brands/internal/repository/brand"
+	"Brands/internal/repository/revision"
+	"Brands/internal/repository/translation"
 	"github.com/rs/zerolog"
 )
 
 // BrandService представляет слой сервиса для работы с брендами
 type BrandService struct {
-	repo *brand.BrandRepository
-	log  zerolog.Logger
+	repo         *brand.BrandRepository
+	revisions    *revision.RevisionRepository
+	translations *translation.TranslationRepository
+	policy       *rbac.Policy
+	log          zerolog.Logger
 }
 
 // New создает новый экземпляр BrandService
 func New(
 	repo *brand.BrandRepository,
+	revisions *revision.RevisionRepository,
+	translations *translation.TranslationRepository,
+	policy *rbac.Policy,
 	logger zerolog.Logger,
 ) *BrandService {
 	return &BrandService{
-		repo: repo,
-		log:  logger,
+		repo:         repo,
+		revisions:    revisions,
+		translations: translations,
+		policy:       policy,
+		log:          logger,
 	}
 }
@@ -0,0 +1,35 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"slices"
+)
+
+// GetBySlug получает бренд по актуальному или прежнему слагу
+func (s *BrandService) GetBySlug(ctx context.Context, value string) (*dto.Brand, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.GetBySlug")
+	defer span.Finish()
+	if !slug.Valid(value) {
+		return nil, slug.ErrInvalid
+	}
+	brand, err := s.repo.GetBySlug(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.localize(ctx, brand); err != nil {
+		return nil, err
+	}
+	return brand, nil
+}
+
+// writableFields исключает слаг, построенный из названия, из проверки прав
+// на поля: его смена следует из смены названия и покрывается правом на name
+func writableFields(fields []string, derivedSlug bool) []string {
+	if !derivedSlug {
+		return fields
+	}
+	return slices.DeleteFunc(fields, func(field string) bool { return field == "slug" })
+}
@@ -0,0 +1,35 @@
+package brand
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Trash получает страницу мягко удаленных брендов
+func (s *BrandService) Trash(ctx context.Context, filter dto.TrashFilter) (*dto.BrandTrashPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Trash")
+	defer span.Finish()
+	page, err := s.repo.GetTrash(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.localizeAll(ctx, page.Brands); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Purge безвозвратно удаляет бренд из корзины вместе с его моделями и
+// возвращает число удаленных моделей
+func (s *BrandService) Purge(ctx context.Context, id uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Purge")
+	defer span.Finish()
+
+	if err := s.authorize(ctx, rbac.ActionPurge, nil); err != nil {
+		return 0, err
+	}
+	return s.repo.Purge(ctx, id)
+}
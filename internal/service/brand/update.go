@@ -2,6 +2,8 @@ package brand
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
 	"context"
 	"github.com/opentracing/opentracing-go"
 )
@@ -10,7 +12,36 @@ import (
 func (s *BrandService) Update(ctx context.Context, brand *dto.Brand) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "BrandService.Update")
 	defer span.Finish()
-	err := s.repo.Update(ctx, brand)
+	// Локализованный ответ перезаписал бы основной язык переводом
+	if brand.Locale != nil {
+		return ErrLocalized
+	}
+	slugValue, derived, err := slug.Resolve(brand.Slug, brand.Name)
+	if err != nil {
+		return err
+	}
+	brand.Slug = slugValue
+	if brand.ParentID != nil && *brand.ParentID == brand.ID {
+		return ErrOwnParent
+	}
+	if s.policy.Enabled() {
+		// PUT передает запись целиком, поэтому права проверяются только
+		// для полей, значения которых действительно меняются
+		current, err := s.repo.GetByID(ctx, brand.ID)
+		if err != nil {
+			return err
+		}
+		// Альтернативные названия изменяются отдельными запросами, а цепочка
+		// родителей только для чтения; в PUT они не участвуют
+		brand.Aliases = current.Aliases
+		brand.Path = current.Path
+		fields := writableFields(rbac.ChangedFields(*current, *brand), derived)
+		if err = s.authorize(ctx, rbac.ActionUpdate, fields); err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.Update(ctx, brand)
 	if err != nil {
 		return err
 	}
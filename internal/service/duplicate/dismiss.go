@@ -0,0 +1,36 @@
+package duplicate
+
+import (
+	"Brands/internal/repository/audit"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// DismissBrands отклоняет открытые пары брендов внутри перечисленных ID
+func (s *DuplicateService) DismissBrands(ctx context.Context, ids []uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateService.DismissBrands")
+	defer span.Finish()
+	return s.dismiss(ctx, audit.EntityBrand, ids)
+}
+
+// DismissModels отклоняет открытые пары моделей внутри перечисленных ID
+func (s *DuplicateService) DismissModels(ctx context.Context, ids []uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateService.DismissModels")
+	defer span.Finish()
+	return s.dismiss(ctx, audit.EntityModel, ids)
+}
+
+func (s *DuplicateService) dismiss(ctx context.Context, entity string, ids []uuid.UUID) (int, error) {
+	if len(ids) < 2 {
+		return 0, ErrTooFewIDs
+	}
+	dismissed, err := s.repo.Dismiss(ctx, entity, ids)
+	if err != nil {
+		return 0, err
+	}
+	if dismissed == 0 {
+		return 0, ErrNothingToDismiss
+	}
+	return dismissed, nil
+}
@@ -0,0 +1,8 @@
+package duplicate
+
+import "github.com/pkg/errors"
+
+var (
+	ErrTooFewIDs        = errors.New("at least two ids are required")
+	ErrNothingToDismiss = errors.New("no open duplicate candidates among given ids")
+)
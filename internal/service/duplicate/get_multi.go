@@ -0,0 +1,98 @@
+package duplicate
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// BrandGroups получает страницу групп возможных дубликатов брендов.
+// Предлагаемая цель слияния — самый популярный бренд группы, при равенстве
+// самый ранний.
+func (s *DuplicateService) BrandGroups(ctx context.Context, filter dto.DuplicateFilter) (*dto.BrandDuplicatePage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateService.BrandGroups")
+	defer span.Finish()
+
+	pairs, brands, err := s.repo.BrandPairs(ctx, filter.MinScore)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]dto.Brand, len(brands))
+	for _, brand := range brands {
+		byID[brand.ID] = brand
+	}
+
+	clusters := clusterPairs(pairs)
+	from, to := window(len(clusters), filter.Limit, filter.Offset)
+	page := &dto.BrandDuplicatePage{
+		Groups: make([]dto.BrandDuplicateGroup, 0, to-from),
+		Total:  len(clusters),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, c := range clusters[from:to] {
+		group := dto.BrandDuplicateGroup{
+			Score:  c.score,
+			Brands: make([]dto.Brand, 0, len(c.members)),
+			Pairs:  c.pairs,
+		}
+		for _, id := range c.members {
+			brand := byID[id]
+			group.Brands = append(group.Brands, brand)
+			if target, ok := byID[group.SuggestedTargetID]; !ok || preferBrand(brand, target) {
+				group.SuggestedTargetID = brand.ID
+			}
+		}
+		page.Groups = append(page.Groups, group)
+	}
+	return page, nil
+}
+
+// ModelGroups получает страницу групп возможных дубликатов моделей.
+// Предлагается оставить самую раннюю модель группы.
+func (s *DuplicateService) ModelGroups(ctx context.Context, filter dto.DuplicateFilter) (*dto.ModelDuplicatePage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DuplicateService.ModelGroups")
+	defer span.Finish()
+
+	pairs, models, err := s.repo.ModelPairs(ctx, filter.BrandID, filter.MinScore)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]dto.Model, len(models))
+	for _, model := range models {
+		byID[model.ID] = model
+	}
+
+	clusters := clusterPairs(pairs)
+	from, to := window(len(clusters), filter.Limit, filter.Offset)
+	page := &dto.ModelDuplicatePage{
+		Groups: make([]dto.ModelDuplicateGroup, 0, to-from),
+		Total:  len(clusters),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for _, c := range clusters[from:to] {
+		group := dto.ModelDuplicateGroup{
+			Score:  c.score,
+			Models: make([]dto.Model, 0, len(c.members)),
+			Pairs:  c.pairs,
+		}
+		for _, id := range c.members {
+			model := byID[id]
+			group.Models = append(group.Models, model)
+			if target, ok := byID[group.SuggestedTargetID]; !ok || model.CreatedAt.Before(target.CreatedAt) {
+				group.SuggestedTargetID = model.ID
+			}
+		}
+		page.Groups = append(page.Groups, group)
+	}
+	return page, nil
+}
+
+func preferBrand(candidate, current dto.Brand) bool {
+	if candidate.Popularity != current.Popularity {
+		return candidate.Popularity > current.Popularity
+	}
+	return candidate.CreatedAt.Before(current.CreatedAt)
+}
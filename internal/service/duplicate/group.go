@@ -0,0 +1,80 @@
+package duplicate
+
+import (
+	"Brands/internal/dto"
+	"bytes"
+	"github.com/google/uuid"
+	"sort"
+)
+
+// cluster группа записей, связанных парами возможных дубликатов
+type cluster struct {
+	score   float64
+	members []uuid.UUID
+	pairs   []dto.DuplicatePair
+}
+
+// clusterPairs объединяет пары в группы связности: записи, связанные
+// цепочкой пар, попадают в одну группу. Группы упорядочены по убыванию
+// наибольшей оценки пары, пары в группе — в исходном порядке.
+func clusterPairs(pairs []dto.DuplicatePair) []cluster {
+	parent := make(map[uuid.UUID]uuid.UUID)
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		root, ok := parent[id]
+		if !ok {
+			parent[id] = id
+			return id
+		}
+		if root != id {
+			root = find(root)
+			parent[id] = root
+		}
+		return root
+	}
+	for _, pair := range pairs {
+		left, right := find(pair.LeftID), find(pair.RightID)
+		if left != right {
+			parent[right] = left
+		}
+	}
+
+	byRoot := make(map[uuid.UUID]*cluster)
+	var clusters []*cluster
+	for _, pair := range pairs {
+		root := find(pair.LeftID)
+		c, ok := byRoot[root]
+		if !ok {
+			c = &cluster{}
+			byRoot[root] = c
+			clusters = append(clusters, c)
+		}
+		c.score = max(c.score, pair.Score)
+		c.pairs = append(c.pairs, pair)
+	}
+	for id := range parent {
+		c := byRoot[find(id)]
+		c.members = append(c.members, id)
+	}
+
+	result := make([]cluster, 0, len(clusters))
+	for _, c := range clusters {
+		sort.Slice(c.members, func(i, k int) bool {
+			return bytes.Compare(c.members[i][:], c.members[k][:]) < 0
+		})
+		result = append(result, *c)
+	}
+	sort.SliceStable(result, func(i, k int) bool {
+		if result[i].score != result[k].score {
+			return result[i].score > result[k].score
+		}
+		return bytes.Compare(result[i].members[0][:], result[k].members[0][:]) < 0
+	})
+	return result
+}
+
+// window возвращает границы страницы [from, to) из total элементов
+func window(total, limit, offset int) (from, to int) {
+	from = min(offset, total)
+	return from, min(from+limit, total)
+}
@@ -0,0 +1,23 @@
+package duplicate
+
+import (
+	"Brands/internal/repository/duplicate"
+	"github.com/rs/zerolog"
+)
+
+// DuplicateService представляет слой сервиса для отчета о возможных дубликатах
+type DuplicateService struct {
+	repo *duplicate.DuplicateRepository
+	log  zerolog.Logger
+}
+
+// New создает новый экземпляр DuplicateService
+func New(
+	repo *duplicate.DuplicateRepository,
+	logger zerolog.Logger,
+) *DuplicateService {
+	return &DuplicateService{
+		repo: repo,
+		log:  logger,
+	}
+}
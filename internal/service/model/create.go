@@ -2,6 +2,8 @@ package model
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
 	"Brands/pkg/zerohook"
 	"context"
 	"fmt"
@@ -16,7 +18,7 @@ func (s *ModelService) Create(ctx context.Context, model *dto.Model) error {
 
 	if model.Name == "" {
 		err := fmt.Errorf("name is required")
-		zerohook.Logger.Error().
+		zerohook.Logger.Error().Ctx(ctx).
 			Err(err).
 			Msg("Validation failed in Create")
 
@@ -28,7 +30,24 @@ func (s *ModelService) Create(ctx context.Context, model *dto.Model) error {
 		return err
 	}
 
-	err := s.repo.Create(ctx, model)
+	slugValue, derived, err := slug.Resolve(model.Slug, model.Name)
+	if err != nil {
+		return err
+	}
+	model.Slug = slugValue
+	normalizeSpecs(model)
+	fields := writableFields(rbac.ChangedFields(dto.Model{}, *model), derived)
+	if err = s.authorize(ctx, rbac.ActionCreate, fields); err != nil {
+		return err
+	}
+	// Характеристики проверяются после прав: схема и ошибки проверки
+	// доступны только тем, кто может создать модель
+	schemaVersion, err := s.checkSpecs(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Create(ctx, model, schemaVersion)
 
 	if err != nil {
 
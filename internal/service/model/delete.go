@@ -1,6 +1,7 @@
 package model
 
 import (
+	"Brands/internal/rbac"
 	"context"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
@@ -11,6 +12,10 @@ func (s *ModelService) SoftDelete(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.SoftDelete")
 	defer span.Finish()
 
+	if err := s.authorize(ctx, rbac.ActionDelete, nil); err != nil {
+		return err
+	}
+
 	err := s.repo.SoftDelete(ctx, id)
 	if err != nil {
 		return err
@@ -23,6 +28,10 @@ func (s *ModelService) Restore(ctx context.Context, id uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.Restore")
 	defer span.Finish()
 
+	if err := s.authorize(ctx, rbac.ActionRestore, nil); err != nil {
+		return err
+	}
+
 	err := s.repo.Restore(ctx, id)
 	if err != nil {
 		return err
@@ -0,0 +1,9 @@
+package model
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnknownCategory      = errors.New("unknown category, create its specs schema first")
+	ErrSpecsWithoutCategory = errors.New("specs require a category")
+	ErrLocalized            = errors.New("model contains a translated name, fetch it without lang to update")
+)
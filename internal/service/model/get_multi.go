@@ -18,6 +18,9 @@ func (s *ModelService) GetAll(
 	if err != nil {
 		return nil, err
 	}
+	if err = s.localizeAll(ctx, models); err != nil {
+		return nil, err
+	}
 	return models, nil
 }
 
@@ -34,5 +37,8 @@ func (s *ModelService) ModelsFilter(
 	if err != nil {
 		return nil, err
 	}
+	if err = s.localizeAll(ctx, models); err != nil {
+		return nil, err
+	}
 	return models, nil
 }
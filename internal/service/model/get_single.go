@@ -16,5 +16,8 @@ func (s *ModelService) GetByID(ctx context.Context, id uuid.UUID) (*dto.Model, e
 
 		return nil, err
 	}
+	if err = s.localize(ctx, model); err != nil {
+		return nil, err
+	}
 	return model, nil
 }
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/i18n"
+	"context"
+)
+
+// localize подставляет в модели переводы на язык запроса; без выбранного
+// языка или для основного языка модели не меняются
+func (s *ModelService) localize(ctx context.Context, models ...*dto.Model) error {
+	locale, ok := i18n.LocaleFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return s.translations.LocalizeModels(ctx, locale.Chain, models...)
+}
+
+// localizeAll подставляет переводы в модели выборки
+func (s *ModelService) localizeAll(ctx context.Context, models []dto.Model) error {
+	refs := make([]*dto.Model, len(models))
+	for i := range models {
+		refs[i] = &models[i]
+	}
+	return s.localize(ctx, refs...)
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"Brands/internal/repository/revision"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"time"
+
+	modelrepo "Brands/internal/repository/model"
+)
+
+// Revisions получает версии модели от новых к старым
+func (s *ModelService) Revisions(ctx context.Context, id uuid.UUID, limit, offset int) ([]dto.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.Revisions")
+	defer span.Finish()
+
+	revisions, err := s.revisions.GetAll(ctx, audit.EntityModel, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 && offset == 0 {
+		return nil, modelrepo.ErrModelNotFound
+	}
+	return revisions, nil
+}
+
+// GetAsOf получает модель в состоянии на момент asOf; удаленная на тот момент
+// модель считается ненайденной. Переводы не версионируются, поэтому модель
+// возвращается на основном языке.
+func (s *ModelService) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*dto.Model, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.GetAsOf")
+	defer span.Finish()
+
+	rev, err := s.revisions.GetAsOf(ctx, audit.EntityModel, id, asOf)
+	if err != nil {
+		if errors.Is(err, revision.ErrRevisionNotFound) {
+			return nil, modelrepo.ErrModelNotFound
+		}
+		return nil, err
+	}
+	model, err := decodeModel(rev)
+	if err != nil {
+		return nil, err
+	}
+	if model.IsDeleted {
+		return nil, modelrepo.ErrModelNotFound
+	}
+	return model, nil
+}
+
+// Revert возвращает модель к версии rev через обычный путь обновления:
+// с проверкой прав, записью аудита и созданием новой версии. Версия,
+// сохраненная до появления характеристик, не содержит категории и
+// характеристик: модель сохраняет текущие, а не теряет их.
+func (s *ModelService) Revert(ctx context.Context, id uuid.UUID, rev int) (*dto.Model, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.Revert")
+	defer span.Finish()
+	span.SetTag("revision", rev)
+
+	target, err := s.revisions.GetByNumber(ctx, audit.EntityModel, id, rev)
+	if err != nil {
+		return nil, err
+	}
+	model, err := decodeModel(target)
+	if err != nil {
+		return nil, err
+	}
+	model.ID = id
+	if !hasSpecs(target) {
+		current, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		model.Category, model.Specs = current.Category, current.Specs
+	}
+
+	if err = s.Update(ctx, model); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("model_id", id.String()).
+		Int("revision", rev).
+		Msg("Model reverted to revision")
+	return model, nil
+}
+
+func decodeModel(rev *dto.Revision) (*dto.Model, error) {
+	var model dto.Model
+	if err := json.Unmarshal(rev.Data, &model); err != nil {
+		return nil, fmt.Errorf("unable to decode revision %d: %w", rev.Revision, err)
+	}
+	return &model, nil
+}
+
+// hasSpecs сообщает, сохранены ли в версии категория и характеристики
+func hasSpecs(rev *dto.Revision) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(rev.Data, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["specs"]
+	return ok
+}
@@ -1,24 +1,40 @@
 package model
 
 import (
+	"Brands/internal/rbac"
 	"Brands/internal/repository/model"
+	"Brands/internal/repository/revision"
+	"Brands/internal/repository/specschema"
+	"Brands/internal/repository/translation"
 	"github.com/rs/zerolog"
 )
 
 // ModelService представляет слой сервиса для работы с моделями
 type ModelService struct {
-	repo *model.ModelRepository
-	log  zerolog.Logger
+	repo         *model.ModelRepository
+	revisions    *revision.RevisionRepository
+	translations *translation.TranslationRepository
+	schemas      *specschema.SpecSchemaRepository
+	policy       *rbac.Policy
+	log          zerolog.Logger
 }
 
 // New создает новый экземпляр ModelService
 
 func New(
 	repo *model.ModelRepository,
+	revisions *revision.RevisionRepository,
+	translations *translation.TranslationRepository,
+	schemas *specschema.SpecSchemaRepository,
+	policy *rbac.Policy,
 	logger zerolog.Logger,
 ) *ModelService {
 	return &ModelService{
-		repo: repo,
-		log:  logger,
+		repo:         repo,
+		revisions:    revisions,
+		translations: translations,
+		schemas:      schemas,
+		policy:       policy,
+		log:          logger,
 	}
 }
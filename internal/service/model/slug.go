@@ -0,0 +1,36 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"slices"
+)
+
+// GetBySlug получает модель по слагу бренда и слагу модели; оба могут быть
+// прежними. Возвращает модель и актуальный слаг ее бренда.
+func (s *ModelService) GetBySlug(ctx context.Context, brandSlug, modelSlug string) (*dto.Model, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.GetBySlug")
+	defer span.Finish()
+	if !slug.Valid(brandSlug) || !slug.Valid(modelSlug) {
+		return nil, "", slug.ErrInvalid
+	}
+	model, brandSlugValue, err := s.repo.GetBySlug(ctx, brandSlug, modelSlug)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = s.localize(ctx, model); err != nil {
+		return nil, "", err
+	}
+	return model, brandSlugValue, nil
+}
+
+// writableFields исключает слаг, построенный из названия, из проверки прав
+// на поля: его смена следует из смены названия и покрывается правом на name
+func writableFields(fields []string, derivedSlug bool) []string {
+	if !derivedSlug {
+		return fields
+	}
+	return slices.DeleteFunc(fields, func(field string) bool { return field == "slug" })
+}
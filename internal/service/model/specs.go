@@ -0,0 +1,62 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/specschema"
+	"Brands/internal/specs"
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// checkSpecs проверяет характеристики модели по схеме ее категории и
+// возвращает версию схемы, по которой прошла проверка; 0 — модель без
+// категории, у нее не может быть характеристик
+func (s *ModelService) checkSpecs(ctx context.Context, model *dto.Model) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.checkSpecs")
+	defer span.Finish()
+
+	if model.Category == nil {
+		if len(model.Specs) > 0 {
+			return 0, ErrSpecsWithoutCategory
+		}
+		return 0, nil
+	}
+	schema, err := s.schemas.GetByCategory(ctx, *model.Category)
+	if errors.Is(err, specschema.ErrSchemaNotFound) {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCategory, *model.Category)
+	}
+	if err != nil {
+		return 0, err
+	}
+	compiled, err := specs.Compile(schema.Schema)
+	if err != nil {
+		return 0, fmt.Errorf("unable to compile specs schema of category %q: %w", schema.Category, err)
+	}
+	if err = compiled.Validate(model.Specs); err != nil {
+		span.LogFields(
+			log.String("event", "validation error"),
+			log.Error(err),
+		)
+		s.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("category", schema.Category).
+			Msg("Model specs do not match the category schema")
+		return 0, err
+	}
+	return schema.Version, nil
+}
+
+// normalizeSpecs приводит пустую категорию к null, а пустые характеристики
+// к nil, чтобы их отсутствие в запросе и пустой объект в записи не считались
+// изменением поля при проверке прав
+func normalizeSpecs(model *dto.Model) {
+	if model.Category != nil && *model.Category == "" {
+		model.Category = nil
+	}
+	if len(model.Specs) == 0 {
+		model.Specs = nil
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Trash получает страницу мягко удаленных моделей
+func (s *ModelService) Trash(ctx context.Context, filter dto.TrashFilter) (*dto.ModelTrashPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.Trash")
+	defer span.Finish()
+	page, err := s.repo.GetTrash(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.localizeAll(ctx, page.Models); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"Brands/internal/slug"
 	"context"
 	"github.com/opentracing/opentracing-go"
 )
@@ -11,7 +13,37 @@ func (s *ModelService) Update(ctx context.Context, model *dto.Model) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ModelService.Update")
 	defer span.Finish()
 
-	err := s.repo.Update(ctx, model)
+	// Локализованный ответ перезаписал бы основной язык переводом
+	if model.Locale != nil {
+		return ErrLocalized
+	}
+	slugValue, derived, err := slug.Resolve(model.Slug, model.Name)
+	if err != nil {
+		return err
+	}
+	model.Slug = slugValue
+	normalizeSpecs(model)
+	if s.policy.Enabled() {
+		// PUT передает запись целиком, поэтому права проверяются только
+		// для полей, значения которых действительно меняются
+		current, err := s.repo.GetByID(ctx, model.ID)
+		if err != nil {
+			return err
+		}
+		normalizeSpecs(current)
+		fields := writableFields(rbac.ChangedFields(*current, *model), derived)
+		if err = s.authorize(ctx, rbac.ActionUpdate, fields); err != nil {
+			return err
+		}
+	}
+
+	// Характеристики проверяются после прав: схема и ошибки проверки
+	// доступны только тем, кто может изменить модель
+	schemaVersion, err := s.checkSpecs(ctx, model)
+	if err != nil {
+		return err
+	}
+	err = s.repo.Update(ctx, model, schemaVersion)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,5 @@
+package specschema
+
+import "github.com/pkg/errors"
+
+var ErrInvalidCategory = errors.New("invalid category")
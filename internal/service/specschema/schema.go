@@ -0,0 +1,60 @@
+package specschema
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/slug"
+	"Brands/internal/specs"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetAll получает схемы характеристик всех категорий
+func (s *SpecSchemaService) GetAll(ctx context.Context) ([]dto.SpecSchema, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaService.GetAll")
+	defer span.Finish()
+	return s.repo.GetAll(ctx)
+}
+
+// Get получает схему характеристик категории
+func (s *SpecSchemaService) Get(ctx context.Context, category string) (*dto.SpecSchema, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaService.Get")
+	defer span.Finish()
+	return s.repo.GetByCategory(ctx, category)
+}
+
+// Save создает или заменяет схему характеристик категории. Схема должна
+// разбираться и подходить всем моделям категории.
+func (s *SpecSchemaService) Save(ctx context.Context, category string, raw json.RawMessage) (*dto.SpecSchema, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaService.Save")
+	defer span.Finish()
+
+	if !slug.Valid(category) {
+		return nil, fmt.Errorf(
+			"%w %q: use lowercase latin letters and digits separated by single hyphens, up to %d characters",
+			ErrInvalidCategory, category, slug.MaxLength,
+		)
+	}
+	compiled, err := specs.Compile(raw)
+	if err != nil {
+		return nil, err
+	}
+	schema := &dto.SpecSchema{
+		ID:       uuid.New(),
+		Category: category,
+		Schema:   raw,
+	}
+	if err = s.repo.Save(ctx, schema, compiled); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// Delete удаляет схему характеристик категории без моделей
+func (s *SpecSchemaService) Delete(ctx context.Context, category string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SpecSchemaService.Delete")
+	defer span.Finish()
+	return s.repo.Delete(ctx, category)
+}
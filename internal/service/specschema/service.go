@@ -0,0 +1,20 @@
+package specschema
+
+import (
+	"Brands/internal/repository/specschema"
+	"github.com/rs/zerolog"
+)
+
+// SpecSchemaService представляет слой сервиса для схем характеристик категорий
+type SpecSchemaService struct {
+	repo *specschema.SpecSchemaRepository
+	log  zerolog.Logger
+}
+
+// New создает новый экземпляр SpecSchemaService
+func New(repo *specschema.SpecSchemaRepository, logger zerolog.Logger) *SpecSchemaService {
+	return &SpecSchemaService{
+		repo: repo,
+		log:  logger,
+	}
+}
@@ -0,0 +1,63 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// BrandTranslations получает переводы бренда по языкам
+func (s *TranslationService) BrandTranslations(ctx context.Context, brandID uuid.UUID) ([]dto.BrandTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.BrandTranslations")
+	defer span.Finish()
+	return s.repo.BrandTranslations(ctx, brandID)
+}
+
+// UpsertBrand создает или заменяет перевод бренда на язык t.Locale
+func (s *TranslationService) UpsertBrand(ctx context.Context, t *dto.BrandTranslation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.UpsertBrand")
+	defer span.Finish()
+
+	if err := s.validateLocale(t.Locale); err != nil {
+		return err
+	}
+	var fields []string
+	if t.Name != nil {
+		name, err := validateName(*t.Name)
+		if err != nil {
+			return err
+		}
+		t.Name = &name
+		fields = append(fields, "name")
+	}
+	// Пустое описание означает, что перевода описания нет
+	if t.Description != nil && *t.Description == "" {
+		t.Description = nil
+	}
+	if t.Description != nil {
+		fields = append(fields, "description")
+	}
+	if len(fields) == 0 {
+		return ErrEmptyTranslation
+	}
+	if err := s.authorize(ctx, rbac.EntityBrand, fields); err != nil {
+		return err
+	}
+	return s.repo.UpsertBrand(ctx, t)
+}
+
+// DeleteBrand удаляет перевод бренда на язык
+func (s *TranslationService) DeleteBrand(ctx context.Context, brandID uuid.UUID, locale string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.DeleteBrand")
+	defer span.Finish()
+
+	if err := s.validateLocale(locale); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.EntityBrand, nil); err != nil {
+		return err
+	}
+	return s.repo.DeleteBrand(ctx, brandID, locale)
+}
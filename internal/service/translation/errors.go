@@ -0,0 +1,12 @@
+package translation
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+	ErrDefaultLocale     = errors.New("content in the default locale is stored in the record itself")
+	ErrEmptyTranslation  = errors.New("translation must contain at least one field")
+	ErrNameRequired      = errors.New("name must not be empty")
+	ErrNameTooLong       = errors.New("name must be at most 255 characters")
+	ErrUnknownEntity     = errors.New("entity must be brand or model")
+)
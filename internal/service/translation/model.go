@@ -0,0 +1,49 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ModelTranslations получает переводы названия модели по языкам
+func (s *TranslationService) ModelTranslations(ctx context.Context, modelID uuid.UUID) ([]dto.ModelTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.ModelTranslations")
+	defer span.Finish()
+	return s.repo.ModelTranslations(ctx, modelID)
+}
+
+// UpsertModel создает или заменяет перевод названия модели на язык t.Locale
+func (s *TranslationService) UpsertModel(ctx context.Context, t *dto.ModelTranslation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.UpsertModel")
+	defer span.Finish()
+
+	if err := s.validateLocale(t.Locale); err != nil {
+		return err
+	}
+	name, err := validateName(t.Name)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+	if err = s.authorize(ctx, rbac.EntityModel, []string{"name"}); err != nil {
+		return err
+	}
+	return s.repo.UpsertModel(ctx, t)
+}
+
+// DeleteModel удаляет перевод названия модели на язык
+func (s *TranslationService) DeleteModel(ctx context.Context, modelID uuid.UUID, locale string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.DeleteModel")
+	defer span.Finish()
+
+	if err := s.validateLocale(locale); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.EntityModel, nil); err != nil {
+		return err
+	}
+	return s.repo.DeleteModel(ctx, modelID, locale)
+}
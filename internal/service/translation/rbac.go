@@ -0,0 +1,29 @@
+package translation
+
+import (
+	"Brands/internal/rbac"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// authorize проверяет по политике RBAC право на перевод записи и на запись
+// переведенных полей
+func (s *TranslationService) authorize(ctx context.Context, entity string, fields []string) error {
+	err := s.policy.AuthorizeFields(ctx, entity, rbac.ActionTranslate, fields)
+	if err != nil {
+		if span := opentracing.SpanFromContext(ctx); span != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "rbac_forbidden"),
+				log.Error(err),
+			)
+		}
+		s.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("entity", entity).
+			Strs("fields", fields).
+			Msg("Translation forbidden")
+	}
+	return err
+}
@@ -0,0 +1,31 @@
+package translation
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/audit"
+	"context"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Completeness получает полноту переводов на каждый язык, кроме основного
+func (s *TranslationService) Completeness(ctx context.Context) ([]dto.LocaleCompleteness, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.Completeness")
+	defer span.Finish()
+	return s.repo.Completeness(ctx, s.locales.Translatable())
+}
+
+// Missing получает страницу записей с непереведенными на язык полями
+func (s *TranslationService) Missing(ctx context.Context, filter dto.MissingTranslationFilter) (*dto.MissingTranslationPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TranslationService.Missing")
+	defer span.Finish()
+
+	if err := s.validateLocale(filter.Locale); err != nil {
+		return nil, err
+	}
+	switch filter.Entity {
+	case "", audit.EntityBrand, audit.EntityModel:
+	default:
+		return nil, ErrUnknownEntity
+	}
+	return s.repo.Missing(ctx, filter)
+}
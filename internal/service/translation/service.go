@@ -0,0 +1,31 @@
+package translation
+
+import (
+	"Brands/internal/i18n"
+	"Brands/internal/rbac"
+	"Brands/internal/repository/translation"
+	"github.com/rs/zerolog"
+)
+
+// TranslationService представляет слой сервиса для переводов брендов и моделей
+type TranslationService struct {
+	repo    *translation.TranslationRepository
+	locales *i18n.Negotiator
+	policy  *rbac.Policy
+	log     zerolog.Logger
+}
+
+// New создает новый экземпляр TranslationService
+func New(
+	repo *translation.TranslationRepository,
+	locales *i18n.Negotiator,
+	policy *rbac.Policy,
+	logger zerolog.Logger,
+) *TranslationService {
+	return &TranslationService{
+		repo:    repo,
+		locales: locales,
+		policy:  policy,
+		log:     logger,
+	}
+}
@@ -0,0 +1,34 @@
+package translation
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// nameMaxLength максимальная длина переведенного названия в символах
+const nameMaxLength = 255
+
+// validateLocale проверяет, что на язык можно переводить: он поддерживается
+// и не является основным
+func (s *TranslationService) validateLocale(locale string) error {
+	if !s.locales.Supported(locale) {
+		return fmt.Errorf("%w %q, supported: %s", ErrUnsupportedLocale, locale, strings.Join(s.locales.Translatable(), ", "))
+	}
+	if locale == s.locales.Default() {
+		return ErrDefaultLocale
+	}
+	return nil
+}
+
+// validateName обрезает пробелы переведенного названия и проверяет его длину
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrNameRequired
+	}
+	if utf8.RuneCountInString(name) > nameMaxLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
@@ -0,0 +1,10 @@
+package variant
+
+import "github.com/pkg/errors"
+
+var (
+	ErrNameRequired     = errors.New("name must not be empty")
+	ErrNameTooLong      = errors.New("name must be at most 255 characters")
+	ErrInvalidStartYear = errors.New("start_year must be a positive year")
+	ErrInvalidYearRange = errors.New("end_year must not be less than start_year")
+)
@@ -0,0 +1,60 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// CreateGeneration добавляет поколение модели
+func (s *VariantService) CreateGeneration(ctx context.Context, g *dto.ModelGeneration) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.CreateGeneration")
+	defer span.Finish()
+
+	if err := validateGeneration(g); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	g.ID = uuid.New()
+	return s.repo.CreateGeneration(ctx, g)
+}
+
+// UpdateGeneration изменяет название и годы выпуска поколения
+func (s *VariantService) UpdateGeneration(ctx context.Context, g *dto.ModelGeneration) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.UpdateGeneration")
+	defer span.Finish()
+
+	if err := validateGeneration(g); err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	return s.repo.UpdateGeneration(ctx, g)
+}
+
+// DeleteGeneration мягко удаляет поколение вместе с его комплектациями
+func (s *VariantService) DeleteGeneration(ctx context.Context, modelID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.DeleteGeneration")
+	defer span.Finish()
+
+	if err := s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	return s.repo.DeleteGeneration(ctx, modelID, id)
+}
+
+// RestoreGeneration восстанавливает поколение и комплектации, удаленные вместе с ним
+func (s *VariantService) RestoreGeneration(ctx context.Context, modelID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.RestoreGeneration")
+	defer span.Finish()
+
+	if err := s.authorize(ctx, rbac.ActionRestore); err != nil {
+		return err
+	}
+	return s.repo.RestoreGeneration(ctx, modelID, id)
+}
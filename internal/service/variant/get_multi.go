@@ -0,0 +1,22 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetVariants получает поколения модели с их комплектациями
+func (s *VariantService) GetVariants(ctx context.Context, modelID uuid.UUID) ([]dto.ModelVariant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.GetVariants")
+	defer span.Finish()
+	return s.repo.GetVariants(ctx, modelID)
+}
+
+// GetTrash получает удаленные поколения и комплектации модели
+func (s *VariantService) GetTrash(ctx context.Context, modelID uuid.UUID) ([]dto.ModelVariant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.GetTrash")
+	defer span.Finish()
+	return s.repo.GetTrash(ctx, modelID)
+}
@@ -0,0 +1,37 @@
+package variant
+
+import (
+	"Brands/internal/rbac"
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// variantsField поле модели, право на запись которого дает право изменять
+// ее поколения и комплектации
+const variantsField = "variants"
+
+// authorize проверяет по политике RBAC право на действие с моделью. Поколения
+// и комплектации — часть модели: их изменение и удаление требуют права на
+// изменение поля variants, восстановление — права на восстановление модели.
+func (s *VariantService) authorize(ctx context.Context, action string) error {
+	var fields []string
+	if action == rbac.ActionUpdate {
+		fields = []string{variantsField}
+	}
+	err := s.policy.AuthorizeFields(ctx, rbac.EntityModel, action, fields)
+	if err != nil {
+		if span := opentracing.SpanFromContext(ctx); span != nil {
+			span.SetTag("error", true)
+			span.LogFields(
+				log.String("event", "rbac_forbidden"),
+				log.Error(err),
+			)
+		}
+		s.log.Warn().Ctx(ctx).
+			Err(err).
+			Str("action", action).
+			Msg("Model variant change forbidden")
+	}
+	return err
+}
@@ -0,0 +1,27 @@
+package variant
+
+import (
+	"Brands/internal/rbac"
+	"Brands/internal/repository/variant"
+	"github.com/rs/zerolog"
+)
+
+// VariantService представляет слой сервиса для поколений и комплектаций моделей
+type VariantService struct {
+	repo   *variant.VariantRepository
+	policy *rbac.Policy
+	log    zerolog.Logger
+}
+
+// New создает новый экземпляр VariantService
+func New(
+	repo *variant.VariantRepository,
+	policy *rbac.Policy,
+	logger zerolog.Logger,
+) *VariantService {
+	return &VariantService{
+		repo:   repo,
+		policy: policy,
+		log:    logger,
+	}
+}
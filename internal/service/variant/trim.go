@@ -0,0 +1,64 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/rbac"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// CreateTrim добавляет комплектацию поколения модели
+func (s *VariantService) CreateTrim(ctx context.Context, modelID uuid.UUID, t *dto.ModelTrim) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.CreateTrim")
+	defer span.Finish()
+
+	name, err := validateName(t.Name)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+	if err = s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	t.ID = uuid.New()
+	return s.repo.CreateTrim(ctx, modelID, t)
+}
+
+// UpdateTrim изменяет название и флаги комплектации
+func (s *VariantService) UpdateTrim(ctx context.Context, modelID uuid.UUID, t *dto.ModelTrim) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.UpdateTrim")
+	defer span.Finish()
+
+	name, err := validateName(t.Name)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+	if err = s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	return s.repo.UpdateTrim(ctx, modelID, t)
+}
+
+// DeleteTrim мягко удаляет комплектацию
+func (s *VariantService) DeleteTrim(ctx context.Context, modelID, generationID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.DeleteTrim")
+	defer span.Finish()
+
+	if err := s.authorize(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	return s.repo.DeleteTrim(ctx, modelID, generationID, id)
+}
+
+// RestoreTrim восстанавливает удаленную комплектацию неудаленного поколения
+func (s *VariantService) RestoreTrim(ctx context.Context, modelID, generationID, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "VariantService.RestoreTrim")
+	defer span.Finish()
+
+	if err := s.authorize(ctx, rbac.ActionRestore); err != nil {
+		return err
+	}
+	return s.repo.RestoreTrim(ctx, modelID, generationID, id)
+}
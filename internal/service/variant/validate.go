@@ -0,0 +1,38 @@
+package variant
+
+import (
+	"Brands/internal/dto"
+	"strings"
+	"unicode/utf8"
+)
+
+// nameMaxLength максимальная длина названия поколения или комплектации в символах
+const nameMaxLength = 255
+
+// validateName обрезает пробелы названия и проверяет его длину
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrNameRequired
+	}
+	if utf8.RuneCountInString(name) > nameMaxLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+// validateGeneration нормализует название поколения и проверяет годы выпуска
+func validateGeneration(g *dto.ModelGeneration) error {
+	name, err := validateName(g.Name)
+	if err != nil {
+		return err
+	}
+	g.Name = name
+	if g.StartYear <= 0 {
+		return ErrInvalidStartYear
+	}
+	if g.EndYear != nil && *g.EndYear < g.StartYear {
+		return ErrInvalidYearRange
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"Brands/internal/auth"
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Create создает подписку; секрет возвращается только в ответе
+func (s *WebhookService) Create(
+	ctx context.Context,
+	req *dto.WebhookSubscriptionRequest,
+) (*dto.CreatedWebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.Create")
+	defer span.Finish()
+
+	if err := validateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	sub := dto.WebhookSubscription{
+		ID:          uuid.New(),
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      secret,
+		Description: req.Description,
+		IsActive:    req.IsActive == nil || *req.IsActive,
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		sub.CreatedBy = principal.Subject
+	}
+
+	if err := s.repo.Create(ctx, &sub); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("webhook_id", sub.ID.String()).
+		Str("webhook_url", sub.URL).
+		Strs("event_types", sub.EventTypes).
+		Msg("Webhook subscription created")
+	return &dto.CreatedWebhookSubscription{WebhookSubscription: sub, Secret: secret}, nil
+}
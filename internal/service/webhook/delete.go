@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Delete удаляет подписку и журнал ее доставок
+func (s *WebhookService) Delete(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.Delete")
+	defer span.Finish()
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.log.Info().Ctx(ctx).Str("webhook_id", id.String()).Msg("Webhook subscription deleted")
+	return nil
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Deliveries получает страницу журнала доставок подписки
+func (s *WebhookService) Deliveries(
+	ctx context.Context,
+	filter dto.WebhookDeliveryFilter,
+) (*dto.WebhookDeliveryPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.Deliveries")
+	defer span.Finish()
+
+	switch filter.Status {
+	case "", dto.WebhookDeliveryPending, dto.WebhookDeliveryDelivered, dto.WebhookDeliveryDead:
+	default:
+		return nil, ErrUnknownStatus
+	}
+	// Проверка существования подписки, чтобы отличить 404 от пустого журнала
+	if _, err := s.repo.GetByID(ctx, filter.SubscriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDeliveries(ctx, filter)
+}
+
+// Redeliver ставит доставку в очередь повторно, в том числе после dead
+func (s *WebhookService) Redeliver(
+	ctx context.Context,
+	subscriptionID, deliveryID uuid.UUID,
+) (*dto.WebhookDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.Redeliver")
+	defer span.Finish()
+
+	delivery, err := s.repo.Redeliver(ctx, subscriptionID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("webhook_id", subscriptionID.String()).
+		Str("delivery_id", deliveryID.String()).
+		Msg("Webhook delivery requeued")
+	return delivery, nil
+}
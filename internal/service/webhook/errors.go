@@ -0,0 +1,15 @@
+package webhook
+
+import (
+	"Brands/internal/webhook"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidURL       = errors.New("url must be an absolute http or https address")
+	ErrPrivateURL       = webhook.ErrPrivateTarget
+	ErrEmptyEventTypes  = errors.New("at least one event type is required")
+	ErrUnknownEventType = errors.New("unknown event type")
+	ErrSecretTooShort   = errors.New("secret must be at least 16 characters")
+	ErrUnknownStatus    = errors.New("unknown delivery status")
+)
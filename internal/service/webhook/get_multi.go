@@ -0,0 +1,15 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetAll получает все подписки
+func (s *WebhookService) GetAll(ctx context.Context) ([]dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.GetAll")
+	defer span.Finish()
+
+	return s.repo.GetAll(ctx)
+}
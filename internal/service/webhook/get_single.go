@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetByID получает подписку по ID
+func (s *WebhookService) GetByID(ctx context.Context, id uuid.UUID) (*dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.GetByID")
+	defer span.Finish()
+
+	return s.repo.GetByID(ctx, id)
+}
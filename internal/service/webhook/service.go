@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"Brands/internal/repository/webhook"
+	"github.com/rs/zerolog"
+)
+
+// WebhookService представляет слой сервиса для работы с подписками на вебхуки
+type WebhookService struct {
+	repo *webhook.WebhookRepository
+	log  zerolog.Logger
+}
+
+// New создает новый экземпляр WebhookService
+func New(
+	repo *webhook.WebhookRepository,
+	logger zerolog.Logger,
+) *WebhookService {
+	return &WebhookService{
+		repo: repo,
+		log:  logger,
+	}
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"context"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Update заменяет параметры подписки; пустой secret оставляет прежний ключ
+func (s *WebhookService) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	req *dto.WebhookSubscriptionRequest,
+) (*dto.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookService.Update")
+	defer span.Finish()
+
+	if err := validateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	sub := dto.WebhookSubscription{
+		ID:          id,
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      req.Secret,
+		Description: req.Description,
+		IsActive:    req.IsActive == nil || *req.IsActive,
+	}
+	if err := s.repo.Update(ctx, &sub); err != nil {
+		return nil, err
+	}
+	s.log.Info().Ctx(ctx).
+		Str("webhook_id", sub.ID.String()).
+		Bool("secret_rotated", req.Secret != "").
+		Bool("is_active", sub.IsActive).
+		Msg("Webhook subscription updated")
+	return &sub, nil
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	"Brands/internal/repository/outbox"
+	"Brands/internal/webhook"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/pkg/errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	// allEvents подписывает на все типы событий
+	allEvents       = "*"
+	minSecretLength = 16
+	secretPrefix    = "whsec_"
+)
+
+func validateRequest(ctx context.Context, req *dto.WebhookSubscriptionRequest) error {
+	req.URL = strings.TrimSpace(req.URL)
+	target, err := url.Parse(req.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return ErrInvalidURL
+	}
+	if err = webhook.CheckTarget(ctx, req.URL); err != nil {
+		return err
+	}
+	if len(req.EventTypes) == 0 {
+		return ErrEmptyEventTypes
+	}
+	for _, eventType := range req.EventTypes {
+		if eventType != allEvents && !outbox.KnownEventType(eventType) {
+			return errors.Wrap(ErrUnknownEventType, eventType)
+		}
+	}
+	if req.Secret != "" && len(req.Secret) < minSecretLength {
+		return ErrSecretTooShort
+	}
+	return nil
+}
+
+// generateSecret создает случайный ключ подписи
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("unable to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,29 @@
+package slug
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalid  = errors.New("invalid slug")
+	ErrConflict = errors.New("slug conflict")
+)
+
+// ConflictError слаг уже занят другой неудаленной записью
+type ConflictError struct {
+	Entity string    // brand или model
+	ID     uuid.UUID // ID записи, занявшей слаг
+	Name   string    // Название записи, занявшей слаг
+	Slug   string    // Спорный слаг
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s slug %q is already taken by %s %q (%s)", e.Entity, e.Slug, e.Entity, e.Name, e.ID)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
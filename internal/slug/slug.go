@@ -0,0 +1,99 @@
+package slug
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength максимальная длина слага, совпадает с размером колонки slug
+const MaxLength = 100
+
+var pattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// translit транслитерация кириллицы (русский, украинский, белорусский) и
+// латинских букв, которые не раскладываются на базовую букву и диакритику.
+// Таблица повторяет функцию slugify из миграции заполнения слагов.
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "u",
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ł': "l", 'þ': "th",
+}
+
+// Make строит слаг из названия: кириллица транслитерируется, у латиницы
+// отбрасываются диакритические знаки, апострофы удаляются, остальные
+// символы заменяются дефисом. Возвращает пустую строку, если в названии
+// нет букв и цифр.
+func Make(name string) string {
+	var b strings.Builder
+	pendingDash := false
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if pendingDash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		pendingDash = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(name) {
+		if tr, ok := translit[r]; ok {
+			write(tr)
+			continue
+		}
+		if r == '\'' || r == '’' || r == '`' {
+			continue
+		}
+		// Разложение отделяет диакритику: é -> e + U+0301
+		for _, d := range norm.NFD.String(string(r)) {
+			switch {
+			case d >= 'a' && d <= 'z', d >= '0' && d <= '9':
+				write(string(d))
+			case unicode.Is(unicode.Mn, d):
+			default:
+				pendingDash = true
+			}
+		}
+	}
+
+	s := b.String()
+	if len(s) > MaxLength {
+		s = strings.TrimRight(s[:MaxLength], "-")
+	}
+	return s
+}
+
+// Valid сообщает, является ли строка допустимым слагом: строчные латинские
+// буквы и цифры, разделенные одиночными дефисами
+func Valid(s string) bool {
+	return len(s) <= MaxLength && pattern.MatchString(s)
+}
+
+// Resolve возвращает слаг записи: переданный клиентом проверяется на формат,
+// пустой строится из названия. derived сообщает, что слаг построен из
+// названия, а не передан явно.
+func Resolve(value, name string) (result string, derived bool, err error) {
+	if value != "" {
+		if !Valid(value) {
+			return "", false, fmt.Errorf(
+				"%w %q: use lowercase latin letters and digits separated by single hyphens, up to %d characters",
+				ErrInvalid, value, MaxLength,
+			)
+		}
+		return value, false, nil
+	}
+	result = Make(name)
+	if result == "" {
+		return "", true, fmt.Errorf("%w: unable to derive slug from name %q, pass slug explicitly", ErrInvalid, name)
+	}
+	return result, true, nil
+}
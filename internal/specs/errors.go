@@ -0,0 +1,37 @@
+package specs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidSchema = errors.New("invalid specs schema")
+	ErrInvalidSpecs  = errors.New("specs do not match the category schema")
+	ErrInvalidFilter = errors.New("invalid specs filter")
+)
+
+// Violation нарушение схемы значением характеристики
+type Violation struct {
+	Path    string // Путь к значению: specs.engine_volume, specs.colors[0]
+	Message string // Какое правило схемы нарушено
+}
+
+// ValidationError характеристики модели не соответствуют схеме категории
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%s: %s", v.Path, v.Message)
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidSpecs, strings.Join(messages, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidSpecs
+}
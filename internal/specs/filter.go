@@ -0,0 +1,89 @@
+package specs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// keyPattern допустимое имя характеристики: оно попадает в параметры
+// запроса и выражения jsonpath фильтра
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// FilterPrefix префикс параметров запроса, фильтрующих модели по характеристикам
+const FilterPrefix = root + "."
+
+// operators операторы фильтра и их запись в jsonpath
+var operators = map[string]string{
+	"eq":  "==",
+	"ne":  "!=",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+// Condition условие фильтра моделей по значению характеристики
+type Condition struct {
+	Path     []string // Путь к характеристике: engine_volume или engine.volume
+	Operator string   // eq, ne, gt, gte, lt, lte
+	Value    any      // Число, строка или логическое значение
+}
+
+// ParseCondition разбирает параметр запроса вида specs.engine_volume[gte]=2.0.
+// Оператор по умолчанию — eq. Значение, записанное как JSON-число, true или
+// false, сравнивается по типу; строку с числом можно передать в кавычках:
+// specs.code="2020". Прочие значения считаются строками.
+func ParseCondition(param, value string) (Condition, error) {
+	key := strings.TrimPrefix(param, FilterPrefix)
+	operator := "eq"
+	if open := strings.IndexByte(key, '['); open >= 0 {
+		if !strings.HasSuffix(key, "]") {
+			return Condition{}, fmt.Errorf("%w %q: expected %s<name>[<operator>]", ErrInvalidFilter, param, FilterPrefix)
+		}
+		key, operator = key[:open], key[open+1:len(key)-1]
+	}
+	if _, ok := operators[operator]; !ok {
+		return Condition{}, fmt.Errorf("%w %q: unknown operator %q, use eq, ne, gt, gte, lt or lte", ErrInvalidFilter, param, operator)
+	}
+
+	path := strings.Split(key, ".")
+	for _, segment := range path {
+		if !keyPattern.MatchString(segment) {
+			return Condition{}, fmt.Errorf("%w %q: spec name must match %s", ErrInvalidFilter, param, keyPattern)
+		}
+	}
+	if value == "" {
+		return Condition{}, fmt.Errorf("%w %q: value must not be empty", ErrInvalidFilter, param)
+	}
+
+	c := Condition{Path: path, Operator: operator, Value: value}
+	var typed any
+	if err := json.Unmarshal([]byte(value), &typed); err == nil {
+		switch typed.(type) {
+		case float64, string, bool:
+			c.Value = typed
+		}
+	}
+	if _, ok := c.Value.(bool); ok && operator != "eq" && operator != "ne" {
+		return Condition{}, fmt.Errorf("%w %q: booleans support only eq and ne", ErrInvalidFilter, param)
+	}
+	return c, nil
+}
+
+// JSONPath записывает условие выражением jsonpath для оператора @?. Имена
+// ограничены keyPattern, а значение записывается JSON-литералом, поэтому
+// выражение не зависит от пользовательского ввода синтаксически.
+func (c Condition) JSONPath() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range c.Path {
+		b.WriteString(`."`)
+		b.WriteString(segment)
+		b.WriteString(`"`)
+	}
+	literal, _ := json.Marshal(c.Value)
+	fmt.Fprintf(&b, " ? (@ %s %s)", operators[c.Operator], literal)
+	return b.String()
+}
@@ -0,0 +1,231 @@
+package specs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Поддерживается подмножество JSON Schema, достаточное для описания
+// характеристик: типы, свойства объектов, перечисления, границы чисел,
+// длины строк и массивов, шаблоны строк. Схема с другими ключевыми словами
+// отклоняется, чтобы администратор не рассчитывал на непроверяемое правило.
+var (
+	// annotations не влияют на проверку и принимаются без разбора
+	annotations = map[string]struct{}{
+		"$schema": {}, "$id": {}, "$comment": {}, "title": {}, "description": {},
+		"default": {}, "examples": {}, "format": {}, "deprecated": {}, "readOnly": {},
+	}
+	types = map[string]struct{}{
+		"object": {}, "array": {}, "string": {}, "number": {}, "integer": {}, "boolean": {}, "null": {},
+	}
+)
+
+// Schema разобранная схема характеристик категории
+type Schema struct {
+	types                []string
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *bool
+	enum                 []any
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	items                *Schema
+	minItems             *int
+	maxItems             *int
+	uniqueItems          bool
+}
+
+// Compile разбирает схему характеристик категории. Корень схемы должен
+// описывать объект: характеристики модели хранятся объектом.
+func Compile(raw json.RawMessage) (*Schema, error) {
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	schema, err := compile(doc, "schema")
+	if err != nil {
+		return nil, err
+	}
+	if len(schema.types) != 1 || schema.types[0] != "object" {
+		return nil, fmt.Errorf(`%w: schema: root must have "type": "object"`, ErrInvalidSchema)
+	}
+	return schema, nil
+}
+
+func compile(doc any, path string) (*Schema, error) {
+	node, ok := doc.(map[string]any)
+	if !ok {
+		return nil, invalid(path, "must be an object")
+	}
+
+	// Ключевые слова разбираются в порядке имен, чтобы ошибка была стабильной
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	s := &Schema{}
+	for _, key := range keys {
+		value := node[key]
+		at := path + "." + key
+		var err error
+		switch key {
+		case "type":
+			s.types, err = compileTypes(value, at)
+		case "properties":
+			s.properties, err = compileProperties(value, at)
+		case "required":
+			s.required, err = stringList(value, at)
+		case "additionalProperties":
+			b, ok := value.(bool)
+			if !ok {
+				return nil, invalid(at, "only true or false is supported")
+			}
+			s.additionalProperties = &b
+		case "enum":
+			list, ok := value.([]any)
+			if !ok || len(list) == 0 {
+				return nil, invalid(at, "must be a non-empty array")
+			}
+			s.enum = list
+		case "const":
+			s.enum = []any{value}
+		case "minimum":
+			s.minimum, err = number(value, at)
+		case "maximum":
+			s.maximum, err = number(value, at)
+		case "exclusiveMinimum":
+			s.exclusiveMinimum, err = number(value, at)
+		case "exclusiveMaximum":
+			s.exclusiveMaximum, err = number(value, at)
+		case "minLength":
+			s.minLength, err = count(value, at)
+		case "maxLength":
+			s.maxLength, err = count(value, at)
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return nil, invalid(at, "must be a string")
+			}
+			if s.pattern, err = regexp.Compile(pattern); err != nil {
+				return nil, invalid(at, err.Error())
+			}
+		case "items":
+			s.items, err = compile(value, at)
+		case "minItems":
+			s.minItems, err = count(value, at)
+		case "maxItems":
+			s.maxItems, err = count(value, at)
+		case "uniqueItems":
+			b, ok := value.(bool)
+			if !ok {
+				return nil, invalid(at, "must be a boolean")
+			}
+			s.uniqueItems = b
+		default:
+			if _, ok := annotations[key]; ok || strings.HasPrefix(key, "x-") {
+				continue
+			}
+			return nil, invalid(at, "unsupported keyword")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func compileTypes(value any, path string) ([]string, error) {
+	var list []string
+	switch v := value.(type) {
+	case string:
+		list = []string{v}
+	case []any:
+		var err error
+		if list, err = stringList(v, path); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, invalid(path, "must be a string or an array of strings")
+	}
+	for _, t := range list {
+		if _, ok := types[t]; !ok {
+			return nil, invalid(path, fmt.Sprintf("unknown type %q", t))
+		}
+	}
+	return list, nil
+}
+
+func compileProperties(value any, path string) (map[string]*Schema, error) {
+	node, ok := value.(map[string]any)
+	if !ok {
+		return nil, invalid(path, "must be an object")
+	}
+	properties := make(map[string]*Schema, len(node))
+	for name, property := range node {
+		if !keyPattern.MatchString(name) {
+			return nil, invalid(path+"."+name, "property name must match "+keyPattern.String())
+		}
+		schema, err := compile(property, path+"."+name)
+		if err != nil {
+			return nil, err
+		}
+		properties[name] = schema
+	}
+	return properties, nil
+}
+
+func stringList(value any, path string) ([]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, invalid(path, "must be an array of strings")
+	}
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, invalid(path, "must be an array of strings")
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func number(value any, path string) (*float64, error) {
+	n, ok := value.(float64)
+	if !ok {
+		return nil, invalid(path, "must be a number")
+	}
+	return &n, nil
+}
+
+func count(value any, path string) (*int, error) {
+	n, ok := value.(float64)
+	if !ok || n < 0 || n != float64(int(n)) {
+		return nil, invalid(path, "must be a non-negative integer")
+	}
+	c := int(n)
+	return &c, nil
+}
+
+func invalid(path, message string) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalidSchema, path, message)
+}
+
+// equal сравнивает значения JSON так же, как их сравнила бы схема: объекты
+// и массивы поэлементно
+func equal(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(x, y)
+}
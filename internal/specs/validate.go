@@ -0,0 +1,167 @@
+package specs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+// root имя корня в путях нарушений, совпадает с именем поля модели
+const root = "specs"
+
+// Validate проверяет характеристики модели по схеме и возвращает
+// *ValidationError со всеми найденными нарушениями
+func (s *Schema) Validate(specs map[string]any) error {
+	var value any = specs
+	if specs == nil {
+		value = map[string]any{}
+	}
+	var violations []Violation
+	s.validate(value, root, &violations)
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+func (s *Schema) validate(value any, path string, out *[]Violation) {
+	report := func(format string, args ...any) {
+		*out = append(*out, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(s.types) > 0 && !s.hasType(value) {
+		report("must be %s", typeList(s.types))
+		return
+	}
+	if s.enum != nil && !s.inEnum(value) {
+		report("must be one of the allowed values")
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		s.validateObject(v, path, out)
+	case []any:
+		if s.minItems != nil && len(v) < *s.minItems {
+			report("must contain at least %d items", *s.minItems)
+		}
+		if s.maxItems != nil && len(v) > *s.maxItems {
+			report("must contain at most %d items", *s.maxItems)
+		}
+		if s.uniqueItems && !unique(v) {
+			report("must not contain duplicate items")
+		}
+		if s.items != nil {
+			for i, item := range v {
+				s.items.validate(item, fmt.Sprintf("%s[%d]", path, i), out)
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			report("must be at least %d characters", *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			report("must be at most %d characters", *s.maxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			report("must match pattern %s", s.pattern)
+		}
+	case float64:
+		if s.minimum != nil && v < *s.minimum {
+			report("must be >= %v", *s.minimum)
+		}
+		if s.maximum != nil && v > *s.maximum {
+			report("must be <= %v", *s.maximum)
+		}
+		if s.exclusiveMinimum != nil && v <= *s.exclusiveMinimum {
+			report("must be > %v", *s.exclusiveMinimum)
+		}
+		if s.exclusiveMaximum != nil && v >= *s.exclusiveMaximum {
+			report("must be < %v", *s.exclusiveMaximum)
+		}
+	}
+}
+
+func (s *Schema) validateObject(v map[string]any, path string, out *[]Violation) {
+	for _, name := range s.required {
+		if _, ok := v[name]; !ok {
+			*out = append(*out, Violation{Path: path + "." + name, Message: "is required"})
+		}
+	}
+	// Свойства проверяются в порядке имен, чтобы порядок нарушений был стабильным
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		at := path + "." + name
+		if property, ok := s.properties[name]; ok {
+			property.validate(v[name], at, out)
+			continue
+		}
+		if s.additionalProperties != nil && !*s.additionalProperties {
+			*out = append(*out, Violation{Path: at, Message: "is not defined by the category schema"})
+		}
+	}
+}
+
+func (s *Schema) hasType(value any) bool {
+	for _, t := range s.types {
+		switch v := value.(type) {
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case float64:
+			if t == "number" || (t == "integer" && v == math.Trunc(v)) {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case nil:
+			if t == "null" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Schema) inEnum(value any) bool {
+	for _, allowed := range s.enum {
+		if equal(allowed, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func unique(items []any) bool {
+	for i := range items {
+		for j := i + 1; j < len(items); j++ {
+			if equal(items[i], items[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func typeList(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("one of %v", types)
+}
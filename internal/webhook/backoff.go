@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// backoff возвращает задержку перед повтором после attempts неудачных
+// попыток: initial, 2×initial, 4×initial… не больше maxDelay, плюс до 10%
+// случайного разброса, чтобы повторы к одному получателю не шли залпом
+func backoff(attempts int, initial, maxDelay time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	return delay + time.Duration(rand.Int64N(int64(delay)/10+1))
+}
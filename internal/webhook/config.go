@@ -0,0 +1,50 @@
+package webhook
+
+import "time"
+
+const (
+	defaultPollInterval   = time.Second
+	defaultBatchSize      = 50
+	defaultWorkers        = 8
+	defaultTimeout        = 10 * time.Second
+	defaultMaxAttempts    = 8
+	defaultInitialBackoff = 30 * time.Second
+	defaultMaxBackoff     = 6 * time.Hour
+)
+
+// Config настройки доставки вебхуков
+type Config struct {
+	Enabled        bool          `yaml:"enabled"`         // Создавать и отправлять доставки
+	PollInterval   time.Duration `yaml:"poll_interval"`   // Период опроса очереди доставок
+	BatchSize      int           `yaml:"batch_size"`      // Максимум доставок, захватываемых за раз
+	Workers        int           `yaml:"workers"`         // Число одновременных HTTP-запросов
+	Timeout        time.Duration `yaml:"timeout"`         // Таймаут запроса к получателю
+	MaxAttempts    int           `yaml:"max_attempts"`    // Попыток до перевода доставки в dead
+	InitialBackoff time.Duration `yaml:"initial_backoff"` // Задержка перед первым повтором
+	MaxBackoff     time.Duration `yaml:"max_backoff"`     // Максимальная задержка между повторами
+}
+
+func (c Config) withDefaults() Config {
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultPollInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	if c.Workers <= 0 {
+		c.Workers = defaultWorkers
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = defaultInitialBackoff
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		c.MaxBackoff = max(defaultMaxBackoff, c.InitialBackoff)
+	}
+	return c
+}
@@ -0,0 +1,203 @@
+package webhook
+
+import (
+	"Brands/internal/metrics"
+	"Brands/internal/pg"
+	webhookrepo "Brands/internal/repository/webhook"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// leaseMargin добавляется к таймауту запроса при захвате доставки
+	leaseMargin = 30 * time.Second
+	// maxResponseSnippet ограничивает фрагмент ответа получателя в ошибке
+	maxResponseSnippet = 256
+)
+
+// Dispatcher отправляет доставки, срок которых наступил, и планирует
+// повторы с экспоненциальной задержкой. После MaxAttempts неудачных
+// попыток доставка переводится в dead и ждет ручной переотправки.
+type Dispatcher struct {
+	repo   *webhookrepo.WebhookRepository
+	cfg    Config
+	client *http.Client
+	log    zerolog.Logger
+}
+
+// NewDispatcher создает отправителя вебхуков
+func NewDispatcher(cfg Config, repo *webhookrepo.WebhookRepository, logger zerolog.Logger) *Dispatcher {
+	cfg = cfg.withDefaults()
+	return &Dispatcher{
+		repo: repo,
+		cfg:  cfg,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			// Соединения только с публичными адресами и без прокси: иначе
+			// проверка адреса относилась бы к прокси, а не к получателю
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{
+					Timeout: cfg.Timeout,
+					Control: dialControl,
+				}).DialContext,
+				ForceAttemptHTTP2:   true,
+				MaxIdleConnsPerHost: cfg.Workers,
+				TLSHandshakeTimeout: cfg.Timeout,
+			},
+			// Перенаправления не выполняются: подписка должна указывать конечный адрес
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		log: logger,
+	}
+}
+
+// Run обрабатывает очередь доставок до отмены ctx
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	defer d.client.CloseIdleConnections()
+
+	for {
+		// Полная пачка означает, что в очереди могут остаться доставки
+		if d.dispatch(ctx) == d.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch захватывает пачку доставок и отправляет их параллельно не более
+// чем в Workers запросов; возвращает размер пачки
+func (d *Dispatcher) dispatch(ctx context.Context) int {
+	claimed, err := d.repo.ClaimDue(pg.WithoutTracing(ctx), d.cfg.BatchSize, d.cfg.Timeout+leaseMargin)
+	if err != nil {
+		d.log.Error().Err(err).Msg("Failed to claim webhook deliveries")
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, d.cfg.Workers)
+	for _, delivery := range claimed {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			d.deliver(ctx, delivery)
+		}()
+	}
+	wg.Wait()
+	return len(claimed)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery webhookrepo.ClaimedDelivery) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookDispatcher.Deliver")
+	defer span.Finish()
+	span.SetTag("webhook.delivery_id", delivery.ID.String())
+	span.SetTag("webhook.subscription_id", delivery.SubscriptionID.String())
+	span.SetTag("webhook.event_type", delivery.EventType)
+
+	attempt := delivery.Attempts + 1
+	statusCode, err := d.send(ctx, span, delivery, attempt)
+	if err == nil {
+		metrics.WebhookDeliveries.WithLabelValues("delivered").Inc()
+		if err = d.repo.MarkDelivered(ctx, delivery.ID, delivery.NextAttemptAt, statusCode); err != nil {
+			span.SetTag("error", true)
+			span.LogFields(log.Error(err))
+		}
+		return
+	}
+
+	span.SetTag("error", true)
+	span.LogFields(
+		log.String("event", "delivery_failed"),
+		log.Error(err),
+	)
+	var code *int
+	if statusCode != 0 {
+		code = &statusCode
+	}
+	var retryIn *time.Duration
+	result := "dead"
+	if attempt < d.cfg.MaxAttempts {
+		delay := backoff(attempt, d.cfg.InitialBackoff, d.cfg.MaxBackoff)
+		retryIn = &delay
+		result = "retry"
+	}
+	metrics.WebhookDeliveries.WithLabelValues(result).Inc()
+
+	event := d.log.Warn()
+	if retryIn == nil {
+		event = d.log.Error()
+	}
+	event.Ctx(ctx).Err(err).
+		Str("delivery_id", delivery.ID.String()).
+		Str("subscription_id", delivery.SubscriptionID.String()).
+		Int("attempt", attempt).
+		Bool("dead", retryIn == nil).
+		Msg("Webhook delivery failed")
+
+	if err = d.repo.MarkFailed(ctx, delivery.ID, delivery.NextAttemptAt, code, err.Error(), retryIn); err != nil {
+		span.LogFields(log.Error(err))
+	}
+}
+
+// send выполняет один подписанный запрос; успехом считается ответ 2xx
+func (d *Dispatcher) send(
+	ctx context.Context,
+	span opentracing.Span,
+	delivery webhookrepo.ClaimedDelivery,
+	attempt int,
+) (int, error) {
+	ext.SpanKindRPCClient.Set(span)
+	ext.HTTPMethod.Set(span, http.MethodPost)
+	ext.HTTPUrl.Set(span, delivery.URL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("unable to build webhook request: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Brands-Webhooks/1.0")
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(AttemptHeader, strconv.Itoa(attempt))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to deliver webhook: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		_ = resp.Body.Close()
+	}()
+	ext.HTTPStatusCode.Set(span, uint16(resp.StatusCode))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSnippet))
+		return resp.StatusCode, fmt.Errorf("webhook receiver responded with status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return resp.StatusCode, nil
+}
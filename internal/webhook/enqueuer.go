@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"Brands/internal/dto"
+	webhookrepo "Brands/internal/repository/webhook"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog"
+)
+
+// Enqueuer получатель событий outbox, создающий доставки для подходящих
+// подписок. Сама отправка выполняется Dispatcher, поэтому медленный
+// партнер не задерживает публикацию остальных событий.
+type Enqueuer struct {
+	repo *webhookrepo.WebhookRepository
+	log  zerolog.Logger
+}
+
+// NewEnqueuer создает получателя событий для вебхуков
+func NewEnqueuer(repo *webhookrepo.WebhookRepository, logger zerolog.Logger) *Enqueuer {
+	return &Enqueuer{repo: repo, log: logger}
+}
+
+func (e *Enqueuer) Publish(ctx context.Context, event dto.OutboxEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookEnqueuer.Publish")
+	defer span.Finish()
+
+	subs, err := e.repo.GetMatching(ctx, event.EventType)
+	if err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode webhook payload: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(subs))
+	for _, sub := range subs {
+		ids = append(ids, sub.ID)
+	}
+	enqueued, err := e.repo.Enqueue(ctx, event.ID, event.EventType, payload, ids)
+	if err != nil {
+		return err
+	}
+	e.log.Debug().Ctx(ctx).
+		Str("event_id", event.ID.String()).
+		Str("event_type", event.EventType).
+		Int64("deliveries", enqueued).
+		Msg("Webhook deliveries enqueued")
+	return nil
+}
+
+func (e *Enqueuer) Close() error {
+	return nil
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	DeliveryHeader  = "X-Webhook-ID"
+	EventHeader     = "X-Webhook-Event"
+	AttemptHeader   = "X-Webhook-Attempt"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign вычисляет подпись тела запроса: HMAC-SHA256 по секрету подписки от
+// строки "<timestamp>.<body>", где timestamp — значение X-Webhook-Timestamp
+// в секундах Unix. Получатель сверяет подпись и отклоняет старые timestamp,
+// чтобы перехваченный запрос нельзя было повторить.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPrivateTarget адрес получателя во внутренней сети: вебхуки
+// отправляются только на публичные адреса, иначе любой клиент с правом
+// записи мог бы обращаться через сервис к внутренним системам
+var ErrPrivateTarget = errors.New("webhook target must resolve to a public address")
+
+// reservedPrefixes специальные диапазоны, не покрытые методами netip.Addr
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // Текущая сеть
+	netip.MustParsePrefix("100.64.0.0/10"),   // Shared address space (CGNAT)
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),   // Тестирование производительности
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64 с встроенным IPv4
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // Локальный NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // Документация
+	netip.MustParsePrefix("fec0::/10"),       // Устаревшие site-local
+	netip.MustParsePrefix("240.0.0.0/4"),     // Зарезервировано и broadcast
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+}
+
+// PublicAddr сообщает, можно ли отправлять вебхуки на адрес: loopback,
+// link-local, частные, multicast и зарезервированные диапазоны запрещены
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckTarget проверяет адрес получателя при создании и изменении подписки:
+// все адреса, в которые сейчас разрешается хост, должны быть публичными.
+// Разрешение имени может измениться, поэтому Dispatcher повторяет проверку
+// для каждого соединения.
+func CheckTarget(ctx context.Context, rawURL string) error {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook target: %w", err)
+	}
+	host := target.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !PublicAddr(addr) {
+			return ErrPrivateTarget
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Wrapf(ErrPrivateTarget, "unable to resolve %q: %v", host, err)
+	}
+	for _, addr := range addrs {
+		if !PublicAddr(addr) {
+			return errors.Wrapf(ErrPrivateTarget, "%q resolves to %s", host, addr)
+		}
+	}
+	return nil
+}
+
+// dialControl запрещает соединения с непубличными адресами. Проверяется
+// адрес, с которым действительно устанавливается соединение, поэтому смена
+// DNS-записи после создания подписки не открывает доступ во внутреннюю сеть.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook target address %q: %w", address, err)
+	}
+	if !PublicAddr(addrPort.Addr()) {
+		return errors.Wrapf(ErrPrivateTarget, "refusing to connect to %s", addrPort.Addr())
+	}
+	return nil
+}
@@ -3,15 +3,50 @@ package main
 import (
 	_ "Brands/docs"
 	"Brands/internal/api"
+	apikeyhandler "Brands/internal/api/handler/apikey"
+	audithandler "Brands/internal/api/handler/audit"
 	brandhandler "Brands/internal/api/handler/brand"
+	changeshandler "Brands/internal/api/handler/changes"
+	duplicatehandler "Brands/internal/api/handler/duplicate"
 	modelhandler "Brands/internal/api/handler/model"
+	specschemahandler "Brands/internal/api/handler/specschema"
+	translationhandler "Brands/internal/api/handler/translation"
+	varianthandler "Brands/internal/api/handler/variant"
+	webhookhandler "Brands/internal/api/handler/webhook"
+	"Brands/internal/auth"
+	"Brands/internal/changefeed"
 	"Brands/internal/config"
+	"Brands/internal/dedup"
+	"Brands/internal/i18n"
+	"Brands/internal/idempotency"
 	"Brands/internal/metrics"
+	"Brands/internal/outbox"
 	"Brands/internal/pg"
+	"Brands/internal/rbac"
+	"Brands/internal/repository/apikey"
+	"Brands/internal/repository/audit"
 	"Brands/internal/repository/brand"
+	duplicaterepo "Brands/internal/repository/duplicate"
+	idempotencyrepo "Brands/internal/repository/idempotency"
 	"Brands/internal/repository/model"
+	outboxrepo "Brands/internal/repository/outbox"
+	"Brands/internal/repository/revision"
+	specschemarepo "Brands/internal/repository/specschema"
+	translationrepo "Brands/internal/repository/translation"
+	variantrepo "Brands/internal/repository/variant"
+	webhookrepo "Brands/internal/repository/webhook"
+	"Brands/internal/retention"
+	apikeyservice "Brands/internal/service/apikey"
+	auditservice "Brands/internal/service/audit"
 	brandservice "Brands/internal/service/brand"
+	duplicateservice "Brands/internal/service/duplicate"
 	modelservice "Brands/internal/service/model"
+	specschemaservice "Brands/internal/service/specschema"
+	translationservice "Brands/internal/service/translation"
+	variantservice "Brands/internal/service/variant"
+	webhookservice "Brands/internal/service/webhook"
+	"Brands/internal/webhook"
+	"Brands/pkg/redact"
 	"Brands/pkg/tracer"
 	"Brands/pkg/yamlreader"
 	"Brands/pkg/zerohook"
@@ -22,6 +57,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 // @title Brands API
@@ -35,29 +71,42 @@ import (
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 // @host 127.0.0.1:8080
 // @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description JWT в формате "Bearer {token}"
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+// @description API-ключ сервисного клиента
 
 func main() {
 	ctx := context.Background()
 	cfg := MustNewConfig(parseFlags(), zerohook.Logger)
-	zerohook.InitLogger(cfg.Log)
+	redactor := redact.New(cfg.Redaction)
+	zerohook.InitLogger(cfg.Log, redactor)
 
-	err := tracer.InitTracer(
-		cfg.Jaeger.ServiceName,
-		cfg.Jaeger.AgentHost,
-		cfg.Jaeger.AgentPort,
-	)
+	err := tracer.InitTracer(ctx, cfg.Tracing, cfg.Log, redactor)
 	if err != nil {
 		zerohook.Logger.Fatal().Msgf("Ошибка инициализации трейсера: %v", err)
 		return
 	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		if err := tracer.CloseTracer(shutdownCtx); err != nil {
+			zerohook.Logger.Error().Err(err).Msg("Ошибка остановки трейсера")
+		}
+	}()
 
 	// Подключение к базе данных
-	pgInstance, err := pg.NewPG(ctx, cfg.Postgres.Conn, zerohook.Logger)
+	pgInstance, err := pg.NewPG(ctx, cfg.Postgres.Conn, cfg.Postgres.RedactArgs, zerohook.Logger)
 	if err != nil {
 		zerohook.Logger.Fatal().Msg("Ошибка подключения к базе данных")
 		return
 	}
 	defer pgInstance.Close()
+	metrics.RegisterPgxPool(pgInstance.Pool())
 
 	// Создание репозиториев
 	br, err := brand.New(ctx, pgInstance.Pool(), zerohook.Logger)
@@ -70,17 +119,101 @@ func main() {
 		zerohook.Logger.Fatal().Err(err)
 		return
 	}
+	akr, err := apikey.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	ar, err := audit.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	rr, err := revision.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+
+	or, err := outboxrepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	wr, err := webhookrepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	ir, err := idempotencyrepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	dr, err := duplicaterepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	tr, err := translationrepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	vr, err := variantrepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+	sr, err := specschemarepo.New(ctx, pgInstance.Pool(), zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err)
+		return
+	}
+
+	// Политика доступа по ролям
+	policy := rbac.New(cfg.RBAC)
+
+	// Поддерживаемые языки и выбор языка ответа
+	locales := i18n.New(cfg.I18n)
 
 	// Создание сервисов с передачей WorkerPool
-	bs := brandservice.New(br, zerohook.Logger)
-	ms := modelservice.New(mr, zerohook.Logger)
+	bs := brandservice.New(br, rr, tr, policy, zerohook.Logger)
+	ms := modelservice.New(mr, rr, tr, sr, policy, zerohook.Logger)
+	aks := apikeyservice.New(akr, zerohook.Logger)
+	as := auditservice.New(ar, zerohook.Logger)
+	ws := webhookservice.New(wr, zerohook.Logger)
+	ds := duplicateservice.New(dr, zerohook.Logger)
+	ts := translationservice.New(tr, locales, policy, zerohook.Logger)
+	vs := variantservice.New(vr, policy, zerohook.Logger)
+	ss := specschemaservice.New(sr, zerohook.Logger)
+
+	// Повтор ответов на запросы с Idempotency-Key
+	keeper := idempotency.New(cfg.Idempotency, ir, zerohook.Logger)
 
 	// Создание хендлеров
-	bh := brandhandler.New(bs)
-	mh := modelhandler.New(ms)
+	bh := brandhandler.New(bs, keeper)
+	mh := modelhandler.New(ms, keeper)
+	akh := apikeyhandler.New(aks)
+	ah := audithandler.New(as)
+	wh := webhookhandler.New(ws)
+	dh := duplicatehandler.New(ds)
+	th := translationhandler.New(ts)
+	vh := varianthandler.New(vs)
+	sh := specschemahandler.New(ss)
+	hub := changefeed.NewHub(cfg.Changes, or, zerohook.Logger)
+	ch := changeshandler.New(hub)
+
+	// Аутентификация запросов по JWT и API-ключам
+	authenticator, err := auth.New(ctx, cfg.Auth, zerohook.Logger)
+	if err != nil {
+		zerohook.Logger.Fatal().Err(err).Msg("Ошибка инициализации аутентификации")
+		return
+	}
+	authenticator.SetAPIKeyVerifier(aks)
 
 	// Создание API-сервиса
-	apiService, err := api.NewService(zerohook.Logger, bh, mh)
+	apiService, err := api.NewService(zerohook.Logger, redactor, authenticator, locales, bh, mh, akh, ah, wh, ch, dh, th, vh, sh)
 	if err != nil {
 		zerohook.Logger.Fatal().Err(err)
 		return
@@ -92,6 +225,55 @@ func main() {
 		}
 	}()
 
+	// Публикация доменных событий из outbox во внешний sink, в очередь вебхуков и в ленту изменений
+	relayCtx, stopRelay := context.WithCancel(ctx)
+	defer stopRelay()
+	var sinks []outbox.Sink
+	if cfg.Outbox.Enabled {
+		sink, err := outbox.NewSink(cfg.Outbox.Sink)
+		if err != nil {
+			zerohook.Logger.Fatal().Err(err).Msg("Ошибка инициализации получателя событий outbox")
+			return
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Webhooks.Enabled {
+		sinks = append(sinks, webhook.NewEnqueuer(wr, zerohook.Logger))
+		go webhook.NewDispatcher(cfg.Webhooks, wr, zerohook.Logger).Run(relayCtx)
+	}
+	// Уведомления LISTEN/NOTIFY будят релей и ленту изменений без ожидания опроса
+	var listener *pg.Listener
+	if cfg.Postgres.Listen.Enabled {
+		listener = pgInstance.NewListener(cfg.Postgres.Listen, zerohook.Logger)
+	}
+	if cfg.Changes.Enabled {
+		if listener != nil {
+			listener.Subscribe(func(pg.ChangeNotification) { hub.Wake() })
+		}
+		go hub.Run(relayCtx)
+	}
+	if len(sinks) > 0 {
+		sink := outbox.NewMultiSink(sinks...)
+		defer sink.Close()
+		relay := outbox.NewRelay(cfg.Outbox, or, sink, zerohook.Logger)
+		if listener != nil {
+			listener.Subscribe(func(pg.ChangeNotification) { relay.Wake() })
+		}
+		go relay.Run(relayCtx)
+	}
+	if listener != nil {
+		go listener.Run(relayCtx)
+	}
+	if cfg.Idempotency.Enabled {
+		go keeper.Run(relayCtx)
+	}
+	if cfg.Retention.Enabled {
+		go retention.New(cfg.Retention, br, mr, zerohook.Logger).Run(relayCtx)
+	}
+	if cfg.Duplicates.Enabled {
+		go dedup.New(cfg.Duplicates, br, mr, dr, zerohook.Logger).Run(relayCtx)
+	}
+
 	go metrics.StartPrometheusServer(fmt.Sprintf(":%d", cfg.Prometheus.Port))
 
 	// Завершение программы
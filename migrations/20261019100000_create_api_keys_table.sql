@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys (
+                          id uuid NOT NULL PRIMARY KEY,
+                          name VARCHAR(255) NOT NULL,
+                          prefix VARCHAR(16) NOT NULL,
+                          key_hash BYTEA NOT NULL,
+                          scopes TEXT[] NOT NULL DEFAULT '{}',
+                          expires_at TIMESTAMP,
+                          last_used_at TIMESTAMP,
+                          revoked_at TIMESTAMP,
+                          created_by VARCHAR(255) NOT NULL DEFAULT '',
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Уникальный индекс для поиска ключа по открытому префиксу
+CREATE UNIQUE INDEX idx_api_keys_prefix ON api_keys (prefix);
+
+-- Индекс для выборки активных ключей
+CREATE INDEX idx_api_keys_revoked_at ON api_keys (revoked_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_api_keys_revoked_at;
+DROP INDEX IF EXISTS idx_api_keys_prefix;
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
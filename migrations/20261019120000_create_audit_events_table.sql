@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_events (
+                              id uuid NOT NULL PRIMARY KEY,
+                              occurred_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              actor VARCHAR(255) NOT NULL DEFAULT '',
+                              request_id VARCHAR(128) NOT NULL DEFAULT '',
+                              operation VARCHAR(32) NOT NULL,
+                              entity_type VARCHAR(32) NOT NULL,
+                              entity_id uuid NOT NULL,
+                              before JSONB,
+                              after JSONB,
+                              diff JSONB NOT NULL DEFAULT '{}'
+);
+
+-- Индекс для истории изменений конкретной записи
+CREATE INDEX idx_audit_events_entity_id ON audit_events (entity_id, occurred_at DESC);
+
+-- Индекс для поиска действий пользователя или сервисного клиента
+CREATE INDEX idx_audit_events_actor ON audit_events (actor, occurred_at DESC);
+
+-- Индекс для выборки по периоду
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_audit_events_occurred_at;
+DROP INDEX IF EXISTS idx_audit_events_actor;
+DROP INDEX IF EXISTS idx_audit_events_entity_id;
+DROP TABLE IF EXISTS audit_events;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE brand_revisions (
+                                 brand_id uuid NOT NULL,
+                                 revision INTEGER NOT NULL,
+                                 operation VARCHAR(32) NOT NULL,
+                                 actor VARCHAR(255) NOT NULL DEFAULT '',
+                                 request_id VARCHAR(128) NOT NULL DEFAULT '',
+                                 valid_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 data JSONB NOT NULL,
+                                 PRIMARY KEY (brand_id, revision)
+);
+
+-- Индекс для чтения бренда на момент времени
+CREATE INDEX idx_brand_revisions_valid_from ON brand_revisions (brand_id, valid_from DESC);
+
+CREATE TABLE model_revisions (
+                                 model_id uuid NOT NULL,
+                                 revision INTEGER NOT NULL,
+                                 operation VARCHAR(32) NOT NULL,
+                                 actor VARCHAR(255) NOT NULL DEFAULT '',
+                                 request_id VARCHAR(128) NOT NULL DEFAULT '',
+                                 valid_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 data JSONB NOT NULL,
+                                 PRIMARY KEY (model_id, revision)
+);
+
+-- Индекс для чтения модели на момент времени
+CREATE INDEX idx_model_revisions_valid_from ON model_revisions (model_id, valid_from DESC);
+
+-- Начальная ревизия для существующих записей; время сериализуется в RFC 3339,
+-- как это делает сервис
+INSERT INTO brand_revisions (brand_id, revision, operation, valid_from, data)
+SELECT b.id, 1, 'create', COALESCE(b.updated_at, CURRENT_TIMESTAMP),
+       to_jsonb(b) || jsonb_build_object(
+               'created_at', to_char(b.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+               'updated_at', to_char(b.updated_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'))
+FROM brands b;
+
+INSERT INTO model_revisions (model_id, revision, operation, valid_from, data)
+SELECT m.id, 1, 'create', COALESCE(m.updated_at, CURRENT_TIMESTAMP),
+       to_jsonb(m) || jsonb_build_object(
+               'release_date', to_char(m.release_date, 'YYYY-MM-DD"T00:00:00Z"'),
+               'created_at', to_char(m.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+               'updated_at', to_char(m.updated_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'))
+FROM models m;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_model_revisions_valid_from;
+DROP TABLE IF EXISTS model_revisions;
+DROP INDEX IF EXISTS idx_brand_revisions_valid_from;
+DROP TABLE IF EXISTS brand_revisions;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox_events (
+                               sequence BIGSERIAL PRIMARY KEY,
+                               id uuid NOT NULL,
+                               event_type VARCHAR(64) NOT NULL,
+                               aggregate_type VARCHAR(32) NOT NULL,
+                               aggregate_id uuid NOT NULL,
+                               actor VARCHAR(255) NOT NULL DEFAULT '',
+                               request_id VARCHAR(128) NOT NULL DEFAULT '',
+                               payload JSONB NOT NULL,
+                               occurred_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               published_at TIMESTAMP,
+                               attempts INTEGER NOT NULL DEFAULT 0,
+                               last_error TEXT NOT NULL DEFAULT ''
+);
+
+-- Уникальный индекс по ID события для дедупликации у потребителей
+CREATE UNIQUE INDEX idx_outbox_events_id ON outbox_events (id);
+
+-- Частичный индекс очереди неопубликованных событий
+CREATE INDEX idx_outbox_events_pending ON outbox_events (sequence) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+DROP INDEX IF EXISTS idx_outbox_events_id;
+DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_subscriptions (
+                                       id uuid NOT NULL PRIMARY KEY,
+                                       url TEXT NOT NULL,
+                                       event_types TEXT[] NOT NULL DEFAULT '{}',
+                                       secret VARCHAR(255) NOT NULL,
+                                       description TEXT NOT NULL DEFAULT '',
+                                       is_active BOOLEAN NOT NULL DEFAULT true,
+                                       created_by VARCHAR(255) NOT NULL DEFAULT '',
+                                       created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                       updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Индекс для подбора подписок по типу события
+CREATE INDEX idx_webhook_subscriptions_event_types ON webhook_subscriptions USING GIN (event_types);
+
+CREATE TABLE webhook_deliveries (
+                                    id uuid NOT NULL PRIMARY KEY,
+                                    subscription_id uuid NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+                                    event_id uuid NOT NULL,
+                                    event_type VARCHAR(64) NOT NULL,
+                                    payload JSONB NOT NULL,
+                                    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+                                    attempts INTEGER NOT NULL DEFAULT 0,
+                                    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    last_status_code INTEGER,
+                                    last_error TEXT,
+                                    delivered_at TIMESTAMP,
+                                    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Одно событие доставляется подписке один раз, повторная публикация из outbox игнорируется
+CREATE UNIQUE INDEX idx_webhook_deliveries_subscription_event ON webhook_deliveries (subscription_id, event_id);
+
+-- Индекс для выборки доставок, срок отправки которых наступил
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- Индекс для журнала доставок подписки
+CREATE INDEX idx_webhook_deliveries_subscription_created ON webhook_deliveries (subscription_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_created;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_event;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_event_types;
+DROP TABLE IF EXISTS webhook_subscriptions;
+-- +goose StatementEnd
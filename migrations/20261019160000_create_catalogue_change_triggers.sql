@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уведомление об изменении бренда или модели в канал catalogue_changes.
+-- Уведомления доставляются слушателям при фиксации транзакции, поэтому
+-- реплики API узнают об изменениях, в том числе сделанных в обход сервиса,
+-- без опроса таблиц.
+CREATE OR REPLACE FUNCTION notify_catalogue_change() RETURNS trigger AS $$
+DECLARE
+    rec RECORD;
+    payload JSON;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+    ELSE
+        rec := NEW;
+    END IF;
+
+    IF TG_TABLE_NAME = 'brands' THEN
+        payload := json_build_object(
+            'entity', 'brand',
+            'operation', lower(TG_OP),
+            'id', rec.id,
+            'brand_id', rec.id
+        );
+    ELSE
+        payload := json_build_object(
+            'entity', 'model',
+            'operation', lower(TG_OP),
+            'id', rec.id,
+            'brand_id', rec.brand_id
+        );
+    END IF;
+
+    PERFORM pg_notify('catalogue_changes', payload::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER trg_brands_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON brands
+    FOR EACH ROW EXECUTE FUNCTION notify_catalogue_change();
+
+CREATE TRIGGER trg_models_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON models
+    FOR EACH ROW EXECUTE FUNCTION notify_catalogue_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_models_notify_change ON models;
+DROP TRIGGER IF EXISTS trg_brands_notify_change ON brands;
+DROP FUNCTION IF EXISTS notify_catalogue_change();
+-- +goose StatementEnd
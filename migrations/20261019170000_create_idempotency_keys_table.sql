@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_keys (
+                                  scope VARCHAR(255) NOT NULL,
+                                  key VARCHAR(255) NOT NULL,
+                                  fingerprint BYTEA NOT NULL,
+                                  status VARCHAR(16) NOT NULL DEFAULT 'processing',
+                                  response_status INTEGER,
+                                  content_type VARCHAR(255) NOT NULL DEFAULT '',
+                                  response_body BYTEA,
+                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  expires_at TIMESTAMP NOT NULL,
+                                  PRIMARY KEY (scope, key)
+);
+
+-- Индекс для удаления просроченных ключей
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
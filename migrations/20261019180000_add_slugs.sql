@@ -0,0 +1,131 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE brands ADD COLUMN slug VARCHAR(100);
+ALTER TABLE models ADD COLUMN slug VARCHAR(100);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Временная функция для заполнения слагов существующих записей; повторяет
+-- Brands/internal/slug.Make: сначала транслитерация по той же таблице, затем
+-- разложение NFD и отбрасывание диакритики у остальных латинских букв.
+-- Заглавные буквы вне ASCII, которые не раскладываются на базовую букву и
+-- диакритику, переводятся в строчные явно, так как lower() в локали C
+-- меняет только ASCII; для остальных после разложения достаточно lower().
+CREATE FUNCTION pg_temp.slugify(name TEXT) RETURNS TEXT AS $$
+DECLARE
+    s TEXT;
+BEGIN
+    s := translate(name,
+        'АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯІЇЄҐЎÆŒØĐŁÞẞ',
+        'абвгдеёжзийклмнопрстуфхцчшщъыьэюяіїєґўæœøđłþß');
+    s := replace(s, 'щ', 'shch');
+    s := replace(s, 'ё', 'yo');
+    s := replace(s, 'ж', 'zh');
+    s := replace(s, 'х', 'kh');
+    s := replace(s, 'ц', 'ts');
+    s := replace(s, 'ч', 'ch');
+    s := replace(s, 'ш', 'sh');
+    s := replace(s, 'ю', 'yu');
+    s := replace(s, 'я', 'ya');
+    s := replace(s, 'ї', 'yi');
+    s := replace(s, 'є', 'ye');
+    s := replace(s, 'ß', 'ss');
+    s := replace(s, 'æ', 'ae');
+    s := replace(s, 'œ', 'oe');
+    s := replace(s, 'þ', 'th');
+    -- Символы без пары во второй строке (ъ, ь, апострофы) удаляются
+    s := translate(s,
+        'абвгдезийклмнопрстуфыэіґўøđłъь''’`',
+        'abvgdeziyklmnoprstufyeiguodl');
+    -- Разложение отделяет диакритику: É -> E + U+0301. Удаляются блоки
+    -- комбинируемых знаков, затем lower() переводит базовую букву
+    s := lower(regexp_replace(normalize(s, NFD),
+        '[\u0300-\u036f\u1ab0-\u1aff\u1dc0-\u1dff\u20d0-\u20ff\ufe20-\ufe2f]', '', 'g'));
+    s := trim(BOTH '-' FROM regexp_replace(s, '[^a-z0-9]+', '-', 'g'));
+    RETURN trim(TRAILING '-' FROM left(s, 100));
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Дубликаты получают суффикс из случайной части UUID; первым слаг без
+-- суффикса получает старейшая неудаленная запись
+WITH base AS (
+    SELECT id, is_deleted, created_at, pg_temp.slugify(name) AS slug
+    FROM brands
+), ranked AS (
+    SELECT id, slug, row_number() OVER (
+        PARTITION BY slug ORDER BY is_deleted, created_at, id
+    ) AS rn
+    FROM base
+)
+UPDATE brands b
+SET slug = CASE
+    WHEN r.slug = '' THEN 'brand-' || right(replace(b.id::text, '-', ''), 8)
+    WHEN r.rn > 1 THEN left(r.slug, 91) || '-' || right(replace(b.id::text, '-', ''), 8)
+    ELSE r.slug
+END
+FROM ranked r
+WHERE r.id = b.id;
+
+WITH base AS (
+    SELECT id, brand_id, is_deleted, created_at, pg_temp.slugify(name) AS slug
+    FROM models
+), ranked AS (
+    SELECT id, slug, row_number() OVER (
+        PARTITION BY brand_id, slug ORDER BY is_deleted, created_at, id
+    ) AS rn
+    FROM base
+)
+UPDATE models m
+SET slug = CASE
+    WHEN r.slug = '' THEN 'model-' || right(replace(m.id::text, '-', ''), 8)
+    WHEN r.rn > 1 THEN left(r.slug, 91) || '-' || right(replace(m.id::text, '-', ''), 8)
+    ELSE r.slug
+END
+FROM ranked r
+WHERE r.id = m.id;
+
+ALTER TABLE brands ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE models ALTER COLUMN slug SET NOT NULL;
+
+-- Уникальность слага бренда среди неудаленных брендов
+CREATE UNIQUE INDEX uq_brands_slug ON brands (slug) WHERE is_deleted = false;
+
+-- Уникальность слага модели в пределах бренда среди неудаленных моделей
+CREATE UNIQUE INDEX uq_models_brand_slug ON models (brand_id, slug) WHERE is_deleted = false;
+
+-- Прежние слаги брендов для перенаправления на актуальный адрес
+CREATE TABLE brand_slug_history (
+    slug VARCHAR(100) NOT NULL PRIMARY KEY,
+    brand_id uuid NOT NULL,
+    replaced_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Индекс для выборки прежних слагов бренда
+CREATE INDEX idx_brand_slug_history_brand_id ON brand_slug_history (brand_id);
+
+-- Прежние слаги моделей в пределах бренда, которому модель тогда принадлежала
+CREATE TABLE model_slug_history (
+    brand_id uuid NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    model_id uuid NOT NULL,
+    replaced_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (brand_id, slug)
+);
+
+-- Индекс для выборки прежних слагов модели
+CREATE INDEX idx_model_slug_history_model_id ON model_slug_history (model_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_model_slug_history_model_id;
+DROP TABLE IF EXISTS model_slug_history;
+DROP INDEX IF EXISTS idx_brand_slug_history_brand_id;
+DROP TABLE IF EXISTS brand_slug_history;
+DROP INDEX IF EXISTS uq_models_brand_slug;
+DROP INDEX IF EXISTS uq_brands_slug;
+ALTER TABLE models DROP COLUMN IF EXISTS slug;
+ALTER TABLE brands DROP COLUMN IF EXISTS slug;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Модель удалена каскадно вместе с брендом; восстановление бренда
+-- восстанавливает только такие модели
+ALTER TABLE models ADD COLUMN deleted_with_brand BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Модели уже удаленных брендов скрываются так же, как при каскадном удалении
+UPDATE models m
+SET is_deleted = true, deleted_with_brand = true, updated_at = NOW()
+FROM brands b
+WHERE b.id = m.brand_id AND b.is_deleted = true AND m.is_deleted = false;
+
+-- Внешний ключ проверяет новые записи сразу; существующие — только если
+-- среди них нет моделей без бренда, иначе их нужно разобрать вручную и
+-- выполнить VALIDATE CONSTRAINT
+ALTER TABLE models
+    ADD CONSTRAINT fk_models_brand_id FOREIGN KEY (brand_id) REFERENCES brands (id)
+    ON DELETE RESTRICT NOT VALID;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM models m WHERE NOT EXISTS (SELECT 1 FROM brands b WHERE b.id = m.brand_id)
+    ) THEN
+        ALTER TABLE models VALIDATE CONSTRAINT fk_models_brand_id;
+    ELSE
+        RAISE WARNING 'models without brand found, fk_models_brand_id is left NOT VALID';
+    END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Индекс для выборки каскадно удаленных моделей бренда при восстановлении
+CREATE INDEX idx_models_deleted_with_brand ON models (brand_id) WHERE deleted_with_brand = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_models_deleted_with_brand;
+ALTER TABLE models DROP CONSTRAINT IF EXISTS fk_models_brand_id;
+ALTER TABLE models DROP COLUMN IF EXISTS deleted_with_brand;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE brands ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE brands ADD COLUMN deleted_by VARCHAR(255);
+ALTER TABLE models ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE models ADD COLUMN deleted_by VARCHAR(255);
+
+-- Время удаления ранее удаленных записей неизвестно; берется время
+-- последнего изменения, чтобы срок хранения в корзине отсчитывался от него
+UPDATE brands SET deleted_at = updated_at WHERE is_deleted = true;
+UPDATE models SET deleted_at = updated_at WHERE is_deleted = true;
+
+-- Индекс для корзины брендов и поиска брендов с истекшим сроком хранения
+CREATE INDEX idx_brands_deleted_at ON brands (deleted_at) WHERE is_deleted = true;
+
+-- Индекс для корзины моделей и поиска моделей с истекшим сроком хранения
+CREATE INDEX idx_models_deleted_at ON models (deleted_at) WHERE is_deleted = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_models_deleted_at;
+DROP INDEX IF EXISTS idx_brands_deleted_at;
+ALTER TABLE models DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE models DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE brands DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE brands DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
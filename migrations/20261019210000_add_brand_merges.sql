@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Бренд, в который слит удаленный бренд-дубликат
+ALTER TABLE brands ADD COLUMN merged_into uuid;
+
+-- Индекс для выборки брендов, слитых в бренд
+CREATE INDEX idx_brands_merged_into ON brands (merged_into) WHERE merged_into IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_brands_merged_into;
+ALTER TABLE brands DROP COLUMN IF EXISTS merged_into;
+-- +goose StatementEnd
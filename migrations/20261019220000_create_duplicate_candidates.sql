@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пары записей, похожих на дубликаты; заполняется задачей поиска дубликатов.
+-- Отклоненные редактором пары сохраняются, чтобы не предлагаться повторно.
+CREATE TABLE duplicate_candidates (
+    entity VARCHAR(16) NOT NULL,                         -- brand или model
+    left_id uuid NOT NULL,                               -- Меньший ID пары
+    right_id uuid NOT NULL,                              -- Больший ID пары
+    score DOUBLE PRECISION NOT NULL,                     -- Оценка сходства 0..1
+    name_similarity DOUBLE PRECISION NOT NULL DEFAULT 0, -- Триграммное сходство нормализованных названий
+    reasons TEXT[] NOT NULL,                             -- Совпавшие признаки
+    status VARCHAR(16) NOT NULL DEFAULT 'open',          -- open или dismissed
+    detected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dismissed_at TIMESTAMP,
+    dismissed_by VARCHAR(255),
+    PRIMARY KEY (entity, left_id, right_id),
+    CHECK (left_id < right_id)
+);
+
+-- Индекс для отчета по открытым парам
+CREATE INDEX idx_duplicate_candidates_open ON duplicate_candidates (entity, score DESC) WHERE status = 'open';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_duplicate_candidates_open;
+DROP TABLE IF EXISTS duplicate_candidates;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Альтернативные названия брендов для поиска: сокращения, написания на
+-- других языках, прежние названия
+CREATE TABLE brand_aliases (
+    id uuid NOT NULL PRIMARY KEY,
+    brand_id uuid NOT NULL REFERENCES brands (id) ON DELETE CASCADE,
+    alias VARCHAR(255) NOT NULL,              -- Название в исходном написании
+    slug VARCHAR(100) NOT NULL,               -- Слаг названия для сравнения и адресов
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Псевдоним однозначно указывает на один бренд
+CREATE UNIQUE INDEX uq_brand_aliases_slug ON brand_aliases (slug);
+
+-- Индекс для выборки псевдонимов бренда
+CREATE INDEX idx_brand_aliases_brand_id ON brand_aliases (brand_id);
+
+-- Названия уже слитых брендов становятся псевдонимами брендов, в которые они слиты
+INSERT INTO brand_aliases (id, brand_id, alias, slug, created_at)
+SELECT gen_random_uuid(), b.merged_into, b.name, b.slug, COALESCE(b.deleted_at, NOW())
+FROM brands b
+JOIN brands t ON t.id = b.merged_into
+WHERE b.merged_into IS NOT NULL AND b.slug <> t.slug
+ON CONFLICT (slug) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_brand_aliases_brand_id;
+DROP INDEX IF EXISTS uq_brand_aliases_slug;
+DROP TABLE IF EXISTS brand_aliases;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Переводы полей брендов; основные поля записи хранятся на основном языке,
+-- NULL означает, что поле на этот язык не переведено
+CREATE TABLE brand_translations (
+    brand_id uuid NOT NULL REFERENCES brands (id) ON DELETE CASCADE,
+    locale VARCHAR(16) NOT NULL,              -- Код языка: en, kk
+    name VARCHAR(255),                        -- Название бренда
+    description TEXT,                         -- Описание/история бренда
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (brand_id, locale),
+    CHECK (name IS NOT NULL OR description IS NOT NULL)
+);
+
+-- Переводы названий моделей
+CREATE TABLE model_translations (
+    model_id uuid NOT NULL REFERENCES models (id) ON DELETE CASCADE,
+    locale VARCHAR(16) NOT NULL,              -- Код языка: en, kk
+    name VARCHAR(255) NOT NULL,               -- Название модели
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (model_id, locale)
+);
+
+-- Индексы для отчета о полноте переводов по языку
+CREATE INDEX idx_brand_translations_locale ON brand_translations (locale);
+CREATE INDEX idx_model_translations_locale ON model_translations (locale);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_model_translations_locale;
+DROP INDEX IF EXISTS idx_brand_translations_locale;
+DROP TABLE IF EXISTS model_translations;
+DROP TABLE IF EXISTS brand_translations;
+-- +goose StatementEnd
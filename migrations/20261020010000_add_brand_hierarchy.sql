@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Родительский бренд (концерн или группа): VAG → Audi, Porsche, Škoda.
+-- Безвозвратное удаление родителя делает дочерние бренды корневыми
+ALTER TABLE brands
+    ADD COLUMN parent_id uuid
+    CONSTRAINT fk_brands_parent_id REFERENCES brands (id) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_brands_parent_not_self CHECK (parent_id <> id);
+
+-- Индекс для выборки дочерних брендов и обхода иерархии вниз
+CREATE INDEX idx_brands_parent_id ON brands (parent_id) WHERE parent_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_brands_parent_id;
+ALTER TABLE brands DROP CONSTRAINT IF EXISTS chk_brands_parent_not_self;
+ALTER TABLE brands DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
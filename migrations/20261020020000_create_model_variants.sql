@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Поколения моделей: Golf Mk7, Golf Mk8
+CREATE TABLE model_generations (
+    id uuid NOT NULL PRIMARY KEY,
+    model_id uuid NOT NULL REFERENCES models (id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,               -- Название поколения
+    start_year INT NOT NULL,                  -- Первый год выпуска
+    end_year INT,                             -- Последний год выпуска; NULL — выпускается
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    deleted_at TIMESTAMP,
+    deleted_by VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_year IS NULL OR end_year >= start_year)
+);
+
+-- Комплектации поколений
+CREATE TABLE model_trims (
+    id uuid NOT NULL PRIMARY KEY,
+    generation_id uuid NOT NULL REFERENCES model_generations (id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,               -- Название комплектации
+    is_upcoming BOOLEAN NOT NULL DEFAULT FALSE,
+    is_limited BOOLEAN NOT NULL DEFAULT FALSE,
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    deleted_with_generation BOOLEAN NOT NULL DEFAULT FALSE, -- Удалена каскадно вместе с поколением
+    deleted_at TIMESTAMP,
+    deleted_by VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Названия неудаленных поколений уникальны в пределах модели, комплектаций —
+-- в пределах поколения
+CREATE UNIQUE INDEX uq_model_generations_name ON model_generations (model_id, lower(name)) WHERE is_deleted = false;
+CREATE UNIQUE INDEX uq_model_trims_name ON model_trims (generation_id, lower(name)) WHERE is_deleted = false;
+
+-- Индекс для фильтра моделей по годам выпуска поколений
+CREATE INDEX idx_model_generations_years ON model_generations (model_id, start_year, end_year) WHERE is_deleted = false;
+CREATE INDEX idx_model_trims_generation_id ON model_trims (generation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_model_trims_generation_id;
+DROP INDEX IF EXISTS idx_model_generations_years;
+DROP INDEX IF EXISTS uq_model_trims_name;
+DROP INDEX IF EXISTS uq_model_generations_name;
+DROP TABLE IF EXISTS model_trims;
+DROP TABLE IF EXISTS model_generations;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Схемы характеристик категорий (cars, sneakers, phones) в формате JSON Schema
+CREATE TABLE spec_schemas (
+    id uuid NOT NULL PRIMARY KEY,
+    category VARCHAR(100) NOT NULL,           -- Слаг категории
+    schema JSONB NOT NULL,                    -- JSON Schema характеристик моделей категории
+    version INT NOT NULL DEFAULT 1,           -- Растет при каждой замене схемы
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_spec_schemas_category UNIQUE (category),
+    CHECK (jsonb_typeof(schema) = 'object')
+);
+
+-- Категория и характеристики модели. Схему нельзя удалить, пока у нее есть
+-- модели, в том числе удаленные
+ALTER TABLE models
+    ADD COLUMN category VARCHAR(100)
+    CONSTRAINT fk_models_category REFERENCES spec_schemas (category) ON DELETE RESTRICT,
+    ADD COLUMN specs JSONB NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT chk_models_specs_object CHECK (jsonb_typeof(specs) = 'object');
+
+-- GIN-индекс для фильтров по характеристикам (specs @? jsonpath): отбирает
+-- модели по наличию характеристики и по равенству значения
+CREATE INDEX idx_models_specs ON models USING GIN (specs) WHERE is_deleted = false;
+CREATE INDEX idx_models_category ON models (category) WHERE category IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_models_category;
+DROP INDEX IF EXISTS idx_models_specs;
+ALTER TABLE models DROP CONSTRAINT IF EXISTS chk_models_specs_object;
+ALTER TABLE models DROP COLUMN IF EXISTS specs;
+ALTER TABLE models DROP COLUMN IF EXISTS category;
+DROP TABLE IF EXISTS spec_schemas;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Аренда события релеем: публикация идет вне транзакции захвата, а
+-- истекшая аренда возвращает событие в очередь
+ALTER TABLE outbox_events ADD COLUMN claimed_until TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS claimed_until;
+-- +goose StatementEnd
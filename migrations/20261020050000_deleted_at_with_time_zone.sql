@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время удаления сравнивается с NOW() при очистке корзины, поэтому хранится
+-- с часовым поясом. Прежние значения записаны через NOW() и трактуются в
+-- часовом поясе сессии, как и при записи.
+ALTER TABLE brands ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+ALTER TABLE models ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+ALTER TABLE model_generations ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+ALTER TABLE model_trims ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE model_trims ALTER COLUMN deleted_at TYPE TIMESTAMP;
+ALTER TABLE model_generations ALTER COLUMN deleted_at TYPE TIMESTAMP;
+ALTER TABLE models ALTER COLUMN deleted_at TYPE TIMESTAMP;
+ALTER TABLE brands ALTER COLUMN deleted_at TYPE TIMESTAMP;
+-- +goose StatementEnd
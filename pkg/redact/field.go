@@ -0,0 +1,18 @@
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// JSONField сохраняет объект в логе спана в виде JSON, чтобы при экспорте
+// его поля могли быть замаскированы по ключам и JSON-путям
+func JSONField(key string, v any) log.Field {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return log.String(key, fmt.Sprintf("[unserializable %T]", v))
+	}
+	return log.String(key, string(data))
+}
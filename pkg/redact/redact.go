@@ -0,0 +1,286 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	DefaultMask        = "***"
+	DefaultMaxBodySize = 4096
+
+	truncatedSuffix = "...(truncated)"
+)
+
+// Config настройки маскирования чувствительных данных в спанах и логах
+type Config struct {
+	MaxBodySize  int      `yaml:"max_body_size"` // Максимальный размер сохраняемого тела запроса, байт (-1 — не сохранять)
+	ContentTypes []string `yaml:"content_types"` // Типы содержимого, тело которых разрешено сохранять
+	MaskPaths    []string `yaml:"mask_paths"`    // JSON-пути маскируемых полей, например $.contacts[*].email
+	Keys         []string `yaml:"keys"`          // Имена ключей, маскируемых на любом уровне вложенности
+	Mask         string   `yaml:"mask"`          // Строка-замена, по умолчанию ***
+}
+
+// Redactor маскирует чувствительные данные по настроенным правилам
+type Redactor struct {
+	maxBodySize  int
+	contentTypes []string
+	paths        [][]string
+	keys         map[string]struct{}
+	mask         string
+	// needles имена ключей в кавычках, без одного из которых в документе
+	// нечего маскировать; anyKey — есть путь только из шаблонов *
+	needles [][]byte
+	anyKey  bool
+}
+
+// New создает Redactor по конфигурации
+func New(cfg Config) *Redactor {
+	r := &Redactor{
+		maxBodySize: cfg.MaxBodySize,
+		keys:        make(map[string]struct{}, len(cfg.Keys)),
+		mask:        cfg.Mask,
+	}
+	if r.maxBodySize == 0 {
+		r.maxBodySize = DefaultMaxBodySize
+	}
+	if r.mask == "" {
+		r.mask = DefaultMask
+	}
+	for _, ct := range cfg.ContentTypes {
+		r.contentTypes = append(r.contentTypes, strings.ToLower(strings.TrimSpace(ct)))
+	}
+	for _, key := range cfg.Keys {
+		r.keys[strings.ToLower(key)] = struct{}{}
+		r.needles = append(r.needles, quoted(key))
+	}
+	for _, path := range cfg.MaskPaths {
+		if segments := parsePath(path); len(segments) > 0 {
+			r.paths = append(r.paths, segments)
+			r.addPathNeedle(segments)
+		}
+	}
+	return r
+}
+
+// addPathNeedle запоминает последний именованный сегмент пути: путь
+// совпадает только с документом, в котором есть ключ с таким именем
+func (r *Redactor) addPathNeedle(segments []string) {
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != "*" {
+			r.needles = append(r.needles, quoted(segments[i]))
+			return
+		}
+	}
+	r.anyKey = true
+}
+
+// Enabled сообщает, заданы ли правила маскирования полей
+func (r *Redactor) Enabled() bool {
+	return len(r.keys) > 0 || len(r.paths) > 0
+}
+
+// MayContainSensitive быстро, без разбора JSON, проверяет, может ли документ
+// содержать маскируемые поля: ищет имена ключей и сегментов путей в кавычках
+// без учета регистра. Ложноположительный ответ возможен, ложноотрицательный — нет.
+func (r *Redactor) MayContainSensitive(data []byte) bool {
+	if r.anyKey {
+		return true
+	}
+	for _, needle := range r.needles {
+		if containsFold(data, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSensitiveKey сообщает, должно ли значение с таким ключом маскироваться
+func (r *Redactor) IsSensitiveKey(key string) bool {
+	_, ok := r.keys[strings.ToLower(key)]
+	return ok
+}
+
+// Body возвращает тело запроса, пригодное для сохранения в спане:
+// с учетом разрешенных типов содержимого, маскирования и ограничения размера
+func (r *Redactor) Body(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if r.maxBodySize < 0 {
+		return "[body not captured]"
+	}
+	if !r.contentTypeAllowed(contentType) {
+		return fmt.Sprintf("[body not captured: content-type %q]", contentType)
+	}
+	return r.truncate(r.String(string(body)))
+}
+
+// String маскирует поля в строке, если она содержит JSON-документ;
+// прочие строки возвращаются без изменений
+func (r *Redactor) String(value string) string {
+	if !r.Enabled() || !looksLikeJSON(value) {
+		return value
+	}
+	masked, err := r.JSON([]byte(value))
+	if err != nil {
+		return value
+	}
+	return string(masked)
+}
+
+// Value маскирует значение по имени его ключа либо по содержимому
+func (r *Redactor) Value(key, value string) string {
+	if r.IsSensitiveKey(key) {
+		return r.mask
+	}
+	return r.String(value)
+}
+
+// Object сериализует объект в JSON с маскированием полей
+func (r *Redactor) Object(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("[unserializable %T]", v)
+	}
+	if !r.Enabled() {
+		return string(data)
+	}
+	masked, err := r.JSON(data)
+	if err != nil {
+		return string(data)
+	}
+	return string(masked)
+}
+
+// JSON маскирует поля JSON-документа по ключам и JSON-путям
+func (r *Redactor) JSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	doc = r.maskKeys(doc)
+	for _, path := range r.paths {
+		doc = r.maskPath(doc, path)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (r *Redactor) maskKeys(node any) any {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if r.IsSensitiveKey(key) {
+				v[key] = r.mask
+				continue
+			}
+			v[key] = r.maskKeys(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = r.maskKeys(value)
+		}
+	}
+	return node
+}
+
+func (r *Redactor) maskPath(node any, path []string) any {
+	if len(path) == 0 {
+		if node == nil {
+			return nil
+		}
+		return r.mask
+	}
+	segment, rest := path[0], path[1:]
+	switch v := node.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if segment == "*" || strings.EqualFold(segment, key) {
+				v[key] = r.maskPath(value, rest)
+			}
+		}
+	case []any:
+		if segment != "*" {
+			return node
+		}
+		for i, value := range v {
+			v[i] = r.maskPath(value, rest)
+		}
+	}
+	return node
+}
+
+func (r *Redactor) contentTypeAllowed(contentType string) bool {
+	if len(r.contentTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	for _, allowed := range r.contentTypes {
+		if allowed == mediaType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redactor) truncate(value string) string {
+	if r.maxBodySize > 0 && len(value) > r.maxBodySize {
+		cut := r.maxBodySize
+		for cut > 0 && !utf8.RuneStart(value[cut]) {
+			cut--
+		}
+		return value[:cut] + truncatedSuffix
+	}
+	return value
+}
+
+// parsePath разбирает JSON-путь вида $.a.b[*].c в сегменты [a b * c]
+func parsePath(path string) []string {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+	path = strings.ReplaceAll(path, "[*]", ".*")
+	var segments []string
+	for _, segment := range strings.Split(path, ".") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// quoted возвращает имя ключа в кавычках, как оно записывается в JSON
+func quoted(key string) []byte {
+	return []byte(`"` + strings.ToLower(key) + `"`)
+}
+
+// containsFold сообщает, содержит ли data подстроку needle без учета регистра
+func containsFold(data, needle []byte) bool {
+	for i := 0; i+len(needle) <= len(data); i++ {
+		// needle начинается с кавычки, сравнение с ней отсеивает почти все позиции
+		if data[i] == needle[0] && bytes.EqualFold(data[i:i+len(needle)], needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func looksLikeJSON(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")
+}
@@ -0,0 +1,48 @@
+package redact
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// Writer маскирует поля событий zerolog перед записью в нижележащий writer.
+// Хуки zerolog могут только добавлять поля в событие, поэтому очистка уже
+// записанных полей выполняется на уровне вывода.
+type Writer struct {
+	out      io.Writer
+	redactor *Redactor
+}
+
+// NewWriter оборачивает out маскированием по правилам redactor
+func NewWriter(out io.Writer, redactor *Redactor) *Writer {
+	return &Writer{out: out, redactor: redactor}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	// Разбор и повторная сериализация нужны только строкам, в которых
+	// встречается хотя бы один маскируемый ключ
+	if !w.redactor.Enabled() || !w.redactor.MayContainSensitive(p) {
+		return w.out.Write(p)
+	}
+	masked, err := w.redactor.JSON(p)
+	if err != nil {
+		// Не JSON (например, консольный вывод) — пишем как есть
+		return w.out.Write(p)
+	}
+	if bytes.HasSuffix(p, []byte("\n")) {
+		masked = append(masked, '\n')
+	}
+	if _, err = w.out.Write(masked); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *Writer) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if lw, ok := w.out.(zerolog.LevelWriter); ok && (!w.redactor.Enabled() || !w.redactor.MayContainSensitive(p)) {
+		return lw.WriteLevel(level, p)
+	}
+	return w.Write(p)
+}
@@ -0,0 +1,27 @@
+package tracer
+
+import (
+	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+)
+
+// Extract извлекает контекст трассировки из входящих HTTP-заголовков.
+// Поддерживаются W3C traceparent/tracestate, Jaeger uber-trace-id и Zipkin B3;
+// при наличии нескольких форматов приоритет у W3C.
+func Extract(carrier opentracing.HTTPHeadersCarrier) (opentracing.SpanContext, error) {
+	return opentracing.GlobalTracer().Extract(opentracing.HTTPHeaders, carrier)
+}
+
+// TraceID возвращает идентификатор трассы спана в виде 32 hex-символов
+func TraceID(span opentracing.Span) string {
+	sc, ok := span.Context().(interface{ TraceID() trace.TraceID })
+	if !ok || !sc.TraceID().IsValid() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -0,0 +1,75 @@
+package tracer
+
+import (
+	"context"
+
+	"Brands/pkg/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// redactingExporter маскирует атрибуты спанов и событий (логов спана)
+// перед передачей во внутренний экспортер
+type redactingExporter struct {
+	next     sdktrace.SpanExporter
+	redactor *redact.Redactor
+}
+
+// redactedSpan подменяет атрибуты и события исходного спана
+type redactedSpan struct {
+	sdktrace.ReadOnlySpan
+	attributes []attribute.KeyValue
+	events     []sdktrace.Event
+}
+
+func (s redactedSpan) Attributes() []attribute.KeyValue {
+	return s.attributes
+}
+
+func (s redactedSpan) Events() []sdktrace.Event {
+	return s.events
+}
+
+func newRedactingExporter(next sdktrace.SpanExporter, redactor *redact.Redactor) sdktrace.SpanExporter {
+	if redactor == nil || !redactor.Enabled() {
+		return next
+	}
+	return &redactingExporter{next: next, redactor: redactor}
+}
+
+func (e *redactingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	redacted := make([]sdktrace.ReadOnlySpan, len(spans))
+	for i, span := range spans {
+		events := span.Events()
+		redactedEvents := make([]sdktrace.Event, len(events))
+		for j, event := range events {
+			event.Attributes = e.redactAttributes(event.Attributes)
+			redactedEvents[j] = event
+		}
+		redacted[i] = redactedSpan{
+			ReadOnlySpan: span,
+			attributes:   e.redactAttributes(span.Attributes()),
+			events:       redactedEvents,
+		}
+	}
+	return e.next.ExportSpans(ctx, redacted)
+}
+
+func (e *redactingExporter) Shutdown(ctx context.Context) error {
+	return e.next.Shutdown(ctx)
+}
+
+func (e *redactingExporter) redactAttributes(attrs []attribute.KeyValue) []attribute.KeyValue {
+	result := make([]attribute.KeyValue, len(attrs))
+	for i, kv := range attrs {
+		switch {
+		case e.redactor.IsSensitiveKey(string(kv.Key)):
+			result[i] = kv.Key.String(e.redactor.Value(string(kv.Key), kv.Value.Emit()))
+		case kv.Value.Type() == attribute.STRING:
+			result[i] = kv.Key.String(e.redactor.String(kv.Value.AsString()))
+		default:
+			result[i] = kv
+		}
+	}
+	return result
+}
@@ -1,53 +1,165 @@
 package tracer
 
 import (
+	"context"
 	"fmt"
-	"io"
+	"strings"
 
+	"Brands/pkg/redact"
+	"Brands/pkg/yamlenv"
 	"Brands/pkg/zerohook"
 	"github.com/opentracing/opentracing-go"
-	jaeger "github.com/uber/jaeger-client-go"
-	traceconfig "github.com/uber/jaeger-client-go/config"
-	"github.com/uber/jaeger-lib/metrics/prometheus"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/contrib/propagators/jaeger"
+	"go.opentelemetry.io/otel"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	instrumentationName = "Brands"
+
+	defaultSampleRatio = 1.0
+)
+
+// Config настройки трассировки OpenTelemetry
+type Config struct {
+	ServiceName string   `yaml:"service_name"` // Имя сервиса, по умолчанию log.app
+	Endpoint    string   `yaml:"endpoint"`     // Адрес OTLP-коллектора (host:port)
+	Protocol    string   `yaml:"protocol"`     // Протокол экспорта: grpc или http
+	Insecure    bool     `yaml:"insecure"`     // Отключить TLS при экспорте
+	SampleRatio *float64 `yaml:"sample_ratio"` // Доля сэмплируемых корневых трасс (0..1), по умолчанию 1
+}
+
+// sampleRatio возвращает долю сэмплирования. Без явного значения
+// сэмплируются все трассы: нулевое значение структуры отбрасывало бы их все.
+func (c Config) sampleRatio() (float64, error) {
+	if c.SampleRatio == nil {
+		return defaultSampleRatio, nil
+	}
+	if ratio := *c.SampleRatio; ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("sample_ratio must be within [0, 1], got %v", ratio)
+	}
+	return *c.SampleRatio, nil
+}
+
+// Tracer глобальный opentracing-трейсер, работающий поверх OpenTelemetry
 var Tracer opentracing.Tracer
-var closer io.Closer
-
-// InitTracer инициализирует глобальный трейсер
-func InitTracer(serviceName, agentHost string, agentPort int) error {
-	cfg := traceconfig.Configuration{
-		ServiceName: serviceName,
-		Sampler: &traceconfig.SamplerConfig{
-			Type:  jaeger.SamplerTypeConst,
-			Param: 1, // 1 включает полную выборку
-		},
-		Reporter: &traceconfig.ReporterConfig{
-			LogSpans:           true,
-			LocalAgentHostPort: fmt.Sprintf("%s:%d", agentHost, agentPort),
-		},
-	}
-
-	metricsFactory := prometheus.New()
-
-	var err error
-	Tracer, closer, err = cfg.NewTracer(
-		traceconfig.Logger(jaeger.StdLogger),
-		traceconfig.Metrics(metricsFactory),
-	)
+var provider *sdktrace.TracerProvider
+
+// InitTracer инициализирует OpenTelemetry SDK с экспортом по OTLP и
+// регистрирует мост, через который продолжают работать вызовы opentracing.
+// Атрибуты и события спанов маскируются redactor перед экспортом.
+func InitTracer(
+	ctx context.Context,
+	cfg Config,
+	logCfg zerohook.LoggerConfig,
+	redactor *redact.Redactor,
+) error {
+	ratio, err := cfg.sampleRatio()
+	if err != nil {
+		zerohook.Logger.Error().Err(err).Msg("Некорректная доля сэмплирования трасс")
+		return err
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		zerohook.Logger.Error().Err(err).Msg("Не удалось создать экспортер трасс")
+		return err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName(cfg, logCfg)),
+		semconv.ServiceNamespace(envValue(logCfg.Facility)),
+		semconv.ServiceVersion(envValue(logCfg.CiCommitRefName)),
+		semconv.DeploymentEnvironment(envValue(logCfg.Origin)),
+	))
 	if err != nil {
-		zerohook.Logger.Error().Err(err).Msg("Не удалось инициализировать трейсер")
+		zerohook.Logger.Error().Err(err).Msg("Не удалось сформировать ресурс трейсера")
 		return err
 	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(newRedactingExporter(exporter, redactor)),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+
+	// Порядок важен: при извлечении последний найденный контекст побеждает,
+	// поэтому W3C traceparent имеет наивысший приоритет
+	propagator := propagation.NewCompositeTextMapPropagator(
+		b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)),
+		jaeger.Jaeger{},
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+
+	bridge, wrapperProvider := otbridge.NewTracerPair(provider.Tracer(instrumentationName))
+	bridge.SetTextMapPropagator(propagator)
+	bridge.SetWarningHandler(func(msg string) {
+		zerohook.Logger.Warn().Msg(strings.TrimSpace(msg))
+	})
+
+	otel.SetTracerProvider(wrapperProvider)
+	otel.SetTextMapPropagator(propagator)
+	Tracer = bridge
 	opentracing.SetGlobalTracer(Tracer)
-	zerohook.Logger.Info().Msg("Трейсер инициализирован")
+
+	zerohook.Logger.Info().
+		Str("endpoint", cfg.Endpoint).
+		Str("protocol", cfg.Protocol).
+		Float64("sample_ratio", ratio).
+		Msg("Трейсер инициализирован")
 	return nil
 }
 
-// CloseTracer закрывает трейсер
-func CloseTracer() error {
-	if closer != nil {
-		return closer.Close()
+// CloseTracer отправляет накопленные спаны и останавливает трейсер
+func CloseTracer(ctx context.Context) error {
+	if provider != nil {
+		return provider.Shutdown(ctx)
 	}
 	return nil
 }
+
+func newExporter(ctx context.Context, cfg Config) (*otlptrace.Exporter, error) {
+	switch strings.ToLower(cfg.Protocol) {
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case ProtocolGRPC, "":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported otlp protocol: %s", cfg.Protocol)
+	}
+}
+
+func serviceName(cfg Config, logCfg zerohook.LoggerConfig) string {
+	if cfg.ServiceName != "" {
+		return cfg.ServiceName
+	}
+	return envValue(logCfg.App)
+}
+
+func envValue(env *yamlenv.Env[string]) string {
+	if env == nil {
+		return ""
+	}
+	return env.Value
+}
@@ -7,9 +7,11 @@ import (
 	"sync"
 	"time"
 
+	"Brands/pkg/redact"
 	"Brands/pkg/yamlenv"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/pkgerrors"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var once sync.Once
@@ -23,7 +25,8 @@ type LoggerConfig struct {
 	Origin          *yamlenv.Env[string] `yaml:"origin"`
 }
 
-func InitLogger(cfg LoggerConfig) {
+// InitLogger настраивает глобальный логгер; поля событий маскируются redactor
+func InitLogger(cfg LoggerConfig, redactor *redact.Redactor) {
 	once.Do(func() {
 		zerolog.ErrorStackMarshaler = pkgerrors.MarshalStack
 		zerolog.TimeFieldFormat = time.RFC3339Nano
@@ -43,7 +46,10 @@ func InitLogger(cfg LoggerConfig) {
 		if err != nil {
 			level = zerolog.TraceLevel
 		}
-		Logger = logCtx.Logger().Level(level).Hook(NewHook(cfg))
+		Logger = logCtx.Logger().
+			Output(redact.NewWriter(os.Stdout, redactor)).
+			Level(level).
+			Hook(NewHook(cfg))
 		if err != nil {
 			Logger.Error().Msg("уровень логгирования не определен, установлен уровень trace")
 		}
@@ -75,6 +81,11 @@ func (h *ZeroHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
 		e.Str("person_guid", guid.(string))
 	}
 
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		e.Str("trace_id", sc.TraceID().String())
+		e.Str("span_id", sc.SpanID().String())
+	}
+
 	if tag := ctx.Value("app-tag"); tag != nil {
 		e.Str("app_tag", fmt.Sprintf(`%s-%s`, h.cfg.App.Value, tag))
 	} else {